@@ -1,5 +1,5 @@
 import React, { useEffect, useState, useMemo, useRef, useCallback } from 'react';
-import { GamePhase, GameState, TokenType, Role, RoomInfo, Difficulty, ReactionEvent } from './types';
+import { GamePhase, GameState, TokenType, Role, RoomInfo, Difficulty, ReactionEvent, ServerNotice } from './types';
 import { gameService } from './services/game';
 import { audioService } from './services/audioService';
 import { RoleCard } from './components/ui/RoleCard';
@@ -27,6 +27,8 @@ const DIFFICULTY_CONFIG: Record<Difficulty, { label: string; emoji: string; colo
   EASY: { label: 'Puppy', emoji: '🐶', color: 'from-green-500 to-emerald-500' },
   MEDIUM: { label: 'Good Boy', emoji: '🐕', color: 'from-amber-500 to-orange-500' },
   HARD: { label: 'Alpha Wolf', emoji: '🐺', color: 'from-red-500 to-pink-500' },
+  PHRASE: { label: 'Pack Talk', emoji: '💬', color: 'from-sky-500 to-indigo-500' },
+  ADAPTIVE: { label: 'Sniffer', emoji: '👃', color: 'from-violet-500 to-fuchsia-500' },
 };
 
 // ─── Avatar options (puppy breeds!) ─────────────────────────────────────
@@ -322,6 +324,46 @@ const MuteButton = () => {
   );
 };
 
+// ─── Server notice banner ──────────────────────────────────────────────
+const NOTICE_ICONS: Record<ServerNotice['kind'], string> = {
+  SERVER_SHUTDOWN: '🔧',
+  ROOM_EXPIRING: '💤',
+  ANNOUNCEMENT: '📣',
+  KICKED: '🚪',
+  ROOM_CLOSED: '🚪',
+};
+
+const NoticeBanner: React.FC<{ notice: ServerNotice; onDismiss: () => void }> = ({ notice, onDismiss }) => {
+  const [now, setNow] = useState(Date.now());
+  useEffect(() => {
+    if (notice.secondsRemaining === undefined) return;
+    const iv = setInterval(() => setNow(Date.now()), 1000);
+    return () => clearInterval(iv);
+  }, [notice]);
+
+  const left = notice.secondsRemaining === undefined
+    ? undefined
+    : Math.max(0, notice.secondsRemaining - Math.floor((now - notice.receivedAt) / 1000));
+  const urgent = notice.kind === 'SERVER_SHUTDOWN' || notice.kind === 'ROOM_EXPIRING';
+
+  return (
+    <div className={`fixed top-4 left-1/2 -translate-x-1/2 z-[70] max-w-md w-[calc(100%-6rem)] flex items-start gap-3 px-4 py-3 rounded-2xl backdrop-blur-xl border shadow-lg ${
+      urgent ? 'bg-red-950/80 border-red-500/40 text-red-100' : 'bg-slate-900/80 border-amber-500/30 text-slate-100'
+    }`}>
+      <span className="text-xl">{NOTICE_ICONS[notice.kind]}</span>
+      <div className="flex-1 text-sm">
+        <p>{notice.message}</p>
+        {left !== undefined && (
+          <p className="mt-1 font-mono font-bold flex items-center gap-1.5">
+            <Clock size={14} /> {Math.floor(left / 60)}:{String(left % 60).padStart(2, '0')}
+          </p>
+        )}
+      </div>
+      <button onClick={onDismiss} className="text-slate-400 hover:text-white text-sm" title="Dismiss">✕</button>
+    </div>
+  );
+};
+
 // ─── Scoreboard ─────────────────────────────────────────────────────────
 const Scoreboard: React.FC<{ players: GameState['players']; myPlayerId: string | null }> = ({ players, myPlayerId }) => {
   const ranked = useMemo(
@@ -396,11 +438,13 @@ const App = () => {
   const [selectedAvatar, setSelectedAvatar] = useState(AVATAR_SEEDS[0]);
   const [roleRevealed, setRoleRevealed] = useState(false);
   const [hasVoted, setHasVoted] = useState(false);
+  const [freeWord, setFreeWord] = useState('');
   const [rooms, setRooms] = useState<RoomInfo[]>([]);
   const [showRoomBrowser, setShowRoomBrowser] = useState(false);
   const [floatingReactions, setFloatingReactions] = useState<{id: number; emoji: string; x: number; y: number}[]>([]);
   const nextReactionId = useRef(0);
   const [showReplay, setShowReplay] = useState(false);
+  const [notice, setNotice] = useState<ServerNotice | null>(null);
 
   const prevPhaseRef = useRef(gameState.phase);
   const prevTokenCountRef = useRef(0);
@@ -425,7 +469,8 @@ const App = () => {
       setFloatingReactions(prev => [...prev.slice(-8), { id, emoji: reaction.emoji, x, y }]);
       setTimeout(() => setFloatingReactions(prev => prev.filter(r => r.id !== id)), 2000);
     });
-    return () => { unsub(); unsubRooms(); unsubReaction(); };
+    const unsubNotice = gameService.onNotice(setNotice);
+    return () => { unsub(); unsubRooms(); unsubReaction(); unsubNotice(); };
   }, []);
 
   // ── Refresh room list on LOGIN ──
@@ -582,6 +627,7 @@ const App = () => {
       <MuteButton />
       <PawCursorTrail />
       {showPhaseFlash && <PhaseFlash color={phaseFlashColor} />}
+      {notice && <NoticeBanner notice={notice} onDismiss={() => setNotice(null)} />}
       {gameState.phase === GamePhase.GAME_OVER && <Confetti variant={iWon ? 'gold' : 'silver'} />}
 
       {/* Night/Day overlay transitions */}
@@ -968,7 +1014,7 @@ const App = () => {
                   <span className="text-[10px] text-slate-500 uppercase tracking-widest font-bold">Difficulty</span>
                 </div>
                 <div className="flex gap-2">
-                  {(['EASY', 'MEDIUM', 'HARD'] as Difficulty[]).map((diff) => {
+                  {(['EASY', 'MEDIUM', 'HARD', 'PHRASE', 'ADAPTIVE'] as Difficulty[]).map((diff) => {
                     const cfg = DIFFICULTY_CONFIG[diff];
                     const isActive = gameState.difficulty === diff;
                     return (
@@ -1121,7 +1167,7 @@ const App = () => {
                   </div>
                 </div>
                 <div className="grid gap-3 w-full">
-                  {(gameState.wordOptions || []).map((word, i) => (
+                  {(gameState.wordChoices || (gameState.wordOptions || []).map(word => ({ word, difficulty: undefined }))).map(({ word, difficulty }, i) => (
                     <button
                       key={word}
                       onClick={() => { gameService.chooseWord(word); audioService.playWordChosen(); }}
@@ -1129,10 +1175,43 @@ const App = () => {
                       style={{ animationDelay: `${i * 80}ms` }}
                     >
                       <span>{word}</span>
-                      <span className="text-slate-500 group-hover:text-amber-400 transition-colors text-sm">Pick</span>
+                      <span className="flex items-center gap-3">
+                        {gameState.mixedDifficulty && difficulty && (
+                          <span className="text-xs font-mono uppercase tracking-wide text-slate-400">{difficulty.toLowerCase()}</span>
+                        )}
+                        <span className="text-slate-500 group-hover:text-amber-400 transition-colors text-sm">Pick</span>
+                      </span>
                     </button>
                   ))}
                 </div>
+                {gameState.freeChoice && (
+                  <form
+                    onSubmit={(e) => { e.preventDefault(); if (freeWord.trim()) { gameService.chooseWord(freeWord.trim()); audioService.playWordChosen(); setFreeWord(''); } }}
+                    className="mt-4 w-full flex gap-2"
+                  >
+                    <input
+                      value={freeWord}
+                      onChange={(e) => setFreeWord(e.target.value)}
+                      placeholder="Or choose your own word"
+                      maxLength={24}
+                      className="flex-1 px-4 py-3 rounded-xl bg-slate-800 border border-slate-600/50 text-slate-100 placeholder-slate-500 focus:outline-none focus:border-amber-500/50"
+                    />
+                    <button
+                      type="submit"
+                      className="px-4 py-3 rounded-xl font-bold bg-amber-500/20 text-amber-300 border border-amber-500/50 hover:bg-amber-500/30 transition-colors"
+                    >
+                      Pick
+                    </button>
+                  </form>
+                )}
+                {(gameState.rerollsLeft ?? 0) > 0 && (
+                  <button
+                    onClick={() => { gameService.rerollWords(); audioService.playClick(); }}
+                    className="mt-4 px-4 py-2 rounded-lg text-sm text-slate-300 border border-slate-600/50 hover:border-amber-500/50 hover:text-amber-300 transition-colors"
+                  >
+                    New words ({gameState.rerollsLeft} left)
+                  </button>
+                )}
                 <p className="mt-4 text-slate-500 text-xs text-center">A random word will be chosen if time runs out</p>
               </>
             ) : (
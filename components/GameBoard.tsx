@@ -1,5 +1,5 @@
 import React, { useState, useRef, useMemo, useEffect } from 'react';
-import { GameState, TokenType, GuessEntry, Role } from '../types';
+import { GameState, TokenType, GuessEntry, Role, HintType } from '../types';
 import { Token } from './ui/Token';
 import { Clock, Crown, Sun, Send, MessageCircle, Eye, Shield, Skull } from '../utils/icons';
 import { TOKEN_CONFIG } from '../constants';
@@ -13,12 +13,13 @@ interface GameBoardProps {
 }
 
 /* ─── Speech Bubble Component ─── */
-const SpeechBubble: React.FC<{ text: string; isNew: boolean }> = ({ text, isNew }) => (
+const SpeechBubble: React.FC<{ text: string; isNew: boolean; soClose?: boolean }> = ({ text, isNew, soClose }) => (
   <div className={`absolute bottom-full left-1/2 -translate-x-1/2 mb-1 z-30 pointer-events-none
     ${isNew ? 'animate-bubble-pop' : ''}`}
   >
-    <div className="relative bg-white/95 text-slate-800 rounded-xl px-2.5 py-1 text-[10px] sm:text-xs font-medium
-      shadow-lg shadow-black/20 border border-white/50 whitespace-nowrap max-w-[140px] truncate">
+    <div className={`relative bg-white/95 text-slate-800 rounded-xl px-2.5 py-1 text-[10px] sm:text-xs font-medium
+      shadow-lg shadow-black/20 border whitespace-nowrap max-w-[140px] truncate ${soClose ? 'border-amber-400 ring-1 ring-amber-400/60' : 'border-white/50'}`}>
+      {soClose && <span className="text-amber-600 font-bold mr-1" title="Nearly the word — SO CLOSE?">≈</span>}
       {text}
       {/* Speech bubble tail */}
       <div className="absolute top-full left-1/2 -translate-x-1/2 w-0 h-0
@@ -29,6 +30,15 @@ const SpeechBubble: React.FC<{ text: string; isNew: boolean }> = ({ text, isNew
   </div>
 );
 
+const HINT_LABELS: Record<HintType, string> = {
+  LETTER: 'Letter',
+  FIRST_LETTER: 'First letter',
+  LENGTH: 'Length',
+  SYLLABLES: 'Syllables',
+  CATEGORY: 'Category',
+  CLUE: 'Clue',
+};
+
 /* ─── Role instruction config ─── */
 const getRoleInstruction = (role: string | undefined, isMayor: boolean) => {
   if (isMayor) {
@@ -68,7 +78,8 @@ export const GameBoard: React.FC<GameBoardProps> = ({ state, onTokenClick, onSub
   const mayorPlayer = state.players.find(p => p.isMayor);
   const nonMayorPlayers = state.players.filter(p => !p.isMayor);
   const roleInstruction = getRoleInstruction(myRole as string, !!isMayor);
-  const maxHints = state.secretWord ? Math.floor(state.secretWord.length / 2) : 0;
+  const hintOptions = state.hintOptions || [];
+  const hintEvents = (state.timeline || []).filter(e => e.type === 'HINT');
 
   const [selectedToken, setSelectedToken] = useState<TokenType | null>(null);
   const [guessText, setGuessText] = useState('');
@@ -252,21 +263,27 @@ export const GameBoard: React.FC<GameBoardProps> = ({ state, onTokenClick, onSub
           {(isMayor || myPlayer?.role === 'WEREWOLF' || myPlayer?.role === 'SEER') ? (
             <div className="flex items-center justify-center gap-2">
               <p className="text-base sm:text-lg font-serif font-bold text-amber-400 truncate">{state.secretWord}</p>
-              {isMayor && state.hintsRevealed < maxHints && (
+              {isMayor && hintOptions.map(option => (
                 <button
-                  onClick={() => { gameService.revealHint(); audioService.playClick(); }}
+                  key={option.type}
+                  onClick={() => { gameService.revealHint(option.type); audioService.playClick(); }}
                   className="text-[9px] px-2 py-0.5 bg-indigo-500/20 text-indigo-300 rounded-full border border-indigo-500/30 hover:bg-indigo-500/30 transition-all shrink-0"
-                  title={`Reveal a letter hint (${state.hintsRevealed}/${maxHints} used, costs 1 score)`}
+                  title={`Reveal a ${HINT_LABELS[option.type].toLowerCase()} hint (${option.remaining} left, costs ${option.cost} score)`}
                 >
-                  💡 Hint ({maxHints - state.hintsRevealed})
+                  💡 {HINT_LABELS[option.type]} ({option.remaining})
                 </button>
-              )}
+              ))}
             </div>
           ) : state.secretWordHints ? (
             <p className="text-base sm:text-lg font-mono font-bold text-indigo-300 tracking-wider">{state.secretWordHints}</p>
           ) : (
             <p className="text-base sm:text-lg font-serif font-bold text-slate-600">? ? ? ? ?</p>
           )}
+          {hintEvents.length > 0 && (
+            <p className="text-[10px] text-indigo-300/80 truncate">
+              {hintEvents.map(e => `💡 ${e.text}`).join(' · ')}
+            </p>
+          )}
         </div>
 
         {/* Day indicator */}
@@ -346,7 +363,7 @@ export const GameBoard: React.FC<GameBoardProps> = ({ state, onTokenClick, onSub
               >
                 <div className={`flex flex-col items-center relative ${moodClass}`}>
                   {/* Speech bubble for guess */}
-                  {guess && <SpeechBubble text={guess.text} isNew={isNewGuess} />}
+                  {guess && <SpeechBubble text={guess.text} isNew={isNewGuess} soClose={guess.suggestedToken === TokenType.SO_CLOSE} />}
 
                   {/* Pulsing target ring when Mayor has a token selected */}
                   {isTargetable && (
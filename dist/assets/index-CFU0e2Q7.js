@@ -1,226 +0,0 @@
-(function(){const f=document.createElement("link").relList;if(f&&f.supports&&f.supports("modulepreload"))return;for(const m of document.querySelectorAll('link[rel="modulepreload"]'))u(m);new MutationObserver(m=>{for(const x of m)if(x.type==="childList")for(const b of x.addedNodes)b.tagName==="LINK"&&b.rel==="modulepreload"&&u(b)}).observe(document,{childList:!0,subtree:!0});function r(m){const x={};return m.integrity&&(x.integrity=m.integrity),m.referrerPolicy&&(x.referrerPolicy=m.referrerPolicy),m.crossOrigin==="use-credentials"?x.credentials="include":m.crossOrigin==="anonymous"?x.credentials="omit":x.credentials="same-origin",x}function u(m){if(m.ep)return;m.ep=!0;const x=r(m);fetch(m.href,x)}})();function P0(o){return o&&o.__esModule&&Object.prototype.hasOwnProperty.call(o,"default")?o.default:o}var gu={exports:{}},_n={};/**
- * @license React
- * react-jsx-runtime.production.js
- *
- * Copyright (c) Meta Platforms, Inc. and affiliates.
- *
- * This source code is licensed under the MIT license found in the
- * LICENSE file in the root directory of this source tree.
- */var B0;function zh(){if(B0)return _n;B0=1;var o=Symbol.for("react.transitional.element"),f=Symbol.for("react.fragment");function r(u,m,x){var b=null;if(x!==void 0&&(b=""+x),m.key!==void 0&&(b=""+m.key),"key"in m){x={};for(var B in m)B!=="key"&&(x[B]=m[B])}else x=m;return m=x.ref,{$$typeof:o,type:u,key:b,ref:m!==void 0?m:null,props:x}}return _n.Fragment=f,_n.jsx=r,_n.jsxs=r,_n}var U0;function Mh(){return U0||(U0=1,gu.exports=zh()),gu.exports}var s=Mh(),vu={exports:{}},te={};/**
- * @license React
- * react.production.js
- *
- * Copyright (c) Meta Platforms, Inc. and affiliates.
- *
- * This source code is licensed under the MIT license found in the
- * LICENSE file in the root directory of this source tree.
- */var H0;function Rh(){if(H0)return te;H0=1;var o=Symbol.for("react.transitional.element"),f=Symbol.for("react.portal"),r=Symbol.for("react.fragment"),u=Symbol.for("react.strict_mode"),m=Symbol.for("react.profiler"),x=Symbol.for("react.consumer"),b=Symbol.for("react.context"),B=Symbol.for("react.forward_ref"),z=Symbol.for("react.suspense"),M=Symbol.for("react.memo"),P=Symbol.for("react.lazy"),L=Symbol.for("react.activity"),k=Symbol.iterator;function W(h){return h===null||typeof h!="object"?null:(h=k&&h[k]||h["@@iterator"],typeof h=="function"?h:null)}var I={isMounted:function(){return!1},enqueueForceUpdate:function(){},enqueueReplaceState:function(){},enqueueSetState:function(){}},se=Object.assign,be={};function pe(h,g,D){this.props=h,this.context=g,this.refs=be,this.updater=D||I}pe.prototype.isReactComponent={},pe.prototype.setState=function(h,g){if(typeof h!="object"&&typeof h!="function"&&h!=null)throw Error("takes an object of state variables to update or a function which returns an object of state variables.");this.updater.enqueueSetState(this,h,g,"setState")},pe.prototype.forceUpdate=function(h){this.updater.enqueueForceUpdate(this,h,"forceUpdate")};function V(){}V.prototype=pe.prototype;function G(h,g,D){this.props=h,this.context=g,this.refs=be,this.updater=D||I}var ce=G.prototype=new V;ce.constructor=G,se(ce,pe.prototype),ce.isPureReactComponent=!0;var Ne=Array.isArray;function ze(){}var ee={H:null,A:null,T:null,S:null},ge=Object.prototype.hasOwnProperty;function Ve(h,g,D){var U=D.ref;return{$$typeof:o,type:h,key:g,ref:U!==void 0?U:null,props:D}}function Vt(h,g){return Ve(h.type,g,h.props)}function lt(h){return typeof h=="object"&&h!==null&&h.$$typeof===o}function ke(h){var g={"=":"=0",":":"=2"};return"$"+h.replace(/[=:]/g,function(D){return g[D]})}var ae=/\/+/g;function it(h,g){return typeof h=="object"&&h!==null&&h.key!=null?ke(""+h.key):g.toString(36)}function at(h){switch(h.status){case"fulfilled":return h.value;case"rejected":throw h.reason;default:switch(typeof h.status=="string"?h.then(ze,ze):(h.status="pending",h.then(function(g){h.status==="pending"&&(h.status="fulfilled",h.value=g)},function(g){h.status==="pending"&&(h.status="rejected",h.reason=g)})),h.status){case"fulfilled":return h.value;case"rejected":throw h.reason}}throw h}function A(h,g,D,U,X){var _=typeof h;(_==="undefined"||_==="boolean")&&(h=null);var w=!1;if(h===null)w=!0;else switch(_){case"bigint":case"string":case"number":w=!0;break;case"object":switch(h.$$typeof){case o:case f:w=!0;break;case P:return w=h._init,A(w(h._payload),g,D,U,X)}}if(w)return X=X(h),w=U===""?"."+it(h,0):U,Ne(X)?(D="",w!=null&&(D=w.replace(ae,"$&/")+"/"),A(X,g,D,"",function(Qe){return Qe})):X!=null&&(lt(X)&&(X=Vt(X,D+(X.key==null||h&&h.key===X.key?"":(""+X.key).replace(ae,"$&/")+"/")+w)),g.push(X)),1;w=0;var K=U===""?".":U+":";if(Ne(h))for(var ue=0;ue<h.length;ue++)U=h[ue],_=K+it(U,ue),w+=A(U,g,D,_,X);else if(ue=W(h),typeof ue=="function")for(h=ue.call(h),ue=0;!(U=h.next()).done;)U=U.value,_=K+it(U,ue++),w+=A(U,g,D,_,X);else if(_==="object"){if(typeof h.then=="function")return A(at(h),g,D,U,X);throw g=String(h),Error("Objects are not valid as a React child (found: "+(g==="[object Object]"?"object with keys {"+Object.keys(h).join(", ")+"}":g)+"). If you meant to render a collection of children, use an array instead.")}return w}function H(h,g,D){if(h==null)return h;var U=[],X=0;return A(h,U,"","",function(_){return g.call(D,_,X++)}),U}function Z(h){if(h._status===-1){var g=h._result;g=g(),g.then(function(D){(h._status===0||h._status===-1)&&(h._status=1,h._result=D)},function(D){(h._status===0||h._status===-1)&&(h._status=2,h._result=D)}),h._status===-1&&(h._status=0,h._result=g)}if(h._status===1)return h._result.default;throw h._result}var he=typeof reportError=="function"?reportError:function(h){if(typeof window=="object"&&typeof window.ErrorEvent=="function"){var g=new window.ErrorEvent("error",{bubbles:!0,cancelable:!0,message:typeof h=="object"&&h!==null&&typeof h.message=="string"?String(h.message):String(h),error:h});if(!window.dispatchEvent(g))return}else if(typeof process=="object"&&typeof process.emit=="function"){process.emit("uncaughtException",h);return}console.error(h)},O={map:H,forEach:function(h,g,D){H(h,function(){g.apply(this,arguments)},D)},count:function(h){var g=0;return H(h,function(){g++}),g},toArray:function(h){return H(h,function(g){return g})||[]},only:function(h){if(!lt(h))throw Error("React.Children.only expected to receive a single React element child.");return h}};return te.Activity=L,te.Children=O,te.Component=pe,te.Fragment=r,te.Profiler=m,te.PureComponent=G,te.StrictMode=u,te.Suspense=z,te.__CLIENT_INTERNALS_DO_NOT_USE_OR_WARN_USERS_THEY_CANNOT_UPGRADE=ee,te.__COMPILER_RUNTIME={__proto__:null,c:function(h){return ee.H.useMemoCache(h)}},te.cache=function(h){return function(){return h.apply(null,arguments)}},te.cacheSignal=function(){return null},te.cloneElement=function(h,g,D){if(h==null)throw Error("The argument must be a React element, but you passed "+h+".");var U=se({},h.props),X=h.key;if(g!=null)for(_ in g.key!==void 0&&(X=""+g.key),g)!ge.call(g,_)||_==="key"||_==="__self"||_==="__source"||_==="ref"&&g.ref===void 0||(U[_]=g[_]);var _=arguments.length-2;if(_===1)U.children=D;else if(1<_){for(var w=Array(_),K=0;K<_;K++)w[K]=arguments[K+2];U.children=w}return Ve(h.type,X,U)},te.createContext=function(h){return h={$$typeof:b,_currentValue:h,_currentValue2:h,_threadCount:0,Provider:null,Consumer:null},h.Provider=h,h.Consumer={$$typeof:x,_context:h},h},te.createElement=function(h,g,D){var U,X={},_=null;if(g!=null)for(U in g.key!==void 0&&(_=""+g.key),g)ge.call(g,U)&&U!=="key"&&U!=="__self"&&U!=="__source"&&(X[U]=g[U]);var w=arguments.length-2;if(w===1)X.children=D;else if(1<w){for(var K=Array(w),ue=0;ue<w;ue++)K[ue]=arguments[ue+2];X.children=K}if(h&&h.defaultProps)for(U in w=h.defaultProps,w)X[U]===void 0&&(X[U]=w[U]);return Ve(h,_,X)},te.createRef=function(){return{current:null}},te.forwardRef=function(h){return{$$typeof:B,render:h}},te.isValidElement=lt,te.lazy=function(h){return{$$typeof:P,_payload:{_status:-1,_result:h},_init:Z}},te.memo=function(h,g){return{$$typeof:M,type:h,compare:g===void 0?null:g}},te.startTransition=function(h){var g=ee.T,D={};ee.T=D;try{var U=h(),X=ee.S;X!==null&&X(D,U),typeof U=="object"&&U!==null&&typeof U.then=="function"&&U.then(ze,he)}catch(_){he(_)}finally{g!==null&&D.types!==null&&(g.types=D.types),ee.T=g}},te.unstable_useCacheRefresh=function(){return ee.H.useCacheRefresh()},te.use=function(h){return ee.H.use(h)},te.useActionState=function(h,g,D){return ee.H.useActionState(h,g,D)},te.useCallback=function(h,g){return ee.H.useCallback(h,g)},te.useContext=function(h){return ee.H.useContext(h)},te.useDebugValue=function(){},te.useDeferredValue=function(h,g){return ee.H.useDeferredValue(h,g)},te.useEffect=function(h,g){return ee.H.useEffect(h,g)},te.useEffectEvent=function(h){return ee.H.useEffectEvent(h)},te.useId=function(){return ee.H.useId()},te.useImperativeHandle=function(h,g,D){return ee.H.useImperativeHandle(h,g,D)},te.useInsertionEffect=function(h,g){return ee.H.useInsertionEffect(h,g)},te.useLayoutEffect=function(h,g){return ee.H.useLayoutEffect(h,g)},te.useMemo=function(h,g){return ee.H.useMemo(h,g)},te.useOptimistic=function(h,g){return ee.H.useOptimistic(h,g)},te.useReducer=function(h,g,D){return ee.H.useReducer(h,g,D)},te.useRef=function(h){return ee.H.useRef(h)},te.useState=function(h){return ee.H.useState(h)},te.useSyncExternalStore=function(h,g,D){return ee.H.useSyncExternalStore(h,g,D)},te.useTransition=function(){return ee.H.useTransition()},te.version="19.2.4",te}var V0;function Du(){return V0||(V0=1,vu.exports=Rh()),vu.exports}var Q=Du();const Oh=P0(Q);var bu={exports:{}},wn={},ju={exports:{}},Su={};/**
- * @license React
- * scheduler.production.js
- *
- * Copyright (c) Meta Platforms, Inc. and affiliates.
- *
- * This source code is licensed under the MIT license found in the
- * LICENSE file in the root directory of this source tree.
- */var L0;function Dh(){return L0||(L0=1,(function(o){function f(A,H){var Z=A.length;A.push(H);e:for(;0<Z;){var he=Z-1>>>1,O=A[he];if(0<m(O,H))A[he]=H,A[Z]=O,Z=he;else break e}}function r(A){return A.length===0?null:A[0]}function u(A){if(A.length===0)return null;var H=A[0],Z=A.pop();if(Z!==H){A[0]=Z;e:for(var he=0,O=A.length,h=O>>>1;he<h;){var g=2*(he+1)-1,D=A[g],U=g+1,X=A[U];if(0>m(D,Z))U<O&&0>m(X,D)?(A[he]=X,A[U]=Z,he=U):(A[he]=D,A[g]=Z,he=g);else if(U<O&&0>m(X,Z))A[he]=X,A[U]=Z,he=U;else break e}}return H}function m(A,H){var Z=A.sortIndex-H.sortIndex;return Z!==0?Z:A.id-H.id}if(o.unstable_now=void 0,typeof performance=="object"&&typeof performance.now=="function"){var x=performance;o.unstable_now=function(){return x.now()}}else{var b=Date,B=b.now();o.unstable_now=function(){return b.now()-B}}var z=[],M=[],P=1,L=null,k=3,W=!1,I=!1,se=!1,be=!1,pe=typeof setTimeout=="function"?setTimeout:null,V=typeof clearTimeout=="function"?clearTimeout:null,G=typeof setImmediate<"u"?setImmediate:null;function ce(A){for(var H=r(M);H!==null;){if(H.callback===null)u(M);else if(H.startTime<=A)u(M),H.sortIndex=H.expirationTime,f(z,H);else break;H=r(M)}}function Ne(A){if(se=!1,ce(A),!I)if(r(z)!==null)I=!0,ze||(ze=!0,ke());else{var H=r(M);H!==null&&at(Ne,H.startTime-A)}}var ze=!1,ee=-1,ge=5,Ve=-1;function Vt(){return be?!0:!(o.unstable_now()-Ve<ge)}function lt(){if(be=!1,ze){var A=o.unstable_now();Ve=A;var H=!0;try{e:{I=!1,se&&(se=!1,V(ee),ee=-1),W=!0;var Z=k;try{t:{for(ce(A),L=r(z);L!==null&&!(L.expirationTime>A&&Vt());){var he=L.callback;if(typeof he=="function"){L.callback=null,k=L.priorityLevel;var O=he(L.expirationTime<=A);if(A=o.unstable_now(),typeof O=="function"){L.callback=O,ce(A),H=!0;break t}L===r(z)&&u(z),ce(A)}else u(z);L=r(z)}if(L!==null)H=!0;else{var h=r(M);h!==null&&at(Ne,h.startTime-A),H=!1}}break e}finally{L=null,k=Z,W=!1}H=void 0}}finally{H?ke():ze=!1}}}var ke;if(typeof G=="function")ke=function(){G(lt)};else if(typeof MessageChannel<"u"){var ae=new MessageChannel,it=ae.port2;ae.port1.onmessage=lt,ke=function(){it.postMessage(null)}}else ke=function(){pe(lt,0)};function at(A,H){ee=pe(function(){A(o.unstable_now())},H)}o.unstable_IdlePriority=5,o.unstable_ImmediatePriority=1,o.unstable_LowPriority=4,o.unstable_NormalPriority=3,o.unstable_Profiling=null,o.unstable_UserBlockingPriority=2,o.unstable_cancelCallback=function(A){A.callback=null},o.unstable_forceFrameRate=function(A){0>A||125<A?console.error("forceFrameRate takes a positive int between 0 and 125, forcing frame rates higher than 125 fps is not supported"):ge=0<A?Math.floor(1e3/A):5},o.unstable_getCurrentPriorityLevel=function(){return k},o.unstable_next=function(A){switch(k){case 1:case 2:case 3:var H=3;break;default:H=k}var Z=k;k=H;try{return A()}finally{k=Z}},o.unstable_requestPaint=function(){be=!0},o.unstable_runWithPriority=function(A,H){switch(A){case 1:case 2:case 3:case 4:case 5:break;default:A=3}var Z=k;k=A;try{return H()}finally{k=Z}},o.unstable_scheduleCallback=function(A,H,Z){var he=o.unstable_now();switch(typeof Z=="object"&&Z!==null?(Z=Z.delay,Z=typeof Z=="number"&&0<Z?he+Z:he):Z=he,A){case 1:var O=-1;break;case 2:O=250;break;case 5:O=1073741823;break;case 4:O=1e4;break;default:O=5e3}return O=Z+O,A={id:P++,callback:H,priorityLevel:A,startTime:Z,expirationTime:O,sortIndex:-1},Z>he?(A.sortIndex=Z,f(M,A),r(z)===null&&A===r(M)&&(se?(V(ee),ee=-1):se=!0,at(Ne,Z-he))):(A.sortIndex=O,f(z,A),I||W||(I=!0,ze||(ze=!0,ke()))),A},o.unstable_shouldYield=Vt,o.unstable_wrapCallback=function(A){var H=k;return function(){var Z=k;k=H;try{return A.apply(this,arguments)}finally{k=Z}}}})(Su)),Su}var G0;function _h(){return G0||(G0=1,ju.exports=Dh()),ju.exports}var Eu={exports:{}},tt={};/**
- * @license React
- * react-dom.production.js
- *
- * Copyright (c) Meta Platforms, Inc. and affiliates.
- *
- * This source code is licensed under the MIT license found in the
- * LICENSE file in the root directory of this source tree.
- */var q0;function wh(){if(q0)return tt;q0=1;var o=Du();function f(z){var M="https://react.dev/errors/"+z;if(1<arguments.length){M+="?args[]="+encodeURIComponent(arguments[1]);for(var P=2;P<arguments.length;P++)M+="&args[]="+encodeURIComponent(arguments[P])}return"Minified React error #"+z+"; visit "+M+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."}function r(){}var u={d:{f:r,r:function(){throw Error(f(522))},D:r,C:r,L:r,m:r,X:r,S:r,M:r},p:0,findDOMNode:null},m=Symbol.for("react.portal");function x(z,M,P){var L=3<arguments.length&&arguments[3]!==void 0?arguments[3]:null;return{$$typeof:m,key:L==null?null:""+L,children:z,containerInfo:M,implementation:P}}var b=o.__CLIENT_INTERNALS_DO_NOT_USE_OR_WARN_USERS_THEY_CANNOT_UPGRADE;function B(z,M){if(z==="font")return"";if(typeof M=="string")return M==="use-credentials"?M:""}return tt.__DOM_INTERNALS_DO_NOT_USE_OR_WARN_USERS_THEY_CANNOT_UPGRADE=u,tt.createPortal=function(z,M){var P=2<arguments.length&&arguments[2]!==void 0?arguments[2]:null;if(!M||M.nodeType!==1&&M.nodeType!==9&&M.nodeType!==11)throw Error(f(299));return x(z,M,null,P)},tt.flushSync=function(z){var M=b.T,P=u.p;try{if(b.T=null,u.p=2,z)return z()}finally{b.T=M,u.p=P,u.d.f()}},tt.preconnect=function(z,M){typeof z=="string"&&(M?(M=M.crossOrigin,M=typeof M=="string"?M==="use-credentials"?M:"":void 0):M=null,u.d.C(z,M))},tt.prefetchDNS=function(z){typeof z=="string"&&u.d.D(z)},tt.preinit=function(z,M){if(typeof z=="string"&&M&&typeof M.as=="string"){var P=M.as,L=B(P,M.crossOrigin),k=typeof M.integrity=="string"?M.integrity:void 0,W=typeof M.fetchPriority=="string"?M.fetchPriority:void 0;P==="style"?u.d.S(z,typeof M.precedence=="string"?M.precedence:void 0,{crossOrigin:L,integrity:k,fetchPriority:W}):P==="script"&&u.d.X(z,{crossOrigin:L,integrity:k,fetchPriority:W,nonce:typeof M.nonce=="string"?M.nonce:void 0})}},tt.preinitModule=function(z,M){if(typeof z=="string")if(typeof M=="object"&&M!==null){if(M.as==null||M.as==="script"){var P=B(M.as,M.crossOrigin);u.d.M(z,{crossOrigin:P,integrity:typeof M.integrity=="string"?M.integrity:void 0,nonce:typeof M.nonce=="string"?M.nonce:void 0})}}else M==null&&u.d.M(z)},tt.preload=function(z,M){if(typeof z=="string"&&typeof M=="object"&&M!==null&&typeof M.as=="string"){var P=M.as,L=B(P,M.crossOrigin);u.d.L(z,P,{crossOrigin:L,integrity:typeof M.integrity=="string"?M.integrity:void 0,nonce:typeof M.nonce=="string"?M.nonce:void 0,type:typeof M.type=="string"?M.type:void 0,fetchPriority:typeof M.fetchPriority=="string"?M.fetchPriority:void 0,referrerPolicy:typeof M.referrerPolicy=="string"?M.referrerPolicy:void 0,imageSrcSet:typeof M.imageSrcSet=="string"?M.imageSrcSet:void 0,imageSizes:typeof M.imageSizes=="string"?M.imageSizes:void 0,media:typeof M.media=="string"?M.media:void 0})}},tt.preloadModule=function(z,M){if(typeof z=="string")if(M){var P=B(M.as,M.crossOrigin);u.d.m(z,{as:typeof M.as=="string"&&M.as!=="script"?M.as:void 0,crossOrigin:P,integrity:typeof M.integrity=="string"?M.integrity:void 0})}else u.d.m(z)},tt.requestFormReset=function(z){u.d.r(z)},tt.unstable_batchedUpdates=function(z,M){return z(M)},tt.useFormState=function(z,M,P){return b.H.useFormState(z,M,P)},tt.useFormStatus=function(){return b.H.useHostTransitionStatus()},tt.version="19.2.4",tt}var Y0;function Bh(){if(Y0)return Eu.exports;Y0=1;function o(){if(!(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__>"u"||typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE!="function"))try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(o)}catch(f){console.error(f)}}return o(),Eu.exports=wh(),Eu.exports}/**
- * @license React
- * react-dom-client.production.js
- *
- * Copyright (c) Meta Platforms, Inc. and affiliates.
- *
- * This source code is licensed under the MIT license found in the
- * LICENSE file in the root directory of this source tree.
- */var F0;function Uh(){if(F0)return wn;F0=1;var o=_h(),f=Du(),r=Bh();function u(e){var t="https://react.dev/errors/"+e;if(1<arguments.length){t+="?args[]="+encodeURIComponent(arguments[1]);for(var l=2;l<arguments.length;l++)t+="&args[]="+encodeURIComponent(arguments[l])}return"Minified React error #"+e+"; visit "+t+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."}function m(e){return!(!e||e.nodeType!==1&&e.nodeType!==9&&e.nodeType!==11)}function x(e){var t=e,l=e;if(e.alternate)for(;t.return;)t=t.return;else{e=t;do t=e,(t.flags&4098)!==0&&(l=t.return),e=t.return;while(e)}return t.tag===3?l:null}function b(e){if(e.tag===13){var t=e.memoizedState;if(t===null&&(e=e.alternate,e!==null&&(t=e.memoizedState)),t!==null)return t.dehydrated}return null}function B(e){if(e.tag===31){var t=e.memoizedState;if(t===null&&(e=e.alternate,e!==null&&(t=e.memoizedState)),t!==null)return t.dehydrated}return null}function z(e){if(x(e)!==e)throw Error(u(188))}function M(e){var t=e.alternate;if(!t){if(t=x(e),t===null)throw Error(u(188));return t!==e?null:e}for(var l=e,a=t;;){var n=l.return;if(n===null)break;var i=n.alternate;if(i===null){if(a=n.return,a!==null){l=a;continue}break}if(n.child===i.child){for(i=n.child;i;){if(i===l)return z(n),e;if(i===a)return z(n),t;i=i.sibling}throw Error(u(188))}if(l.return!==a.return)l=n,a=i;else{for(var c=!1,d=n.child;d;){if(d===l){c=!0,l=n,a=i;break}if(d===a){c=!0,a=n,l=i;break}d=d.sibling}if(!c){for(d=i.child;d;){if(d===l){c=!0,l=i,a=n;break}if(d===a){c=!0,a=i,l=n;break}d=d.sibling}if(!c)throw Error(u(189))}}if(l.alternate!==a)throw Error(u(190))}if(l.tag!==3)throw Error(u(188));return l.stateNode.current===l?e:t}function P(e){var t=e.tag;if(t===5||t===26||t===27||t===6)return e;for(e=e.child;e!==null;){if(t=P(e),t!==null)return t;e=e.sibling}return null}var L=Object.assign,k=Symbol.for("react.element"),W=Symbol.for("react.transitional.element"),I=Symbol.for("react.portal"),se=Symbol.for("react.fragment"),be=Symbol.for("react.strict_mode"),pe=Symbol.for("react.profiler"),V=Symbol.for("react.consumer"),G=Symbol.for("react.context"),ce=Symbol.for("react.forward_ref"),Ne=Symbol.for("react.suspense"),ze=Symbol.for("react.suspense_list"),ee=Symbol.for("react.memo"),ge=Symbol.for("react.lazy"),Ve=Symbol.for("react.activity"),Vt=Symbol.for("react.memo_cache_sentinel"),lt=Symbol.iterator;function ke(e){return e===null||typeof e!="object"?null:(e=lt&&e[lt]||e["@@iterator"],typeof e=="function"?e:null)}var ae=Symbol.for("react.client.reference");function it(e){if(e==null)return null;if(typeof e=="function")return e.$$typeof===ae?null:e.displayName||e.name||null;if(typeof e=="string")return e;switch(e){case se:return"Fragment";case pe:return"Profiler";case be:return"StrictMode";case Ne:return"Suspense";case ze:return"SuspenseList";case Ve:return"Activity"}if(typeof e=="object")switch(e.$$typeof){case I:return"Portal";case G:return e.displayName||"Context";case V:return(e._context.displayName||"Context")+".Consumer";case ce:var t=e.render;return e=e.displayName,e||(e=t.displayName||t.name||"",e=e!==""?"ForwardRef("+e+")":"ForwardRef"),e;case ee:return t=e.displayName||null,t!==null?t:it(e.type)||"Memo";case ge:t=e._payload,e=e._init;try{return it(e(t))}catch{}}return null}var at=Array.isArray,A=f.__CLIENT_INTERNALS_DO_NOT_USE_OR_WARN_USERS_THEY_CANNOT_UPGRADE,H=r.__DOM_INTERNALS_DO_NOT_USE_OR_WARN_USERS_THEY_CANNOT_UPGRADE,Z={pending:!1,data:null,method:null,action:null},he=[],O=-1;function h(e){return{current:e}}function g(e){0>O||(e.current=he[O],he[O]=null,O--)}function D(e,t){O++,he[O]=e.current,e.current=t}var U=h(null),X=h(null),_=h(null),w=h(null);function K(e,t){switch(D(_,t),D(X,e),D(U,null),t.nodeType){case 9:case 11:e=(e=t.documentElement)&&(e=e.namespaceURI)?n0(e):0;break;default:if(e=t.tagName,t=t.namespaceURI)t=n0(t),e=i0(t,e);else switch(e){case"svg":e=1;break;case"math":e=2;break;default:e=0}}g(U),D(U,e)}function ue(){g(U),g(X),g(_)}function Qe(e){e.memoizedState!==null&&D(w,e);var t=U.current,l=i0(t,e.type);t!==l&&(D(X,e),D(U,l))}function Tt(e){X.current===e&&(g(U),g(X)),w.current===e&&(g(w),Mn._currentValue=Z)}var Ft,Un;function Ul(e){if(Ft===void 0)try{throw Error()}catch(l){var t=l.stack.trim().match(/\n( *(at )?)/);Ft=t&&t[1]||"",Un=-1<l.stack.indexOf(`
-    at`)?" (<anonymous>)":-1<l.stack.indexOf("@")?"@unknown:0:0":""}return`
-`+Ft+e+Un}var ts=!1;function ls(e,t){if(!e||ts)return"";ts=!0;var l=Error.prepareStackTrace;Error.prepareStackTrace=void 0;try{var a={DetermineComponentFrameRoot:function(){try{if(t){var R=function(){throw Error()};if(Object.defineProperty(R.prototype,"props",{set:function(){throw Error()}}),typeof Reflect=="object"&&Reflect.construct){try{Reflect.construct(R,[])}catch(T){var E=T}Reflect.construct(e,[],R)}else{try{R.call()}catch(T){E=T}e.call(R.prototype)}}else{try{throw Error()}catch(T){E=T}(R=e())&&typeof R.catch=="function"&&R.catch(function(){})}}catch(T){if(T&&E&&typeof T.stack=="string")return[T.stack,E.stack]}return[null,null]}};a.DetermineComponentFrameRoot.displayName="DetermineComponentFrameRoot";var n=Object.getOwnPropertyDescriptor(a.DetermineComponentFrameRoot,"name");n&&n.configurable&&Object.defineProperty(a.DetermineComponentFrameRoot,"name",{value:"DetermineComponentFrameRoot"});var i=a.DetermineComponentFrameRoot(),c=i[0],d=i[1];if(c&&d){var y=c.split(`
-`),S=d.split(`
-`);for(n=a=0;a<y.length&&!y[a].includes("DetermineComponentFrameRoot");)a++;for(;n<S.length&&!S[n].includes("DetermineComponentFrameRoot");)n++;if(a===y.length||n===S.length)for(a=y.length-1,n=S.length-1;1<=a&&0<=n&&y[a]!==S[n];)n--;for(;1<=a&&0<=n;a--,n--)if(y[a]!==S[n]){if(a!==1||n!==1)do if(a--,n--,0>n||y[a]!==S[n]){var N=`
-`+y[a].replace(" at new "," at ");return e.displayName&&N.includes("<anonymous>")&&(N=N.replace("<anonymous>",e.displayName)),N}while(1<=a&&0<=n);break}}}finally{ts=!1,Error.prepareStackTrace=l}return(l=e?e.displayName||e.name:"")?Ul(l):""}function id(e,t){switch(e.tag){case 26:case 27:case 5:return Ul(e.type);case 16:return Ul("Lazy");case 13:return e.child!==t&&t!==null?Ul("Suspense Fallback"):Ul("Suspense");case 19:return Ul("SuspenseList");case 0:case 15:return ls(e.type,!1);case 11:return ls(e.type.render,!1);case 1:return ls(e.type,!0);case 31:return Ul("Activity");default:return""}}function _u(e){try{var t="",l=null;do t+=id(e,l),l=e,e=e.return;while(e);return t}catch(a){return`
-Error generating stack: `+a.message+`
-`+a.stack}}var as=Object.prototype.hasOwnProperty,ns=o.unstable_scheduleCallback,is=o.unstable_cancelCallback,sd=o.unstable_shouldYield,cd=o.unstable_requestPaint,mt=o.unstable_now,ud=o.unstable_getCurrentPriorityLevel,wu=o.unstable_ImmediatePriority,Bu=o.unstable_UserBlockingPriority,Hn=o.unstable_NormalPriority,rd=o.unstable_LowPriority,Uu=o.unstable_IdlePriority,od=o.log,fd=o.unstable_setDisableYieldValue,Ga=null,ht=null;function ol(e){if(typeof od=="function"&&fd(e),ht&&typeof ht.setStrictMode=="function")try{ht.setStrictMode(Ga,e)}catch{}}var yt=Math.clz32?Math.clz32:hd,dd=Math.log,md=Math.LN2;function hd(e){return e>>>=0,e===0?32:31-(dd(e)/md|0)|0}var Vn=256,Ln=262144,Gn=4194304;function Hl(e){var t=e&42;if(t!==0)return t;switch(e&-e){case 1:return 1;case 2:return 2;case 4:return 4;case 8:return 8;case 16:return 16;case 32:return 32;case 64:return 64;case 128:return 128;case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:return e&261888;case 262144:case 524288:case 1048576:case 2097152:return e&3932160;case 4194304:case 8388608:case 16777216:case 33554432:return e&62914560;case 67108864:return 67108864;case 134217728:return 134217728;case 268435456:return 268435456;case 536870912:return 536870912;case 1073741824:return 0;default:return e}}function qn(e,t,l){var a=e.pendingLanes;if(a===0)return 0;var n=0,i=e.suspendedLanes,c=e.pingedLanes;e=e.warmLanes;var d=a&134217727;return d!==0?(a=d&~i,a!==0?n=Hl(a):(c&=d,c!==0?n=Hl(c):l||(l=d&~e,l!==0&&(n=Hl(l))))):(d=a&~i,d!==0?n=Hl(d):c!==0?n=Hl(c):l||(l=a&~e,l!==0&&(n=Hl(l)))),n===0?0:t!==0&&t!==n&&(t&i)===0&&(i=n&-n,l=t&-t,i>=l||i===32&&(l&4194048)!==0)?t:n}function qa(e,t){return(e.pendingLanes&~(e.suspendedLanes&~e.pingedLanes)&t)===0}function yd(e,t){switch(e){case 1:case 2:case 4:case 8:case 64:return t+250;case 16:case 32:case 128:case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:case 262144:case 524288:case 1048576:case 2097152:return t+5e3;case 4194304:case 8388608:case 16777216:case 33554432:return-1;case 67108864:case 134217728:case 268435456:case 536870912:case 1073741824:return-1;default:return-1}}function Hu(){var e=Gn;return Gn<<=1,(Gn&62914560)===0&&(Gn=4194304),e}function ss(e){for(var t=[],l=0;31>l;l++)t.push(e);return t}function Ya(e,t){e.pendingLanes|=t,t!==268435456&&(e.suspendedLanes=0,e.pingedLanes=0,e.warmLanes=0)}function xd(e,t,l,a,n,i){var c=e.pendingLanes;e.pendingLanes=l,e.suspendedLanes=0,e.pingedLanes=0,e.warmLanes=0,e.expiredLanes&=l,e.entangledLanes&=l,e.errorRecoveryDisabledLanes&=l,e.shellSuspendCounter=0;var d=e.entanglements,y=e.expirationTimes,S=e.hiddenUpdates;for(l=c&~l;0<l;){var N=31-yt(l),R=1<<N;d[N]=0,y[N]=-1;var E=S[N];if(E!==null)for(S[N]=null,N=0;N<E.length;N++){var T=E[N];T!==null&&(T.lane&=-536870913)}l&=~R}a!==0&&Vu(e,a,0),i!==0&&n===0&&e.tag!==0&&(e.suspendedLanes|=i&~(c&~t))}function Vu(e,t,l){e.pendingLanes|=t,e.suspendedLanes&=~t;var a=31-yt(t);e.entangledLanes|=t,e.entanglements[a]=e.entanglements[a]|1073741824|l&261930}function Lu(e,t){var l=e.entangledLanes|=t;for(e=e.entanglements;l;){var a=31-yt(l),n=1<<a;n&t|e[a]&t&&(e[a]|=t),l&=~n}}function Gu(e,t){var l=t&-t;return l=(l&42)!==0?1:cs(l),(l&(e.suspendedLanes|t))!==0?0:l}function cs(e){switch(e){case 2:e=1;break;case 8:e=4;break;case 32:e=16;break;case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:case 262144:case 524288:case 1048576:case 2097152:case 4194304:case 8388608:case 16777216:case 33554432:e=128;break;case 268435456:e=134217728;break;default:e=0}return e}function us(e){return e&=-e,2<e?8<e?(e&134217727)!==0?32:268435456:8:2}function qu(){var e=H.p;return e!==0?e:(e=window.event,e===void 0?32:z0(e.type))}function Yu(e,t){var l=H.p;try{return H.p=e,t()}finally{H.p=l}}var fl=Math.random().toString(36).slice(2),We="__reactFiber$"+fl,st="__reactProps$"+fl,ta="__reactContainer$"+fl,rs="__reactEvents$"+fl,pd="__reactListeners$"+fl,gd="__reactHandles$"+fl,Fu="__reactResources$"+fl,Fa="__reactMarker$"+fl;function os(e){delete e[We],delete e[st],delete e[rs],delete e[pd],delete e[gd]}function la(e){var t=e[We];if(t)return t;for(var l=e.parentNode;l;){if(t=l[ta]||l[We]){if(l=t.alternate,t.child!==null||l!==null&&l.child!==null)for(e=d0(e);e!==null;){if(l=e[We])return l;e=d0(e)}return t}e=l,l=e.parentNode}return null}function aa(e){if(e=e[We]||e[ta]){var t=e.tag;if(t===5||t===6||t===13||t===31||t===26||t===27||t===3)return e}return null}function Qa(e){var t=e.tag;if(t===5||t===26||t===27||t===6)return e.stateNode;throw Error(u(33))}function na(e){var t=e[Fu];return t||(t=e[Fu]={hoistableStyles:new Map,hoistableScripts:new Map}),t}function Ze(e){e[Fa]=!0}var Qu=new Set,Xu={};function Vl(e,t){ia(e,t),ia(e+"Capture",t)}function ia(e,t){for(Xu[e]=t,e=0;e<t.length;e++)Qu.add(t[e])}var vd=RegExp("^[:A-Z_a-z\\u00C0-\\u00D6\\u00D8-\\u00F6\\u00F8-\\u02FF\\u0370-\\u037D\\u037F-\\u1FFF\\u200C-\\u200D\\u2070-\\u218F\\u2C00-\\u2FEF\\u3001-\\uD7FF\\uF900-\\uFDCF\\uFDF0-\\uFFFD][:A-Z_a-z\\u00C0-\\u00D6\\u00D8-\\u00F6\\u00F8-\\u02FF\\u0370-\\u037D\\u037F-\\u1FFF\\u200C-\\u200D\\u2070-\\u218F\\u2C00-\\u2FEF\\u3001-\\uD7FF\\uF900-\\uFDCF\\uFDF0-\\uFFFD\\-.0-9\\u00B7\\u0300-\\u036F\\u203F-\\u2040]*$"),ku={},Zu={};function bd(e){return as.call(Zu,e)?!0:as.call(ku,e)?!1:vd.test(e)?Zu[e]=!0:(ku[e]=!0,!1)}function Yn(e,t,l){if(bd(t))if(l===null)e.removeAttribute(t);else{switch(typeof l){case"undefined":case"function":case"symbol":e.removeAttribute(t);return;case"boolean":var a=t.toLowerCase().slice(0,5);if(a!=="data-"&&a!=="aria-"){e.removeAttribute(t);return}}e.setAttribute(t,""+l)}}function Fn(e,t,l){if(l===null)e.removeAttribute(t);else{switch(typeof l){case"undefined":case"function":case"symbol":case"boolean":e.removeAttribute(t);return}e.setAttribute(t,""+l)}}function Qt(e,t,l,a){if(a===null)e.removeAttribute(l);else{switch(typeof a){case"undefined":case"function":case"symbol":case"boolean":e.removeAttribute(l);return}e.setAttributeNS(t,l,""+a)}}function At(e){switch(typeof e){case"bigint":case"boolean":case"number":case"string":case"undefined":return e;case"object":return e;default:return""}}function Ku(e){var t=e.type;return(e=e.nodeName)&&e.toLowerCase()==="input"&&(t==="checkbox"||t==="radio")}function jd(e,t,l){var a=Object.getOwnPropertyDescriptor(e.constructor.prototype,t);if(!e.hasOwnProperty(t)&&typeof a<"u"&&typeof a.get=="function"&&typeof a.set=="function"){var n=a.get,i=a.set;return Object.defineProperty(e,t,{configurable:!0,get:function(){return n.call(this)},set:function(c){l=""+c,i.call(this,c)}}),Object.defineProperty(e,t,{enumerable:a.enumerable}),{getValue:function(){return l},setValue:function(c){l=""+c},stopTracking:function(){e._valueTracker=null,delete e[t]}}}}function fs(e){if(!e._valueTracker){var t=Ku(e)?"checked":"value";e._valueTracker=jd(e,t,""+e[t])}}function Ju(e){if(!e)return!1;var t=e._valueTracker;if(!t)return!0;var l=t.getValue(),a="";return e&&(a=Ku(e)?e.checked?"true":"false":e.value),e=a,e!==l?(t.setValue(e),!0):!1}function Qn(e){if(e=e||(typeof document<"u"?document:void 0),typeof e>"u")return null;try{return e.activeElement||e.body}catch{return e.body}}var Sd=/[\n"\\]/g;function Nt(e){return e.replace(Sd,function(t){return"\\"+t.charCodeAt(0).toString(16)+" "})}function ds(e,t,l,a,n,i,c,d){e.name="",c!=null&&typeof c!="function"&&typeof c!="symbol"&&typeof c!="boolean"?e.type=c:e.removeAttribute("type"),t!=null?c==="number"?(t===0&&e.value===""||e.value!=t)&&(e.value=""+At(t)):e.value!==""+At(t)&&(e.value=""+At(t)):c!=="submit"&&c!=="reset"||e.removeAttribute("value"),t!=null?ms(e,c,At(t)):l!=null?ms(e,c,At(l)):a!=null&&e.removeAttribute("value"),n==null&&i!=null&&(e.defaultChecked=!!i),n!=null&&(e.checked=n&&typeof n!="function"&&typeof n!="symbol"),d!=null&&typeof d!="function"&&typeof d!="symbol"&&typeof d!="boolean"?e.name=""+At(d):e.removeAttribute("name")}function Wu(e,t,l,a,n,i,c,d){if(i!=null&&typeof i!="function"&&typeof i!="symbol"&&typeof i!="boolean"&&(e.type=i),t!=null||l!=null){if(!(i!=="submit"&&i!=="reset"||t!=null)){fs(e);return}l=l!=null?""+At(l):"",t=t!=null?""+At(t):l,d||t===e.value||(e.value=t),e.defaultValue=t}a=a??n,a=typeof a!="function"&&typeof a!="symbol"&&!!a,e.checked=d?e.checked:!!a,e.defaultChecked=!!a,c!=null&&typeof c!="function"&&typeof c!="symbol"&&typeof c!="boolean"&&(e.name=c),fs(e)}function ms(e,t,l){t==="number"&&Qn(e.ownerDocument)===e||e.defaultValue===""+l||(e.defaultValue=""+l)}function sa(e,t,l,a){if(e=e.options,t){t={};for(var n=0;n<l.length;n++)t["$"+l[n]]=!0;for(l=0;l<e.length;l++)n=t.hasOwnProperty("$"+e[l].value),e[l].selected!==n&&(e[l].selected=n),n&&a&&(e[l].defaultSelected=!0)}else{for(l=""+At(l),t=null,n=0;n<e.length;n++){if(e[n].value===l){e[n].selected=!0,a&&(e[n].defaultSelected=!0);return}t!==null||e[n].disabled||(t=e[n])}t!==null&&(t.selected=!0)}}function $u(e,t,l){if(t!=null&&(t=""+At(t),t!==e.value&&(e.value=t),l==null)){e.defaultValue!==t&&(e.defaultValue=t);return}e.defaultValue=l!=null?""+At(l):""}function Iu(e,t,l,a){if(t==null){if(a!=null){if(l!=null)throw Error(u(92));if(at(a)){if(1<a.length)throw Error(u(93));a=a[0]}l=a}l==null&&(l=""),t=l}l=At(t),e.defaultValue=l,a=e.textContent,a===l&&a!==""&&a!==null&&(e.value=a),fs(e)}function ca(e,t){if(t){var l=e.firstChild;if(l&&l===e.lastChild&&l.nodeType===3){l.nodeValue=t;return}}e.textContent=t}var Ed=new Set("animationIterationCount aspectRatio borderImageOutset borderImageSlice borderImageWidth boxFlex boxFlexGroup boxOrdinalGroup columnCount columns flex flexGrow flexPositive flexShrink flexNegative flexOrder gridArea gridRow gridRowEnd gridRowSpan gridRowStart gridColumn gridColumnEnd gridColumnSpan gridColumnStart fontWeight lineClamp lineHeight opacity order orphans scale tabSize widows zIndex zoom fillOpacity floodOpacity stopOpacity strokeDasharray strokeDashoffset strokeMiterlimit strokeOpacity strokeWidth MozAnimationIterationCount MozBoxFlex MozBoxFlexGroup MozLineClamp msAnimationIterationCount msFlex msZoom msFlexGrow msFlexNegative msFlexOrder msFlexPositive msFlexShrink msGridColumn msGridColumnSpan msGridRow msGridRowSpan WebkitAnimationIterationCount WebkitBoxFlex WebKitBoxFlexGroup WebkitBoxOrdinalGroup WebkitColumnCount WebkitColumns WebkitFlex WebkitFlexGrow WebkitFlexPositive WebkitFlexShrink WebkitLineClamp".split(" "));function Pu(e,t,l){var a=t.indexOf("--")===0;l==null||typeof l=="boolean"||l===""?a?e.setProperty(t,""):t==="float"?e.cssFloat="":e[t]="":a?e.setProperty(t,l):typeof l!="number"||l===0||Ed.has(t)?t==="float"?e.cssFloat=l:e[t]=(""+l).trim():e[t]=l+"px"}function er(e,t,l){if(t!=null&&typeof t!="object")throw Error(u(62));if(e=e.style,l!=null){for(var a in l)!l.hasOwnProperty(a)||t!=null&&t.hasOwnProperty(a)||(a.indexOf("--")===0?e.setProperty(a,""):a==="float"?e.cssFloat="":e[a]="");for(var n in t)a=t[n],t.hasOwnProperty(n)&&l[n]!==a&&Pu(e,n,a)}else for(var i in t)t.hasOwnProperty(i)&&Pu(e,i,t[i])}function hs(e){if(e.indexOf("-")===-1)return!1;switch(e){case"annotation-xml":case"color-profile":case"font-face":case"font-face-src":case"font-face-uri":case"font-face-format":case"font-face-name":case"missing-glyph":return!1;default:return!0}}var Td=new Map([["acceptCharset","accept-charset"],["htmlFor","for"],["httpEquiv","http-equiv"],["crossOrigin","crossorigin"],["accentHeight","accent-height"],["alignmentBaseline","alignment-baseline"],["arabicForm","arabic-form"],["baselineShift","baseline-shift"],["capHeight","cap-height"],["clipPath","clip-path"],["clipRule","clip-rule"],["colorInterpolation","color-interpolation"],["colorInterpolationFilters","color-interpolation-filters"],["colorProfile","color-profile"],["colorRendering","color-rendering"],["dominantBaseline","dominant-baseline"],["enableBackground","enable-background"],["fillOpacity","fill-opacity"],["fillRule","fill-rule"],["floodColor","flood-color"],["floodOpacity","flood-opacity"],["fontFamily","font-family"],["fontSize","font-size"],["fontSizeAdjust","font-size-adjust"],["fontStretch","font-stretch"],["fontStyle","font-style"],["fontVariant","font-variant"],["fontWeight","font-weight"],["glyphName","glyph-name"],["glyphOrientationHorizontal","glyph-orientation-horizontal"],["glyphOrientationVertical","glyph-orientation-vertical"],["horizAdvX","horiz-adv-x"],["horizOriginX","horiz-origin-x"],["imageRendering","image-rendering"],["letterSpacing","letter-spacing"],["lightingColor","lighting-color"],["markerEnd","marker-end"],["markerMid","marker-mid"],["markerStart","marker-start"],["overlinePosition","overline-position"],["overlineThickness","overline-thickness"],["paintOrder","paint-order"],["panose-1","panose-1"],["pointerEvents","pointer-events"],["renderingIntent","rendering-intent"],["shapeRendering","shape-rendering"],["stopColor","stop-color"],["stopOpacity","stop-opacity"],["strikethroughPosition","strikethrough-position"],["strikethroughThickness","strikethrough-thickness"],["strokeDasharray","stroke-dasharray"],["strokeDashoffset","stroke-dashoffset"],["strokeLinecap","stroke-linecap"],["strokeLinejoin","stroke-linejoin"],["strokeMiterlimit","stroke-miterlimit"],["strokeOpacity","stroke-opacity"],["strokeWidth","stroke-width"],["textAnchor","text-anchor"],["textDecoration","text-decoration"],["textRendering","text-rendering"],["transformOrigin","transform-origin"],["underlinePosition","underline-position"],["underlineThickness","underline-thickness"],["unicodeBidi","unicode-bidi"],["unicodeRange","unicode-range"],["unitsPerEm","units-per-em"],["vAlphabetic","v-alphabetic"],["vHanging","v-hanging"],["vIdeographic","v-ideographic"],["vMathematical","v-mathematical"],["vectorEffect","vector-effect"],["vertAdvY","vert-adv-y"],["vertOriginX","vert-origin-x"],["vertOriginY","vert-origin-y"],["wordSpacing","word-spacing"],["writingMode","writing-mode"],["xmlnsXlink","xmlns:xlink"],["xHeight","x-height"]]),Ad=/^[\u0000-\u001F ]*j[\r\n\t]*a[\r\n\t]*v[\r\n\t]*a[\r\n\t]*s[\r\n\t]*c[\r\n\t]*r[\r\n\t]*i[\r\n\t]*p[\r\n\t]*t[\r\n\t]*:/i;function Xn(e){return Ad.test(""+e)?"javascript:throw new Error('React has blocked a javascript: URL as a security precaution.')":e}function Xt(){}var ys=null;function xs(e){return e=e.target||e.srcElement||window,e.correspondingUseElement&&(e=e.correspondingUseElement),e.nodeType===3?e.parentNode:e}var ua=null,ra=null;function tr(e){var t=aa(e);if(t&&(e=t.stateNode)){var l=e[st]||null;e:switch(e=t.stateNode,t.type){case"input":if(ds(e,l.value,l.defaultValue,l.defaultValue,l.checked,l.defaultChecked,l.type,l.name),t=l.name,l.type==="radio"&&t!=null){for(l=e;l.parentNode;)l=l.parentNode;for(l=l.querySelectorAll('input[name="'+Nt(""+t)+'"][type="radio"]'),t=0;t<l.length;t++){var a=l[t];if(a!==e&&a.form===e.form){var n=a[st]||null;if(!n)throw Error(u(90));ds(a,n.value,n.defaultValue,n.defaultValue,n.checked,n.defaultChecked,n.type,n.name)}}for(t=0;t<l.length;t++)a=l[t],a.form===e.form&&Ju(a)}break e;case"textarea":$u(e,l.value,l.defaultValue);break e;case"select":t=l.value,t!=null&&sa(e,!!l.multiple,t,!1)}}}var ps=!1;function lr(e,t,l){if(ps)return e(t,l);ps=!0;try{var a=e(t);return a}finally{if(ps=!1,(ua!==null||ra!==null)&&(Di(),ua&&(t=ua,e=ra,ra=ua=null,tr(t),e)))for(t=0;t<e.length;t++)tr(e[t])}}function Xa(e,t){var l=e.stateNode;if(l===null)return null;var a=l[st]||null;if(a===null)return null;l=a[t];e:switch(t){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":case"onMouseEnter":(a=!a.disabled)||(e=e.type,a=!(e==="button"||e==="input"||e==="select"||e==="textarea")),e=!a;break e;default:e=!1}if(e)return null;if(l&&typeof l!="function")throw Error(u(231,t,typeof l));return l}var kt=!(typeof window>"u"||typeof window.document>"u"||typeof window.document.createElement>"u"),gs=!1;if(kt)try{var ka={};Object.defineProperty(ka,"passive",{get:function(){gs=!0}}),window.addEventListener("test",ka,ka),window.removeEventListener("test",ka,ka)}catch{gs=!1}var dl=null,vs=null,kn=null;function ar(){if(kn)return kn;var e,t=vs,l=t.length,a,n="value"in dl?dl.value:dl.textContent,i=n.length;for(e=0;e<l&&t[e]===n[e];e++);var c=l-e;for(a=1;a<=c&&t[l-a]===n[i-a];a++);return kn=n.slice(e,1<a?1-a:void 0)}function Zn(e){var t=e.keyCode;return"charCode"in e?(e=e.charCode,e===0&&t===13&&(e=13)):e=t,e===10&&(e=13),32<=e||e===13?e:0}function Kn(){return!0}function nr(){return!1}function ct(e){function t(l,a,n,i,c){this._reactName=l,this._targetInst=n,this.type=a,this.nativeEvent=i,this.target=c,this.currentTarget=null;for(var d in e)e.hasOwnProperty(d)&&(l=e[d],this[d]=l?l(i):i[d]);return this.isDefaultPrevented=(i.defaultPrevented!=null?i.defaultPrevented:i.returnValue===!1)?Kn:nr,this.isPropagationStopped=nr,this}return L(t.prototype,{preventDefault:function(){this.defaultPrevented=!0;var l=this.nativeEvent;l&&(l.preventDefault?l.preventDefault():typeof l.returnValue!="unknown"&&(l.returnValue=!1),this.isDefaultPrevented=Kn)},stopPropagation:function(){var l=this.nativeEvent;l&&(l.stopPropagation?l.stopPropagation():typeof l.cancelBubble!="unknown"&&(l.cancelBubble=!0),this.isPropagationStopped=Kn)},persist:function(){},isPersistent:Kn}),t}var Ll={eventPhase:0,bubbles:0,cancelable:0,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:0,isTrusted:0},Jn=ct(Ll),Za=L({},Ll,{view:0,detail:0}),Nd=ct(Za),bs,js,Ka,Wn=L({},Za,{screenX:0,screenY:0,clientX:0,clientY:0,pageX:0,pageY:0,ctrlKey:0,shiftKey:0,altKey:0,metaKey:0,getModifierState:Es,button:0,buttons:0,relatedTarget:function(e){return e.relatedTarget===void 0?e.fromElement===e.srcElement?e.toElement:e.fromElement:e.relatedTarget},movementX:function(e){return"movementX"in e?e.movementX:(e!==Ka&&(Ka&&e.type==="mousemove"?(bs=e.screenX-Ka.screenX,js=e.screenY-Ka.screenY):js=bs=0,Ka=e),bs)},movementY:function(e){return"movementY"in e?e.movementY:js}}),ir=ct(Wn),Cd=L({},Wn,{dataTransfer:0}),zd=ct(Cd),Md=L({},Za,{relatedTarget:0}),Ss=ct(Md),Rd=L({},Ll,{animationName:0,elapsedTime:0,pseudoElement:0}),Od=ct(Rd),Dd=L({},Ll,{clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}}),_d=ct(Dd),wd=L({},Ll,{data:0}),sr=ct(wd),Bd={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},Ud={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"},Hd={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"};function Vd(e){var t=this.nativeEvent;return t.getModifierState?t.getModifierState(e):(e=Hd[e])?!!t[e]:!1}function Es(){return Vd}var Ld=L({},Za,{key:function(e){if(e.key){var t=Bd[e.key]||e.key;if(t!=="Unidentified")return t}return e.type==="keypress"?(e=Zn(e),e===13?"Enter":String.fromCharCode(e)):e.type==="keydown"||e.type==="keyup"?Ud[e.keyCode]||"Unidentified":""},code:0,location:0,ctrlKey:0,shiftKey:0,altKey:0,metaKey:0,repeat:0,locale:0,getModifierState:Es,charCode:function(e){return e.type==="keypress"?Zn(e):0},keyCode:function(e){return e.type==="keydown"||e.type==="keyup"?e.keyCode:0},which:function(e){return e.type==="keypress"?Zn(e):e.type==="keydown"||e.type==="keyup"?e.keyCode:0}}),Gd=ct(Ld),qd=L({},Wn,{pointerId:0,width:0,height:0,pressure:0,tangentialPressure:0,tiltX:0,tiltY:0,twist:0,pointerType:0,isPrimary:0}),cr=ct(qd),Yd=L({},Za,{touches:0,targetTouches:0,changedTouches:0,altKey:0,metaKey:0,ctrlKey:0,shiftKey:0,getModifierState:Es}),Fd=ct(Yd),Qd=L({},Ll,{propertyName:0,elapsedTime:0,pseudoElement:0}),Xd=ct(Qd),kd=L({},Wn,{deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:0,deltaMode:0}),Zd=ct(kd),Kd=L({},Ll,{newState:0,oldState:0}),Jd=ct(Kd),Wd=[9,13,27,32],Ts=kt&&"CompositionEvent"in window,Ja=null;kt&&"documentMode"in document&&(Ja=document.documentMode);var $d=kt&&"TextEvent"in window&&!Ja,ur=kt&&(!Ts||Ja&&8<Ja&&11>=Ja),rr=" ",or=!1;function fr(e,t){switch(e){case"keyup":return Wd.indexOf(t.keyCode)!==-1;case"keydown":return t.keyCode!==229;case"keypress":case"mousedown":case"focusout":return!0;default:return!1}}function dr(e){return e=e.detail,typeof e=="object"&&"data"in e?e.data:null}var oa=!1;function Id(e,t){switch(e){case"compositionend":return dr(t);case"keypress":return t.which!==32?null:(or=!0,rr);case"textInput":return e=t.data,e===rr&&or?null:e;default:return null}}function Pd(e,t){if(oa)return e==="compositionend"||!Ts&&fr(e,t)?(e=ar(),kn=vs=dl=null,oa=!1,e):null;switch(e){case"paste":return null;case"keypress":if(!(t.ctrlKey||t.altKey||t.metaKey)||t.ctrlKey&&t.altKey){if(t.char&&1<t.char.length)return t.char;if(t.which)return String.fromCharCode(t.which)}return null;case"compositionend":return ur&&t.locale!=="ko"?null:t.data;default:return null}}var em={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0};function mr(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t==="input"?!!em[e.type]:t==="textarea"}function hr(e,t,l,a){ua?ra?ra.push(a):ra=[a]:ua=a,t=Li(t,"onChange"),0<t.length&&(l=new Jn("onChange","change",null,l,a),e.push({event:l,listeners:t}))}var Wa=null,$a=null;function tm(e){If(e,0)}function $n(e){var t=Qa(e);if(Ju(t))return e}function yr(e,t){if(e==="change")return t}var xr=!1;if(kt){var As;if(kt){var Ns="oninput"in document;if(!Ns){var pr=document.createElement("div");pr.setAttribute("oninput","return;"),Ns=typeof pr.oninput=="function"}As=Ns}else As=!1;xr=As&&(!document.documentMode||9<document.documentMode)}function gr(){Wa&&(Wa.detachEvent("onpropertychange",vr),$a=Wa=null)}function vr(e){if(e.propertyName==="value"&&$n($a)){var t=[];hr(t,$a,e,xs(e)),lr(tm,t)}}function lm(e,t,l){e==="focusin"?(gr(),Wa=t,$a=l,Wa.attachEvent("onpropertychange",vr)):e==="focusout"&&gr()}function am(e){if(e==="selectionchange"||e==="keyup"||e==="keydown")return $n($a)}function nm(e,t){if(e==="click")return $n(t)}function im(e,t){if(e==="input"||e==="change")return $n(t)}function sm(e,t){return e===t&&(e!==0||1/e===1/t)||e!==e&&t!==t}var xt=typeof Object.is=="function"?Object.is:sm;function Ia(e,t){if(xt(e,t))return!0;if(typeof e!="object"||e===null||typeof t!="object"||t===null)return!1;var l=Object.keys(e),a=Object.keys(t);if(l.length!==a.length)return!1;for(a=0;a<l.length;a++){var n=l[a];if(!as.call(t,n)||!xt(e[n],t[n]))return!1}return!0}function br(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function jr(e,t){var l=br(e);e=0;for(var a;l;){if(l.nodeType===3){if(a=e+l.textContent.length,e<=t&&a>=t)return{node:l,offset:t-e};e=a}e:{for(;l;){if(l.nextSibling){l=l.nextSibling;break e}l=l.parentNode}l=void 0}l=br(l)}}function Sr(e,t){return e&&t?e===t?!0:e&&e.nodeType===3?!1:t&&t.nodeType===3?Sr(e,t.parentNode):"contains"in e?e.contains(t):e.compareDocumentPosition?!!(e.compareDocumentPosition(t)&16):!1:!1}function Er(e){e=e!=null&&e.ownerDocument!=null&&e.ownerDocument.defaultView!=null?e.ownerDocument.defaultView:window;for(var t=Qn(e.document);t instanceof e.HTMLIFrameElement;){try{var l=typeof t.contentWindow.location.href=="string"}catch{l=!1}if(l)e=t.contentWindow;else break;t=Qn(e.document)}return t}function Cs(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&(t==="input"&&(e.type==="text"||e.type==="search"||e.type==="tel"||e.type==="url"||e.type==="password")||t==="textarea"||e.contentEditable==="true")}var cm=kt&&"documentMode"in document&&11>=document.documentMode,fa=null,zs=null,Pa=null,Ms=!1;function Tr(e,t,l){var a=l.window===l?l.document:l.nodeType===9?l:l.ownerDocument;Ms||fa==null||fa!==Qn(a)||(a=fa,"selectionStart"in a&&Cs(a)?a={start:a.selectionStart,end:a.selectionEnd}:(a=(a.ownerDocument&&a.ownerDocument.defaultView||window).getSelection(),a={anchorNode:a.anchorNode,anchorOffset:a.anchorOffset,focusNode:a.focusNode,focusOffset:a.focusOffset}),Pa&&Ia(Pa,a)||(Pa=a,a=Li(zs,"onSelect"),0<a.length&&(t=new Jn("onSelect","select",null,t,l),e.push({event:t,listeners:a}),t.target=fa)))}function Gl(e,t){var l={};return l[e.toLowerCase()]=t.toLowerCase(),l["Webkit"+e]="webkit"+t,l["Moz"+e]="moz"+t,l}var da={animationend:Gl("Animation","AnimationEnd"),animationiteration:Gl("Animation","AnimationIteration"),animationstart:Gl("Animation","AnimationStart"),transitionrun:Gl("Transition","TransitionRun"),transitionstart:Gl("Transition","TransitionStart"),transitioncancel:Gl("Transition","TransitionCancel"),transitionend:Gl("Transition","TransitionEnd")},Rs={},Ar={};kt&&(Ar=document.createElement("div").style,"AnimationEvent"in window||(delete da.animationend.animation,delete da.animationiteration.animation,delete da.animationstart.animation),"TransitionEvent"in window||delete da.transitionend.transition);function ql(e){if(Rs[e])return Rs[e];if(!da[e])return e;var t=da[e],l;for(l in t)if(t.hasOwnProperty(l)&&l in Ar)return Rs[e]=t[l];return e}var Nr=ql("animationend"),Cr=ql("animationiteration"),zr=ql("animationstart"),um=ql("transitionrun"),rm=ql("transitionstart"),om=ql("transitioncancel"),Mr=ql("transitionend"),Rr=new Map,Os="abort auxClick beforeToggle cancel canPlay canPlayThrough click close contextMenu copy cut drag dragEnd dragEnter dragExit dragLeave dragOver dragStart drop durationChange emptied encrypted ended error gotPointerCapture input invalid keyDown keyPress keyUp load loadedData loadedMetadata loadStart lostPointerCapture mouseDown mouseMove mouseOut mouseOver mouseUp paste pause play playing pointerCancel pointerDown pointerMove pointerOut pointerOver pointerUp progress rateChange reset resize seeked seeking stalled submit suspend timeUpdate touchCancel touchEnd touchStart volumeChange scroll toggle touchMove waiting wheel".split(" ");Os.push("scrollEnd");function Bt(e,t){Rr.set(e,t),Vl(t,[e])}var In=typeof reportError=="function"?reportError:function(e){if(typeof window=="object"&&typeof window.ErrorEvent=="function"){var t=new window.ErrorEvent("error",{bubbles:!0,cancelable:!0,message:typeof e=="object"&&e!==null&&typeof e.message=="string"?String(e.message):String(e),error:e});if(!window.dispatchEvent(t))return}else if(typeof process=="object"&&typeof process.emit=="function"){process.emit("uncaughtException",e);return}console.error(e)},Ct=[],ma=0,Ds=0;function Pn(){for(var e=ma,t=Ds=ma=0;t<e;){var l=Ct[t];Ct[t++]=null;var a=Ct[t];Ct[t++]=null;var n=Ct[t];Ct[t++]=null;var i=Ct[t];if(Ct[t++]=null,a!==null&&n!==null){var c=a.pending;c===null?n.next=n:(n.next=c.next,c.next=n),a.pending=n}i!==0&&Or(l,n,i)}}function ei(e,t,l,a){Ct[ma++]=e,Ct[ma++]=t,Ct[ma++]=l,Ct[ma++]=a,Ds|=a,e.lanes|=a,e=e.alternate,e!==null&&(e.lanes|=a)}function _s(e,t,l,a){return ei(e,t,l,a),ti(e)}function Yl(e,t){return ei(e,null,null,t),ti(e)}function Or(e,t,l){e.lanes|=l;var a=e.alternate;a!==null&&(a.lanes|=l);for(var n=!1,i=e.return;i!==null;)i.childLanes|=l,a=i.alternate,a!==null&&(a.childLanes|=l),i.tag===22&&(e=i.stateNode,e===null||e._visibility&1||(n=!0)),e=i,i=i.return;return e.tag===3?(i=e.stateNode,n&&t!==null&&(n=31-yt(l),e=i.hiddenUpdates,a=e[n],a===null?e[n]=[t]:a.push(t),t.lane=l|536870912),i):null}function ti(e){if(50<Sn)throw Sn=0,Yc=null,Error(u(185));for(var t=e.return;t!==null;)e=t,t=e.return;return e.tag===3?e.stateNode:null}var ha={};function fm(e,t,l,a){this.tag=e,this.key=l,this.sibling=this.child=this.return=this.stateNode=this.type=this.elementType=null,this.index=0,this.refCleanup=this.ref=null,this.pendingProps=t,this.dependencies=this.memoizedState=this.updateQueue=this.memoizedProps=null,this.mode=a,this.subtreeFlags=this.flags=0,this.deletions=null,this.childLanes=this.lanes=0,this.alternate=null}function pt(e,t,l,a){return new fm(e,t,l,a)}function ws(e){return e=e.prototype,!(!e||!e.isReactComponent)}function Zt(e,t){var l=e.alternate;return l===null?(l=pt(e.tag,t,e.key,e.mode),l.elementType=e.elementType,l.type=e.type,l.stateNode=e.stateNode,l.alternate=e,e.alternate=l):(l.pendingProps=t,l.type=e.type,l.flags=0,l.subtreeFlags=0,l.deletions=null),l.flags=e.flags&65011712,l.childLanes=e.childLanes,l.lanes=e.lanes,l.child=e.child,l.memoizedProps=e.memoizedProps,l.memoizedState=e.memoizedState,l.updateQueue=e.updateQueue,t=e.dependencies,l.dependencies=t===null?null:{lanes:t.lanes,firstContext:t.firstContext},l.sibling=e.sibling,l.index=e.index,l.ref=e.ref,l.refCleanup=e.refCleanup,l}function Dr(e,t){e.flags&=65011714;var l=e.alternate;return l===null?(e.childLanes=0,e.lanes=t,e.child=null,e.subtreeFlags=0,e.memoizedProps=null,e.memoizedState=null,e.updateQueue=null,e.dependencies=null,e.stateNode=null):(e.childLanes=l.childLanes,e.lanes=l.lanes,e.child=l.child,e.subtreeFlags=0,e.deletions=null,e.memoizedProps=l.memoizedProps,e.memoizedState=l.memoizedState,e.updateQueue=l.updateQueue,e.type=l.type,t=l.dependencies,e.dependencies=t===null?null:{lanes:t.lanes,firstContext:t.firstContext}),e}function li(e,t,l,a,n,i){var c=0;if(a=e,typeof e=="function")ws(e)&&(c=1);else if(typeof e=="string")c=xh(e,l,U.current)?26:e==="html"||e==="head"||e==="body"?27:5;else e:switch(e){case Ve:return e=pt(31,l,t,n),e.elementType=Ve,e.lanes=i,e;case se:return Fl(l.children,n,i,t);case be:c=8,n|=24;break;case pe:return e=pt(12,l,t,n|2),e.elementType=pe,e.lanes=i,e;case Ne:return e=pt(13,l,t,n),e.elementType=Ne,e.lanes=i,e;case ze:return e=pt(19,l,t,n),e.elementType=ze,e.lanes=i,e;default:if(typeof e=="object"&&e!==null)switch(e.$$typeof){case G:c=10;break e;case V:c=9;break e;case ce:c=11;break e;case ee:c=14;break e;case ge:c=16,a=null;break e}c=29,l=Error(u(130,e===null?"null":typeof e,"")),a=null}return t=pt(c,l,t,n),t.elementType=e,t.type=a,t.lanes=i,t}function Fl(e,t,l,a){return e=pt(7,e,a,t),e.lanes=l,e}function Bs(e,t,l){return e=pt(6,e,null,t),e.lanes=l,e}function _r(e){var t=pt(18,null,null,0);return t.stateNode=e,t}function Us(e,t,l){return t=pt(4,e.children!==null?e.children:[],e.key,t),t.lanes=l,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}var wr=new WeakMap;function zt(e,t){if(typeof e=="object"&&e!==null){var l=wr.get(e);return l!==void 0?l:(t={value:e,source:t,stack:_u(t)},wr.set(e,t),t)}return{value:e,source:t,stack:_u(t)}}var ya=[],xa=0,ai=null,en=0,Mt=[],Rt=0,ml=null,Lt=1,Gt="";function Kt(e,t){ya[xa++]=en,ya[xa++]=ai,ai=e,en=t}function Br(e,t,l){Mt[Rt++]=Lt,Mt[Rt++]=Gt,Mt[Rt++]=ml,ml=e;var a=Lt;e=Gt;var n=32-yt(a)-1;a&=~(1<<n),l+=1;var i=32-yt(t)+n;if(30<i){var c=n-n%5;i=(a&(1<<c)-1).toString(32),a>>=c,n-=c,Lt=1<<32-yt(t)+n|l<<n|a,Gt=i+e}else Lt=1<<i|l<<n|a,Gt=e}function Hs(e){e.return!==null&&(Kt(e,1),Br(e,1,0))}function Vs(e){for(;e===ai;)ai=ya[--xa],ya[xa]=null,en=ya[--xa],ya[xa]=null;for(;e===ml;)ml=Mt[--Rt],Mt[Rt]=null,Gt=Mt[--Rt],Mt[Rt]=null,Lt=Mt[--Rt],Mt[Rt]=null}function Ur(e,t){Mt[Rt++]=Lt,Mt[Rt++]=Gt,Mt[Rt++]=ml,Lt=t.id,Gt=t.overflow,ml=e}var $e=null,Re=null,me=!1,hl=null,Ot=!1,Ls=Error(u(519));function yl(e){var t=Error(u(418,1<arguments.length&&arguments[1]!==void 0&&arguments[1]?"text":"HTML",""));throw tn(zt(t,e)),Ls}function Hr(e){var t=e.stateNode,l=e.type,a=e.memoizedProps;switch(t[We]=e,t[st]=a,l){case"dialog":oe("cancel",t),oe("close",t);break;case"iframe":case"object":case"embed":oe("load",t);break;case"video":case"audio":for(l=0;l<Tn.length;l++)oe(Tn[l],t);break;case"source":oe("error",t);break;case"img":case"image":case"link":oe("error",t),oe("load",t);break;case"details":oe("toggle",t);break;case"input":oe("invalid",t),Wu(t,a.value,a.defaultValue,a.checked,a.defaultChecked,a.type,a.name,!0);break;case"select":oe("invalid",t);break;case"textarea":oe("invalid",t),Iu(t,a.value,a.defaultValue,a.children)}l=a.children,typeof l!="string"&&typeof l!="number"&&typeof l!="bigint"||t.textContent===""+l||a.suppressHydrationWarning===!0||l0(t.textContent,l)?(a.popover!=null&&(oe("beforetoggle",t),oe("toggle",t)),a.onScroll!=null&&oe("scroll",t),a.onScrollEnd!=null&&oe("scrollend",t),a.onClick!=null&&(t.onclick=Xt),t=!0):t=!1,t||yl(e,!0)}function Vr(e){for($e=e.return;$e;)switch($e.tag){case 5:case 31:case 13:Ot=!1;return;case 27:case 3:Ot=!0;return;default:$e=$e.return}}function pa(e){if(e!==$e)return!1;if(!me)return Vr(e),me=!0,!1;var t=e.tag,l;if((l=t!==3&&t!==27)&&((l=t===5)&&(l=e.type,l=!(l!=="form"&&l!=="button")||au(e.type,e.memoizedProps)),l=!l),l&&Re&&yl(e),Vr(e),t===13){if(e=e.memoizedState,e=e!==null?e.dehydrated:null,!e)throw Error(u(317));Re=f0(e)}else if(t===31){if(e=e.memoizedState,e=e!==null?e.dehydrated:null,!e)throw Error(u(317));Re=f0(e)}else t===27?(t=Re,Ml(e.type)?(e=uu,uu=null,Re=e):Re=t):Re=$e?_t(e.stateNode.nextSibling):null;return!0}function Ql(){Re=$e=null,me=!1}function Gs(){var e=hl;return e!==null&&(ft===null?ft=e:ft.push.apply(ft,e),hl=null),e}function tn(e){hl===null?hl=[e]:hl.push(e)}var qs=h(null),Xl=null,Jt=null;function xl(e,t,l){D(qs,t._currentValue),t._currentValue=l}function Wt(e){e._currentValue=qs.current,g(qs)}function Ys(e,t,l){for(;e!==null;){var a=e.alternate;if((e.childLanes&t)!==t?(e.childLanes|=t,a!==null&&(a.childLanes|=t)):a!==null&&(a.childLanes&t)!==t&&(a.childLanes|=t),e===l)break;e=e.return}}function Fs(e,t,l,a){var n=e.child;for(n!==null&&(n.return=e);n!==null;){var i=n.dependencies;if(i!==null){var c=n.child;i=i.firstContext;e:for(;i!==null;){var d=i;i=n;for(var y=0;y<t.length;y++)if(d.context===t[y]){i.lanes|=l,d=i.alternate,d!==null&&(d.lanes|=l),Ys(i.return,l,e),a||(c=null);break e}i=d.next}}else if(n.tag===18){if(c=n.return,c===null)throw Error(u(341));c.lanes|=l,i=c.alternate,i!==null&&(i.lanes|=l),Ys(c,l,e),c=null}else c=n.child;if(c!==null)c.return=n;else for(c=n;c!==null;){if(c===e){c=null;break}if(n=c.sibling,n!==null){n.return=c.return,c=n;break}c=c.return}n=c}}function ga(e,t,l,a){e=null;for(var n=t,i=!1;n!==null;){if(!i){if((n.flags&524288)!==0)i=!0;else if((n.flags&262144)!==0)break}if(n.tag===10){var c=n.alternate;if(c===null)throw Error(u(387));if(c=c.memoizedProps,c!==null){var d=n.type;xt(n.pendingProps.value,c.value)||(e!==null?e.push(d):e=[d])}}else if(n===w.current){if(c=n.alternate,c===null)throw Error(u(387));c.memoizedState.memoizedState!==n.memoizedState.memoizedState&&(e!==null?e.push(Mn):e=[Mn])}n=n.return}e!==null&&Fs(t,e,l,a),t.flags|=262144}function ni(e){for(e=e.firstContext;e!==null;){if(!xt(e.context._currentValue,e.memoizedValue))return!0;e=e.next}return!1}function kl(e){Xl=e,Jt=null,e=e.dependencies,e!==null&&(e.firstContext=null)}function Ie(e){return Lr(Xl,e)}function ii(e,t){return Xl===null&&kl(e),Lr(e,t)}function Lr(e,t){var l=t._currentValue;if(t={context:t,memoizedValue:l,next:null},Jt===null){if(e===null)throw Error(u(308));Jt=t,e.dependencies={lanes:0,firstContext:t},e.flags|=524288}else Jt=Jt.next=t;return l}var dm=typeof AbortController<"u"?AbortController:function(){var e=[],t=this.signal={aborted:!1,addEventListener:function(l,a){e.push(a)}};this.abort=function(){t.aborted=!0,e.forEach(function(l){return l()})}},mm=o.unstable_scheduleCallback,hm=o.unstable_NormalPriority,Le={$$typeof:G,Consumer:null,Provider:null,_currentValue:null,_currentValue2:null,_threadCount:0};function Qs(){return{controller:new dm,data:new Map,refCount:0}}function ln(e){e.refCount--,e.refCount===0&&mm(hm,function(){e.controller.abort()})}var an=null,Xs=0,va=0,ba=null;function ym(e,t){if(an===null){var l=an=[];Xs=0,va=Kc(),ba={status:"pending",value:void 0,then:function(a){l.push(a)}}}return Xs++,t.then(Gr,Gr),t}function Gr(){if(--Xs===0&&an!==null){ba!==null&&(ba.status="fulfilled");var e=an;an=null,va=0,ba=null;for(var t=0;t<e.length;t++)(0,e[t])()}}function xm(e,t){var l=[],a={status:"pending",value:null,reason:null,then:function(n){l.push(n)}};return e.then(function(){a.status="fulfilled",a.value=t;for(var n=0;n<l.length;n++)(0,l[n])(t)},function(n){for(a.status="rejected",a.reason=n,n=0;n<l.length;n++)(0,l[n])(void 0)}),a}var qr=A.S;A.S=function(e,t){Nf=mt(),typeof t=="object"&&t!==null&&typeof t.then=="function"&&ym(e,t),qr!==null&&qr(e,t)};var Zl=h(null);function ks(){var e=Zl.current;return e!==null?e:Ce.pooledCache}function si(e,t){t===null?D(Zl,Zl.current):D(Zl,t.pool)}function Yr(){var e=ks();return e===null?null:{parent:Le._currentValue,pool:e}}var ja=Error(u(460)),Zs=Error(u(474)),ci=Error(u(542)),ui={then:function(){}};function Fr(e){return e=e.status,e==="fulfilled"||e==="rejected"}function Qr(e,t,l){switch(l=e[l],l===void 0?e.push(t):l!==t&&(t.then(Xt,Xt),t=l),t.status){case"fulfilled":return t.value;case"rejected":throw e=t.reason,kr(e),e;default:if(typeof t.status=="string")t.then(Xt,Xt);else{if(e=Ce,e!==null&&100<e.shellSuspendCounter)throw Error(u(482));e=t,e.status="pending",e.then(function(a){if(t.status==="pending"){var n=t;n.status="fulfilled",n.value=a}},function(a){if(t.status==="pending"){var n=t;n.status="rejected",n.reason=a}})}switch(t.status){case"fulfilled":return t.value;case"rejected":throw e=t.reason,kr(e),e}throw Jl=t,ja}}function Kl(e){try{var t=e._init;return t(e._payload)}catch(l){throw l!==null&&typeof l=="object"&&typeof l.then=="function"?(Jl=l,ja):l}}var Jl=null;function Xr(){if(Jl===null)throw Error(u(459));var e=Jl;return Jl=null,e}function kr(e){if(e===ja||e===ci)throw Error(u(483))}var Sa=null,nn=0;function ri(e){var t=nn;return nn+=1,Sa===null&&(Sa=[]),Qr(Sa,e,t)}function sn(e,t){t=t.props.ref,e.ref=t!==void 0?t:null}function oi(e,t){throw t.$$typeof===k?Error(u(525)):(e=Object.prototype.toString.call(t),Error(u(31,e==="[object Object]"?"object with keys {"+Object.keys(t).join(", ")+"}":e)))}function Zr(e){function t(v,p){if(e){var j=v.deletions;j===null?(v.deletions=[p],v.flags|=16):j.push(p)}}function l(v,p){if(!e)return null;for(;p!==null;)t(v,p),p=p.sibling;return null}function a(v){for(var p=new Map;v!==null;)v.key!==null?p.set(v.key,v):p.set(v.index,v),v=v.sibling;return p}function n(v,p){return v=Zt(v,p),v.index=0,v.sibling=null,v}function i(v,p,j){return v.index=j,e?(j=v.alternate,j!==null?(j=j.index,j<p?(v.flags|=67108866,p):j):(v.flags|=67108866,p)):(v.flags|=1048576,p)}function c(v){return e&&v.alternate===null&&(v.flags|=67108866),v}function d(v,p,j,C){return p===null||p.tag!==6?(p=Bs(j,v.mode,C),p.return=v,p):(p=n(p,j),p.return=v,p)}function y(v,p,j,C){var J=j.type;return J===se?N(v,p,j.props.children,C,j.key):p!==null&&(p.elementType===J||typeof J=="object"&&J!==null&&J.$$typeof===ge&&Kl(J)===p.type)?(p=n(p,j.props),sn(p,j),p.return=v,p):(p=li(j.type,j.key,j.props,null,v.mode,C),sn(p,j),p.return=v,p)}function S(v,p,j,C){return p===null||p.tag!==4||p.stateNode.containerInfo!==j.containerInfo||p.stateNode.implementation!==j.implementation?(p=Us(j,v.mode,C),p.return=v,p):(p=n(p,j.children||[]),p.return=v,p)}function N(v,p,j,C,J){return p===null||p.tag!==7?(p=Fl(j,v.mode,C,J),p.return=v,p):(p=n(p,j),p.return=v,p)}function R(v,p,j){if(typeof p=="string"&&p!==""||typeof p=="number"||typeof p=="bigint")return p=Bs(""+p,v.mode,j),p.return=v,p;if(typeof p=="object"&&p!==null){switch(p.$$typeof){case W:return j=li(p.type,p.key,p.props,null,v.mode,j),sn(j,p),j.return=v,j;case I:return p=Us(p,v.mode,j),p.return=v,p;case ge:return p=Kl(p),R(v,p,j)}if(at(p)||ke(p))return p=Fl(p,v.mode,j,null),p.return=v,p;if(typeof p.then=="function")return R(v,ri(p),j);if(p.$$typeof===G)return R(v,ii(v,p),j);oi(v,p)}return null}function E(v,p,j,C){var J=p!==null?p.key:null;if(typeof j=="string"&&j!==""||typeof j=="number"||typeof j=="bigint")return J!==null?null:d(v,p,""+j,C);if(typeof j=="object"&&j!==null){switch(j.$$typeof){case W:return j.key===J?y(v,p,j,C):null;case I:return j.key===J?S(v,p,j,C):null;case ge:return j=Kl(j),E(v,p,j,C)}if(at(j)||ke(j))return J!==null?null:N(v,p,j,C,null);if(typeof j.then=="function")return E(v,p,ri(j),C);if(j.$$typeof===G)return E(v,p,ii(v,j),C);oi(v,j)}return null}function T(v,p,j,C,J){if(typeof C=="string"&&C!==""||typeof C=="number"||typeof C=="bigint")return v=v.get(j)||null,d(p,v,""+C,J);if(typeof C=="object"&&C!==null){switch(C.$$typeof){case W:return v=v.get(C.key===null?j:C.key)||null,y(p,v,C,J);case I:return v=v.get(C.key===null?j:C.key)||null,S(p,v,C,J);case ge:return C=Kl(C),T(v,p,j,C,J)}if(at(C)||ke(C))return v=v.get(j)||null,N(p,v,C,J,null);if(typeof C.then=="function")return T(v,p,j,ri(C),J);if(C.$$typeof===G)return T(v,p,j,ii(p,C),J);oi(p,C)}return null}function Y(v,p,j,C){for(var J=null,ye=null,F=p,ie=p=0,de=null;F!==null&&ie<j.length;ie++){F.index>ie?(de=F,F=null):de=F.sibling;var xe=E(v,F,j[ie],C);if(xe===null){F===null&&(F=de);break}e&&F&&xe.alternate===null&&t(v,F),p=i(xe,p,ie),ye===null?J=xe:ye.sibling=xe,ye=xe,F=de}if(ie===j.length)return l(v,F),me&&Kt(v,ie),J;if(F===null){for(;ie<j.length;ie++)F=R(v,j[ie],C),F!==null&&(p=i(F,p,ie),ye===null?J=F:ye.sibling=F,ye=F);return me&&Kt(v,ie),J}for(F=a(F);ie<j.length;ie++)de=T(F,v,ie,j[ie],C),de!==null&&(e&&de.alternate!==null&&F.delete(de.key===null?ie:de.key),p=i(de,p,ie),ye===null?J=de:ye.sibling=de,ye=de);return e&&F.forEach(function(wl){return t(v,wl)}),me&&Kt(v,ie),J}function $(v,p,j,C){if(j==null)throw Error(u(151));for(var J=null,ye=null,F=p,ie=p=0,de=null,xe=j.next();F!==null&&!xe.done;ie++,xe=j.next()){F.index>ie?(de=F,F=null):de=F.sibling;var wl=E(v,F,xe.value,C);if(wl===null){F===null&&(F=de);break}e&&F&&wl.alternate===null&&t(v,F),p=i(wl,p,ie),ye===null?J=wl:ye.sibling=wl,ye=wl,F=de}if(xe.done)return l(v,F),me&&Kt(v,ie),J;if(F===null){for(;!xe.done;ie++,xe=j.next())xe=R(v,xe.value,C),xe!==null&&(p=i(xe,p,ie),ye===null?J=xe:ye.sibling=xe,ye=xe);return me&&Kt(v,ie),J}for(F=a(F);!xe.done;ie++,xe=j.next())xe=T(F,v,ie,xe.value,C),xe!==null&&(e&&xe.alternate!==null&&F.delete(xe.key===null?ie:xe.key),p=i(xe,p,ie),ye===null?J=xe:ye.sibling=xe,ye=xe);return e&&F.forEach(function(Ch){return t(v,Ch)}),me&&Kt(v,ie),J}function Ae(v,p,j,C){if(typeof j=="object"&&j!==null&&j.type===se&&j.key===null&&(j=j.props.children),typeof j=="object"&&j!==null){switch(j.$$typeof){case W:e:{for(var J=j.key;p!==null;){if(p.key===J){if(J=j.type,J===se){if(p.tag===7){l(v,p.sibling),C=n(p,j.props.children),C.return=v,v=C;break e}}else if(p.elementType===J||typeof J=="object"&&J!==null&&J.$$typeof===ge&&Kl(J)===p.type){l(v,p.sibling),C=n(p,j.props),sn(C,j),C.return=v,v=C;break e}l(v,p);break}else t(v,p);p=p.sibling}j.type===se?(C=Fl(j.props.children,v.mode,C,j.key),C.return=v,v=C):(C=li(j.type,j.key,j.props,null,v.mode,C),sn(C,j),C.return=v,v=C)}return c(v);case I:e:{for(J=j.key;p!==null;){if(p.key===J)if(p.tag===4&&p.stateNode.containerInfo===j.containerInfo&&p.stateNode.implementation===j.implementation){l(v,p.sibling),C=n(p,j.children||[]),C.return=v,v=C;break e}else{l(v,p);break}else t(v,p);p=p.sibling}C=Us(j,v.mode,C),C.return=v,v=C}return c(v);case ge:return j=Kl(j),Ae(v,p,j,C)}if(at(j))return Y(v,p,j,C);if(ke(j)){if(J=ke(j),typeof J!="function")throw Error(u(150));return j=J.call(j),$(v,p,j,C)}if(typeof j.then=="function")return Ae(v,p,ri(j),C);if(j.$$typeof===G)return Ae(v,p,ii(v,j),C);oi(v,j)}return typeof j=="string"&&j!==""||typeof j=="number"||typeof j=="bigint"?(j=""+j,p!==null&&p.tag===6?(l(v,p.sibling),C=n(p,j),C.return=v,v=C):(l(v,p),C=Bs(j,v.mode,C),C.return=v,v=C),c(v)):l(v,p)}return function(v,p,j,C){try{nn=0;var J=Ae(v,p,j,C);return Sa=null,J}catch(F){if(F===ja||F===ci)throw F;var ye=pt(29,F,null,v.mode);return ye.lanes=C,ye.return=v,ye}finally{}}}var Wl=Zr(!0),Kr=Zr(!1),pl=!1;function Ks(e){e.updateQueue={baseState:e.memoizedState,firstBaseUpdate:null,lastBaseUpdate:null,shared:{pending:null,lanes:0,hiddenCallbacks:null},callbacks:null}}function Js(e,t){e=e.updateQueue,t.updateQueue===e&&(t.updateQueue={baseState:e.baseState,firstBaseUpdate:e.firstBaseUpdate,lastBaseUpdate:e.lastBaseUpdate,shared:e.shared,callbacks:null})}function gl(e){return{lane:e,tag:0,payload:null,callback:null,next:null}}function vl(e,t,l){var a=e.updateQueue;if(a===null)return null;if(a=a.shared,(ve&2)!==0){var n=a.pending;return n===null?t.next=t:(t.next=n.next,n.next=t),a.pending=t,t=ti(e),Or(e,null,l),t}return ei(e,a,t,l),ti(e)}function cn(e,t,l){if(t=t.updateQueue,t!==null&&(t=t.shared,(l&4194048)!==0)){var a=t.lanes;a&=e.pendingLanes,l|=a,t.lanes=l,Lu(e,l)}}function Ws(e,t){var l=e.updateQueue,a=e.alternate;if(a!==null&&(a=a.updateQueue,l===a)){var n=null,i=null;if(l=l.firstBaseUpdate,l!==null){do{var c={lane:l.lane,tag:l.tag,payload:l.payload,callback:null,next:null};i===null?n=i=c:i=i.next=c,l=l.next}while(l!==null);i===null?n=i=t:i=i.next=t}else n=i=t;l={baseState:a.baseState,firstBaseUpdate:n,lastBaseUpdate:i,shared:a.shared,callbacks:a.callbacks},e.updateQueue=l;return}e=l.lastBaseUpdate,e===null?l.firstBaseUpdate=t:e.next=t,l.lastBaseUpdate=t}var $s=!1;function un(){if($s){var e=ba;if(e!==null)throw e}}function rn(e,t,l,a){$s=!1;var n=e.updateQueue;pl=!1;var i=n.firstBaseUpdate,c=n.lastBaseUpdate,d=n.shared.pending;if(d!==null){n.shared.pending=null;var y=d,S=y.next;y.next=null,c===null?i=S:c.next=S,c=y;var N=e.alternate;N!==null&&(N=N.updateQueue,d=N.lastBaseUpdate,d!==c&&(d===null?N.firstBaseUpdate=S:d.next=S,N.lastBaseUpdate=y))}if(i!==null){var R=n.baseState;c=0,N=S=y=null,d=i;do{var E=d.lane&-536870913,T=E!==d.lane;if(T?(fe&E)===E:(a&E)===E){E!==0&&E===va&&($s=!0),N!==null&&(N=N.next={lane:0,tag:d.tag,payload:d.payload,callback:null,next:null});e:{var Y=e,$=d;E=t;var Ae=l;switch($.tag){case 1:if(Y=$.payload,typeof Y=="function"){R=Y.call(Ae,R,E);break e}R=Y;break e;case 3:Y.flags=Y.flags&-65537|128;case 0:if(Y=$.payload,E=typeof Y=="function"?Y.call(Ae,R,E):Y,E==null)break e;R=L({},R,E);break e;case 2:pl=!0}}E=d.callback,E!==null&&(e.flags|=64,T&&(e.flags|=8192),T=n.callbacks,T===null?n.callbacks=[E]:T.push(E))}else T={lane:E,tag:d.tag,payload:d.payload,callback:d.callback,next:null},N===null?(S=N=T,y=R):N=N.next=T,c|=E;if(d=d.next,d===null){if(d=n.shared.pending,d===null)break;T=d,d=T.next,T.next=null,n.lastBaseUpdate=T,n.shared.pending=null}}while(!0);N===null&&(y=R),n.baseState=y,n.firstBaseUpdate=S,n.lastBaseUpdate=N,i===null&&(n.shared.lanes=0),Tl|=c,e.lanes=c,e.memoizedState=R}}function Jr(e,t){if(typeof e!="function")throw Error(u(191,e));e.call(t)}function Wr(e,t){var l=e.callbacks;if(l!==null)for(e.callbacks=null,e=0;e<l.length;e++)Jr(l[e],t)}var Ea=h(null),fi=h(0);function $r(e,t){e=il,D(fi,e),D(Ea,t),il=e|t.baseLanes}function Is(){D(fi,il),D(Ea,Ea.current)}function Ps(){il=fi.current,g(Ea),g(fi)}var gt=h(null),Dt=null;function bl(e){var t=e.alternate;D(Ue,Ue.current&1),D(gt,e),Dt===null&&(t===null||Ea.current!==null||t.memoizedState!==null)&&(Dt=e)}function ec(e){D(Ue,Ue.current),D(gt,e),Dt===null&&(Dt=e)}function Ir(e){e.tag===22?(D(Ue,Ue.current),D(gt,e),Dt===null&&(Dt=e)):jl()}function jl(){D(Ue,Ue.current),D(gt,gt.current)}function vt(e){g(gt),Dt===e&&(Dt=null),g(Ue)}var Ue=h(0);function di(e){for(var t=e;t!==null;){if(t.tag===13){var l=t.memoizedState;if(l!==null&&(l=l.dehydrated,l===null||su(l)||cu(l)))return t}else if(t.tag===19&&(t.memoizedProps.revealOrder==="forwards"||t.memoizedProps.revealOrder==="backwards"||t.memoizedProps.revealOrder==="unstable_legacy-backwards"||t.memoizedProps.revealOrder==="together")){if((t.flags&128)!==0)return t}else if(t.child!==null){t.child.return=t,t=t.child;continue}if(t===e)break;for(;t.sibling===null;){if(t.return===null||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}return null}var $t=0,ne=null,Ee=null,Ge=null,mi=!1,Ta=!1,$l=!1,hi=0,on=0,Aa=null,pm=0;function we(){throw Error(u(321))}function tc(e,t){if(t===null)return!1;for(var l=0;l<t.length&&l<e.length;l++)if(!xt(e[l],t[l]))return!1;return!0}function lc(e,t,l,a,n,i){return $t=i,ne=t,t.memoizedState=null,t.updateQueue=null,t.lanes=0,A.H=e===null||e.memoizedState===null?Uo:pc,$l=!1,i=l(a,n),$l=!1,Ta&&(i=eo(t,l,a,n)),Pr(e),i}function Pr(e){A.H=mn;var t=Ee!==null&&Ee.next!==null;if($t=0,Ge=Ee=ne=null,mi=!1,on=0,Aa=null,t)throw Error(u(300));e===null||qe||(e=e.dependencies,e!==null&&ni(e)&&(qe=!0))}function eo(e,t,l,a){ne=e;var n=0;do{if(Ta&&(Aa=null),on=0,Ta=!1,25<=n)throw Error(u(301));if(n+=1,Ge=Ee=null,e.updateQueue!=null){var i=e.updateQueue;i.lastEffect=null,i.events=null,i.stores=null,i.memoCache!=null&&(i.memoCache.index=0)}A.H=Ho,i=t(l,a)}while(Ta);return i}function gm(){var e=A.H,t=e.useState()[0];return t=typeof t.then=="function"?fn(t):t,e=e.useState()[0],(Ee!==null?Ee.memoizedState:null)!==e&&(ne.flags|=1024),t}function ac(){var e=hi!==0;return hi=0,e}function nc(e,t,l){t.updateQueue=e.updateQueue,t.flags&=-2053,e.lanes&=~l}function ic(e){if(mi){for(e=e.memoizedState;e!==null;){var t=e.queue;t!==null&&(t.pending=null),e=e.next}mi=!1}$t=0,Ge=Ee=ne=null,Ta=!1,on=hi=0,Aa=null}function nt(){var e={memoizedState:null,baseState:null,baseQueue:null,queue:null,next:null};return Ge===null?ne.memoizedState=Ge=e:Ge=Ge.next=e,Ge}function He(){if(Ee===null){var e=ne.alternate;e=e!==null?e.memoizedState:null}else e=Ee.next;var t=Ge===null?ne.memoizedState:Ge.next;if(t!==null)Ge=t,Ee=e;else{if(e===null)throw ne.alternate===null?Error(u(467)):Error(u(310));Ee=e,e={memoizedState:Ee.memoizedState,baseState:Ee.baseState,baseQueue:Ee.baseQueue,queue:Ee.queue,next:null},Ge===null?ne.memoizedState=Ge=e:Ge=Ge.next=e}return Ge}function yi(){return{lastEffect:null,events:null,stores:null,memoCache:null}}function fn(e){var t=on;return on+=1,Aa===null&&(Aa=[]),e=Qr(Aa,e,t),t=ne,(Ge===null?t.memoizedState:Ge.next)===null&&(t=t.alternate,A.H=t===null||t.memoizedState===null?Uo:pc),e}function xi(e){if(e!==null&&typeof e=="object"){if(typeof e.then=="function")return fn(e);if(e.$$typeof===G)return Ie(e)}throw Error(u(438,String(e)))}function sc(e){var t=null,l=ne.updateQueue;if(l!==null&&(t=l.memoCache),t==null){var a=ne.alternate;a!==null&&(a=a.updateQueue,a!==null&&(a=a.memoCache,a!=null&&(t={data:a.data.map(function(n){return n.slice()}),index:0})))}if(t==null&&(t={data:[],index:0}),l===null&&(l=yi(),ne.updateQueue=l),l.memoCache=t,l=t.data[t.index],l===void 0)for(l=t.data[t.index]=Array(e),a=0;a<e;a++)l[a]=Vt;return t.index++,l}function It(e,t){return typeof t=="function"?t(e):t}function pi(e){var t=He();return cc(t,Ee,e)}function cc(e,t,l){var a=e.queue;if(a===null)throw Error(u(311));a.lastRenderedReducer=l;var n=e.baseQueue,i=a.pending;if(i!==null){if(n!==null){var c=n.next;n.next=i.next,i.next=c}t.baseQueue=n=i,a.pending=null}if(i=e.baseState,n===null)e.memoizedState=i;else{t=n.next;var d=c=null,y=null,S=t,N=!1;do{var R=S.lane&-536870913;if(R!==S.lane?(fe&R)===R:($t&R)===R){var E=S.revertLane;if(E===0)y!==null&&(y=y.next={lane:0,revertLane:0,gesture:null,action:S.action,hasEagerState:S.hasEagerState,eagerState:S.eagerState,next:null}),R===va&&(N=!0);else if(($t&E)===E){S=S.next,E===va&&(N=!0);continue}else R={lane:0,revertLane:S.revertLane,gesture:null,action:S.action,hasEagerState:S.hasEagerState,eagerState:S.eagerState,next:null},y===null?(d=y=R,c=i):y=y.next=R,ne.lanes|=E,Tl|=E;R=S.action,$l&&l(i,R),i=S.hasEagerState?S.eagerState:l(i,R)}else E={lane:R,revertLane:S.revertLane,gesture:S.gesture,action:S.action,hasEagerState:S.hasEagerState,eagerState:S.eagerState,next:null},y===null?(d=y=E,c=i):y=y.next=E,ne.lanes|=R,Tl|=R;S=S.next}while(S!==null&&S!==t);if(y===null?c=i:y.next=d,!xt(i,e.memoizedState)&&(qe=!0,N&&(l=ba,l!==null)))throw l;e.memoizedState=i,e.baseState=c,e.baseQueue=y,a.lastRenderedState=i}return n===null&&(a.lanes=0),[e.memoizedState,a.dispatch]}function uc(e){var t=He(),l=t.queue;if(l===null)throw Error(u(311));l.lastRenderedReducer=e;var a=l.dispatch,n=l.pending,i=t.memoizedState;if(n!==null){l.pending=null;var c=n=n.next;do i=e(i,c.action),c=c.next;while(c!==n);xt(i,t.memoizedState)||(qe=!0),t.memoizedState=i,t.baseQueue===null&&(t.baseState=i),l.lastRenderedState=i}return[i,a]}function to(e,t,l){var a=ne,n=He(),i=me;if(i){if(l===void 0)throw Error(u(407));l=l()}else l=t();var c=!xt((Ee||n).memoizedState,l);if(c&&(n.memoizedState=l,qe=!0),n=n.queue,fc(no.bind(null,a,n,e),[e]),n.getSnapshot!==t||c||Ge!==null&&Ge.memoizedState.tag&1){if(a.flags|=2048,Na(9,{destroy:void 0},ao.bind(null,a,n,l,t),null),Ce===null)throw Error(u(349));i||($t&127)!==0||lo(a,t,l)}return l}function lo(e,t,l){e.flags|=16384,e={getSnapshot:t,value:l},t=ne.updateQueue,t===null?(t=yi(),ne.updateQueue=t,t.stores=[e]):(l=t.stores,l===null?t.stores=[e]:l.push(e))}function ao(e,t,l,a){t.value=l,t.getSnapshot=a,io(t)&&so(e)}function no(e,t,l){return l(function(){io(t)&&so(e)})}function io(e){var t=e.getSnapshot;e=e.value;try{var l=t();return!xt(e,l)}catch{return!0}}function so(e){var t=Yl(e,2);t!==null&&dt(t,e,2)}function rc(e){var t=nt();if(typeof e=="function"){var l=e;if(e=l(),$l){ol(!0);try{l()}finally{ol(!1)}}}return t.memoizedState=t.baseState=e,t.queue={pending:null,lanes:0,dispatch:null,lastRenderedReducer:It,lastRenderedState:e},t}function co(e,t,l,a){return e.baseState=l,cc(e,Ee,typeof a=="function"?a:It)}function vm(e,t,l,a,n){if(bi(e))throw Error(u(485));if(e=t.action,e!==null){var i={payload:n,action:e,next:null,isTransition:!0,status:"pending",value:null,reason:null,listeners:[],then:function(c){i.listeners.push(c)}};A.T!==null?l(!0):i.isTransition=!1,a(i),l=t.pending,l===null?(i.next=t.pending=i,uo(t,i)):(i.next=l.next,t.pending=l.next=i)}}function uo(e,t){var l=t.action,a=t.payload,n=e.state;if(t.isTransition){var i=A.T,c={};A.T=c;try{var d=l(n,a),y=A.S;y!==null&&y(c,d),ro(e,t,d)}catch(S){oc(e,t,S)}finally{i!==null&&c.types!==null&&(i.types=c.types),A.T=i}}else try{i=l(n,a),ro(e,t,i)}catch(S){oc(e,t,S)}}function ro(e,t,l){l!==null&&typeof l=="object"&&typeof l.then=="function"?l.then(function(a){oo(e,t,a)},function(a){return oc(e,t,a)}):oo(e,t,l)}function oo(e,t,l){t.status="fulfilled",t.value=l,fo(t),e.state=l,t=e.pending,t!==null&&(l=t.next,l===t?e.pending=null:(l=l.next,t.next=l,uo(e,l)))}function oc(e,t,l){var a=e.pending;if(e.pending=null,a!==null){a=a.next;do t.status="rejected",t.reason=l,fo(t),t=t.next;while(t!==a)}e.action=null}function fo(e){e=e.listeners;for(var t=0;t<e.length;t++)(0,e[t])()}function mo(e,t){return t}function ho(e,t){if(me){var l=Ce.formState;if(l!==null){e:{var a=ne;if(me){if(Re){t:{for(var n=Re,i=Ot;n.nodeType!==8;){if(!i){n=null;break t}if(n=_t(n.nextSibling),n===null){n=null;break t}}i=n.data,n=i==="F!"||i==="F"?n:null}if(n){Re=_t(n.nextSibling),a=n.data==="F!";break e}}yl(a)}a=!1}a&&(t=l[0])}}return l=nt(),l.memoizedState=l.baseState=t,a={pending:null,lanes:0,dispatch:null,lastRenderedReducer:mo,lastRenderedState:t},l.queue=a,l=_o.bind(null,ne,a),a.dispatch=l,a=rc(!1),i=xc.bind(null,ne,!1,a.queue),a=nt(),n={state:t,dispatch:null,action:e,pending:null},a.queue=n,l=vm.bind(null,ne,n,i,l),n.dispatch=l,a.memoizedState=e,[t,l,!1]}function yo(e){var t=He();return xo(t,Ee,e)}function xo(e,t,l){if(t=cc(e,t,mo)[0],e=pi(It)[0],typeof t=="object"&&t!==null&&typeof t.then=="function")try{var a=fn(t)}catch(c){throw c===ja?ci:c}else a=t;t=He();var n=t.queue,i=n.dispatch;return l!==t.memoizedState&&(ne.flags|=2048,Na(9,{destroy:void 0},bm.bind(null,n,l),null)),[a,i,e]}function bm(e,t){e.action=t}function po(e){var t=He(),l=Ee;if(l!==null)return xo(t,l,e);He(),t=t.memoizedState,l=He();var a=l.queue.dispatch;return l.memoizedState=e,[t,a,!1]}function Na(e,t,l,a){return e={tag:e,create:l,deps:a,inst:t,next:null},t=ne.updateQueue,t===null&&(t=yi(),ne.updateQueue=t),l=t.lastEffect,l===null?t.lastEffect=e.next=e:(a=l.next,l.next=e,e.next=a,t.lastEffect=e),e}function go(){return He().memoizedState}function gi(e,t,l,a){var n=nt();ne.flags|=e,n.memoizedState=Na(1|t,{destroy:void 0},l,a===void 0?null:a)}function vi(e,t,l,a){var n=He();a=a===void 0?null:a;var i=n.memoizedState.inst;Ee!==null&&a!==null&&tc(a,Ee.memoizedState.deps)?n.memoizedState=Na(t,i,l,a):(ne.flags|=e,n.memoizedState=Na(1|t,i,l,a))}function vo(e,t){gi(8390656,8,e,t)}function fc(e,t){vi(2048,8,e,t)}function jm(e){ne.flags|=4;var t=ne.updateQueue;if(t===null)t=yi(),ne.updateQueue=t,t.events=[e];else{var l=t.events;l===null?t.events=[e]:l.push(e)}}function bo(e){var t=He().memoizedState;return jm({ref:t,nextImpl:e}),function(){if((ve&2)!==0)throw Error(u(440));return t.impl.apply(void 0,arguments)}}function jo(e,t){return vi(4,2,e,t)}function So(e,t){return vi(4,4,e,t)}function Eo(e,t){if(typeof t=="function"){e=e();var l=t(e);return function(){typeof l=="function"?l():t(null)}}if(t!=null)return e=e(),t.current=e,function(){t.current=null}}function To(e,t,l){l=l!=null?l.concat([e]):null,vi(4,4,Eo.bind(null,t,e),l)}function dc(){}function Ao(e,t){var l=He();t=t===void 0?null:t;var a=l.memoizedState;return t!==null&&tc(t,a[1])?a[0]:(l.memoizedState=[e,t],e)}function No(e,t){var l=He();t=t===void 0?null:t;var a=l.memoizedState;if(t!==null&&tc(t,a[1]))return a[0];if(a=e(),$l){ol(!0);try{e()}finally{ol(!1)}}return l.memoizedState=[a,t],a}function mc(e,t,l){return l===void 0||($t&1073741824)!==0&&(fe&261930)===0?e.memoizedState=t:(e.memoizedState=l,e=zf(),ne.lanes|=e,Tl|=e,l)}function Co(e,t,l,a){return xt(l,t)?l:Ea.current!==null?(e=mc(e,l,a),xt(e,t)||(qe=!0),e):($t&42)===0||($t&1073741824)!==0&&(fe&261930)===0?(qe=!0,e.memoizedState=l):(e=zf(),ne.lanes|=e,Tl|=e,t)}function zo(e,t,l,a,n){var i=H.p;H.p=i!==0&&8>i?i:8;var c=A.T,d={};A.T=d,xc(e,!1,t,l);try{var y=n(),S=A.S;if(S!==null&&S(d,y),y!==null&&typeof y=="object"&&typeof y.then=="function"){var N=xm(y,a);dn(e,t,N,St(e))}else dn(e,t,a,St(e))}catch(R){dn(e,t,{then:function(){},status:"rejected",reason:R},St())}finally{H.p=i,c!==null&&d.types!==null&&(c.types=d.types),A.T=c}}function Sm(){}function hc(e,t,l,a){if(e.tag!==5)throw Error(u(476));var n=Mo(e).queue;zo(e,n,t,Z,l===null?Sm:function(){return Ro(e),l(a)})}function Mo(e){var t=e.memoizedState;if(t!==null)return t;t={memoizedState:Z,baseState:Z,baseQueue:null,queue:{pending:null,lanes:0,dispatch:null,lastRenderedReducer:It,lastRenderedState:Z},next:null};var l={};return t.next={memoizedState:l,baseState:l,baseQueue:null,queue:{pending:null,lanes:0,dispatch:null,lastRenderedReducer:It,lastRenderedState:l},next:null},e.memoizedState=t,e=e.alternate,e!==null&&(e.memoizedState=t),t}function Ro(e){var t=Mo(e);t.next===null&&(t=e.alternate.memoizedState),dn(e,t.next.queue,{},St())}function yc(){return Ie(Mn)}function Oo(){return He().memoizedState}function Do(){return He().memoizedState}function Em(e){for(var t=e.return;t!==null;){switch(t.tag){case 24:case 3:var l=St();e=gl(l);var a=vl(t,e,l);a!==null&&(dt(a,t,l),cn(a,t,l)),t={cache:Qs()},e.payload=t;return}t=t.return}}function Tm(e,t,l){var a=St();l={lane:a,revertLane:0,gesture:null,action:l,hasEagerState:!1,eagerState:null,next:null},bi(e)?wo(t,l):(l=_s(e,t,l,a),l!==null&&(dt(l,e,a),Bo(l,t,a)))}function _o(e,t,l){var a=St();dn(e,t,l,a)}function dn(e,t,l,a){var n={lane:a,revertLane:0,gesture:null,action:l,hasEagerState:!1,eagerState:null,next:null};if(bi(e))wo(t,n);else{var i=e.alternate;if(e.lanes===0&&(i===null||i.lanes===0)&&(i=t.lastRenderedReducer,i!==null))try{var c=t.lastRenderedState,d=i(c,l);if(n.hasEagerState=!0,n.eagerState=d,xt(d,c))return ei(e,t,n,0),Ce===null&&Pn(),!1}catch{}finally{}if(l=_s(e,t,n,a),l!==null)return dt(l,e,a),Bo(l,t,a),!0}return!1}function xc(e,t,l,a){if(a={lane:2,revertLane:Kc(),gesture:null,action:a,hasEagerState:!1,eagerState:null,next:null},bi(e)){if(t)throw Error(u(479))}else t=_s(e,l,a,2),t!==null&&dt(t,e,2)}function bi(e){var t=e.alternate;return e===ne||t!==null&&t===ne}function wo(e,t){Ta=mi=!0;var l=e.pending;l===null?t.next=t:(t.next=l.next,l.next=t),e.pending=t}function Bo(e,t,l){if((l&4194048)!==0){var a=t.lanes;a&=e.pendingLanes,l|=a,t.lanes=l,Lu(e,l)}}var mn={readContext:Ie,use:xi,useCallback:we,useContext:we,useEffect:we,useImperativeHandle:we,useLayoutEffect:we,useInsertionEffect:we,useMemo:we,useReducer:we,useRef:we,useState:we,useDebugValue:we,useDeferredValue:we,useTransition:we,useSyncExternalStore:we,useId:we,useHostTransitionStatus:we,useFormState:we,useActionState:we,useOptimistic:we,useMemoCache:we,useCacheRefresh:we};mn.useEffectEvent=we;var Uo={readContext:Ie,use:xi,useCallback:function(e,t){return nt().memoizedState=[e,t===void 0?null:t],e},useContext:Ie,useEffect:vo,useImperativeHandle:function(e,t,l){l=l!=null?l.concat([e]):null,gi(4194308,4,Eo.bind(null,t,e),l)},useLayoutEffect:function(e,t){return gi(4194308,4,e,t)},useInsertionEffect:function(e,t){gi(4,2,e,t)},useMemo:function(e,t){var l=nt();t=t===void 0?null:t;var a=e();if($l){ol(!0);try{e()}finally{ol(!1)}}return l.memoizedState=[a,t],a},useReducer:function(e,t,l){var a=nt();if(l!==void 0){var n=l(t);if($l){ol(!0);try{l(t)}finally{ol(!1)}}}else n=t;return a.memoizedState=a.baseState=n,e={pending:null,lanes:0,dispatch:null,lastRenderedReducer:e,lastRenderedState:n},a.queue=e,e=e.dispatch=Tm.bind(null,ne,e),[a.memoizedState,e]},useRef:function(e){var t=nt();return e={current:e},t.memoizedState=e},useState:function(e){e=rc(e);var t=e.queue,l=_o.bind(null,ne,t);return t.dispatch=l,[e.memoizedState,l]},useDebugValue:dc,useDeferredValue:function(e,t){var l=nt();return mc(l,e,t)},useTransition:function(){var e=rc(!1);return e=zo.bind(null,ne,e.queue,!0,!1),nt().memoizedState=e,[!1,e]},useSyncExternalStore:function(e,t,l){var a=ne,n=nt();if(me){if(l===void 0)throw Error(u(407));l=l()}else{if(l=t(),Ce===null)throw Error(u(349));(fe&127)!==0||lo(a,t,l)}n.memoizedState=l;var i={value:l,getSnapshot:t};return n.queue=i,vo(no.bind(null,a,i,e),[e]),a.flags|=2048,Na(9,{destroy:void 0},ao.bind(null,a,i,l,t),null),l},useId:function(){var e=nt(),t=Ce.identifierPrefix;if(me){var l=Gt,a=Lt;l=(a&~(1<<32-yt(a)-1)).toString(32)+l,t="_"+t+"R_"+l,l=hi++,0<l&&(t+="H"+l.toString(32)),t+="_"}else l=pm++,t="_"+t+"r_"+l.toString(32)+"_";return e.memoizedState=t},useHostTransitionStatus:yc,useFormState:ho,useActionState:ho,useOptimistic:function(e){var t=nt();t.memoizedState=t.baseState=e;var l={pending:null,lanes:0,dispatch:null,lastRenderedReducer:null,lastRenderedState:null};return t.queue=l,t=xc.bind(null,ne,!0,l),l.dispatch=t,[e,t]},useMemoCache:sc,useCacheRefresh:function(){return nt().memoizedState=Em.bind(null,ne)},useEffectEvent:function(e){var t=nt(),l={impl:e};return t.memoizedState=l,function(){if((ve&2)!==0)throw Error(u(440));return l.impl.apply(void 0,arguments)}}},pc={readContext:Ie,use:xi,useCallback:Ao,useContext:Ie,useEffect:fc,useImperativeHandle:To,useInsertionEffect:jo,useLayoutEffect:So,useMemo:No,useReducer:pi,useRef:go,useState:function(){return pi(It)},useDebugValue:dc,useDeferredValue:function(e,t){var l=He();return Co(l,Ee.memoizedState,e,t)},useTransition:function(){var e=pi(It)[0],t=He().memoizedState;return[typeof e=="boolean"?e:fn(e),t]},useSyncExternalStore:to,useId:Oo,useHostTransitionStatus:yc,useFormState:yo,useActionState:yo,useOptimistic:function(e,t){var l=He();return co(l,Ee,e,t)},useMemoCache:sc,useCacheRefresh:Do};pc.useEffectEvent=bo;var Ho={readContext:Ie,use:xi,useCallback:Ao,useContext:Ie,useEffect:fc,useImperativeHandle:To,useInsertionEffect:jo,useLayoutEffect:So,useMemo:No,useReducer:uc,useRef:go,useState:function(){return uc(It)},useDebugValue:dc,useDeferredValue:function(e,t){var l=He();return Ee===null?mc(l,e,t):Co(l,Ee.memoizedState,e,t)},useTransition:function(){var e=uc(It)[0],t=He().memoizedState;return[typeof e=="boolean"?e:fn(e),t]},useSyncExternalStore:to,useId:Oo,useHostTransitionStatus:yc,useFormState:po,useActionState:po,useOptimistic:function(e,t){var l=He();return Ee!==null?co(l,Ee,e,t):(l.baseState=e,[e,l.queue.dispatch])},useMemoCache:sc,useCacheRefresh:Do};Ho.useEffectEvent=bo;function gc(e,t,l,a){t=e.memoizedState,l=l(a,t),l=l==null?t:L({},t,l),e.memoizedState=l,e.lanes===0&&(e.updateQueue.baseState=l)}var vc={enqueueSetState:function(e,t,l){e=e._reactInternals;var a=St(),n=gl(a);n.payload=t,l!=null&&(n.callback=l),t=vl(e,n,a),t!==null&&(dt(t,e,a),cn(t,e,a))},enqueueReplaceState:function(e,t,l){e=e._reactInternals;var a=St(),n=gl(a);n.tag=1,n.payload=t,l!=null&&(n.callback=l),t=vl(e,n,a),t!==null&&(dt(t,e,a),cn(t,e,a))},enqueueForceUpdate:function(e,t){e=e._reactInternals;var l=St(),a=gl(l);a.tag=2,t!=null&&(a.callback=t),t=vl(e,a,l),t!==null&&(dt(t,e,l),cn(t,e,l))}};function Vo(e,t,l,a,n,i,c){return e=e.stateNode,typeof e.shouldComponentUpdate=="function"?e.shouldComponentUpdate(a,i,c):t.prototype&&t.prototype.isPureReactComponent?!Ia(l,a)||!Ia(n,i):!0}function Lo(e,t,l,a){e=t.state,typeof t.componentWillReceiveProps=="function"&&t.componentWillReceiveProps(l,a),typeof t.UNSAFE_componentWillReceiveProps=="function"&&t.UNSAFE_componentWillReceiveProps(l,a),t.state!==e&&vc.enqueueReplaceState(t,t.state,null)}function Il(e,t){var l=t;if("ref"in t){l={};for(var a in t)a!=="ref"&&(l[a]=t[a])}if(e=e.defaultProps){l===t&&(l=L({},l));for(var n in e)l[n]===void 0&&(l[n]=e[n])}return l}function Go(e){In(e)}function qo(e){console.error(e)}function Yo(e){In(e)}function ji(e,t){try{var l=e.onUncaughtError;l(t.value,{componentStack:t.stack})}catch(a){setTimeout(function(){throw a})}}function Fo(e,t,l){try{var a=e.onCaughtError;a(l.value,{componentStack:l.stack,errorBoundary:t.tag===1?t.stateNode:null})}catch(n){setTimeout(function(){throw n})}}function bc(e,t,l){return l=gl(l),l.tag=3,l.payload={element:null},l.callback=function(){ji(e,t)},l}function Qo(e){return e=gl(e),e.tag=3,e}function Xo(e,t,l,a){var n=l.type.getDerivedStateFromError;if(typeof n=="function"){var i=a.value;e.payload=function(){return n(i)},e.callback=function(){Fo(t,l,a)}}var c=l.stateNode;c!==null&&typeof c.componentDidCatch=="function"&&(e.callback=function(){Fo(t,l,a),typeof n!="function"&&(Al===null?Al=new Set([this]):Al.add(this));var d=a.stack;this.componentDidCatch(a.value,{componentStack:d!==null?d:""})})}function Am(e,t,l,a,n){if(l.flags|=32768,a!==null&&typeof a=="object"&&typeof a.then=="function"){if(t=l.alternate,t!==null&&ga(t,l,n,!0),l=gt.current,l!==null){switch(l.tag){case 31:case 13:return Dt===null?_i():l.alternate===null&&Be===0&&(Be=3),l.flags&=-257,l.flags|=65536,l.lanes=n,a===ui?l.flags|=16384:(t=l.updateQueue,t===null?l.updateQueue=new Set([a]):t.add(a),Xc(e,a,n)),!1;case 22:return l.flags|=65536,a===ui?l.flags|=16384:(t=l.updateQueue,t===null?(t={transitions:null,markerInstances:null,retryQueue:new Set([a])},l.updateQueue=t):(l=t.retryQueue,l===null?t.retryQueue=new Set([a]):l.add(a)),Xc(e,a,n)),!1}throw Error(u(435,l.tag))}return Xc(e,a,n),_i(),!1}if(me)return t=gt.current,t!==null?((t.flags&65536)===0&&(t.flags|=256),t.flags|=65536,t.lanes=n,a!==Ls&&(e=Error(u(422),{cause:a}),tn(zt(e,l)))):(a!==Ls&&(t=Error(u(423),{cause:a}),tn(zt(t,l))),e=e.current.alternate,e.flags|=65536,n&=-n,e.lanes|=n,a=zt(a,l),n=bc(e.stateNode,a,n),Ws(e,n),Be!==4&&(Be=2)),!1;var i=Error(u(520),{cause:a});if(i=zt(i,l),jn===null?jn=[i]:jn.push(i),Be!==4&&(Be=2),t===null)return!0;a=zt(a,l),l=t;do{switch(l.tag){case 3:return l.flags|=65536,e=n&-n,l.lanes|=e,e=bc(l.stateNode,a,e),Ws(l,e),!1;case 1:if(t=l.type,i=l.stateNode,(l.flags&128)===0&&(typeof t.getDerivedStateFromError=="function"||i!==null&&typeof i.componentDidCatch=="function"&&(Al===null||!Al.has(i))))return l.flags|=65536,n&=-n,l.lanes|=n,n=Qo(n),Xo(n,e,l,a),Ws(l,n),!1}l=l.return}while(l!==null);return!1}var jc=Error(u(461)),qe=!1;function Pe(e,t,l,a){t.child=e===null?Kr(t,null,l,a):Wl(t,e.child,l,a)}function ko(e,t,l,a,n){l=l.render;var i=t.ref;if("ref"in a){var c={};for(var d in a)d!=="ref"&&(c[d]=a[d])}else c=a;return kl(t),a=lc(e,t,l,c,i,n),d=ac(),e!==null&&!qe?(nc(e,t,n),Pt(e,t,n)):(me&&d&&Hs(t),t.flags|=1,Pe(e,t,a,n),t.child)}function Zo(e,t,l,a,n){if(e===null){var i=l.type;return typeof i=="function"&&!ws(i)&&i.defaultProps===void 0&&l.compare===null?(t.tag=15,t.type=i,Ko(e,t,i,a,n)):(e=li(l.type,null,a,t,t.mode,n),e.ref=t.ref,e.return=t,t.child=e)}if(i=e.child,!Mc(e,n)){var c=i.memoizedProps;if(l=l.compare,l=l!==null?l:Ia,l(c,a)&&e.ref===t.ref)return Pt(e,t,n)}return t.flags|=1,e=Zt(i,a),e.ref=t.ref,e.return=t,t.child=e}function Ko(e,t,l,a,n){if(e!==null){var i=e.memoizedProps;if(Ia(i,a)&&e.ref===t.ref)if(qe=!1,t.pendingProps=a=i,Mc(e,n))(e.flags&131072)!==0&&(qe=!0);else return t.lanes=e.lanes,Pt(e,t,n)}return Sc(e,t,l,a,n)}function Jo(e,t,l,a){var n=a.children,i=e!==null?e.memoizedState:null;if(e===null&&t.stateNode===null&&(t.stateNode={_visibility:1,_pendingMarkers:null,_retryCache:null,_transitions:null}),a.mode==="hidden"){if((t.flags&128)!==0){if(i=i!==null?i.baseLanes|l:l,e!==null){for(a=t.child=e.child,n=0;a!==null;)n=n|a.lanes|a.childLanes,a=a.sibling;a=n&~i}else a=0,t.child=null;return Wo(e,t,i,l,a)}if((l&536870912)!==0)t.memoizedState={baseLanes:0,cachePool:null},e!==null&&si(t,i!==null?i.cachePool:null),i!==null?$r(t,i):Is(),Ir(t);else return a=t.lanes=536870912,Wo(e,t,i!==null?i.baseLanes|l:l,l,a)}else i!==null?(si(t,i.cachePool),$r(t,i),jl(),t.memoizedState=null):(e!==null&&si(t,null),Is(),jl());return Pe(e,t,n,l),t.child}function hn(e,t){return e!==null&&e.tag===22||t.stateNode!==null||(t.stateNode={_visibility:1,_pendingMarkers:null,_retryCache:null,_transitions:null}),t.sibling}function Wo(e,t,l,a,n){var i=ks();return i=i===null?null:{parent:Le._currentValue,pool:i},t.memoizedState={baseLanes:l,cachePool:i},e!==null&&si(t,null),Is(),Ir(t),e!==null&&ga(e,t,a,!0),t.childLanes=n,null}function Si(e,t){return t=Ti({mode:t.mode,children:t.children},e.mode),t.ref=e.ref,e.child=t,t.return=e,t}function $o(e,t,l){return Wl(t,e.child,null,l),e=Si(t,t.pendingProps),e.flags|=2,vt(t),t.memoizedState=null,e}function Nm(e,t,l){var a=t.pendingProps,n=(t.flags&128)!==0;if(t.flags&=-129,e===null){if(me){if(a.mode==="hidden")return e=Si(t,a),t.lanes=536870912,hn(null,e);if(ec(t),(e=Re)?(e=o0(e,Ot),e=e!==null&&e.data==="&"?e:null,e!==null&&(t.memoizedState={dehydrated:e,treeContext:ml!==null?{id:Lt,overflow:Gt}:null,retryLane:536870912,hydrationErrors:null},l=_r(e),l.return=t,t.child=l,$e=t,Re=null)):e=null,e===null)throw yl(t);return t.lanes=536870912,null}return Si(t,a)}var i=e.memoizedState;if(i!==null){var c=i.dehydrated;if(ec(t),n)if(t.flags&256)t.flags&=-257,t=$o(e,t,l);else if(t.memoizedState!==null)t.child=e.child,t.flags|=128,t=null;else throw Error(u(558));else if(qe||ga(e,t,l,!1),n=(l&e.childLanes)!==0,qe||n){if(a=Ce,a!==null&&(c=Gu(a,l),c!==0&&c!==i.retryLane))throw i.retryLane=c,Yl(e,c),dt(a,e,c),jc;_i(),t=$o(e,t,l)}else e=i.treeContext,Re=_t(c.nextSibling),$e=t,me=!0,hl=null,Ot=!1,e!==null&&Ur(t,e),t=Si(t,a),t.flags|=4096;return t}return e=Zt(e.child,{mode:a.mode,children:a.children}),e.ref=t.ref,t.child=e,e.return=t,e}function Ei(e,t){var l=t.ref;if(l===null)e!==null&&e.ref!==null&&(t.flags|=4194816);else{if(typeof l!="function"&&typeof l!="object")throw Error(u(284));(e===null||e.ref!==l)&&(t.flags|=4194816)}}function Sc(e,t,l,a,n){return kl(t),l=lc(e,t,l,a,void 0,n),a=ac(),e!==null&&!qe?(nc(e,t,n),Pt(e,t,n)):(me&&a&&Hs(t),t.flags|=1,Pe(e,t,l,n),t.child)}function Io(e,t,l,a,n,i){return kl(t),t.updateQueue=null,l=eo(t,a,l,n),Pr(e),a=ac(),e!==null&&!qe?(nc(e,t,i),Pt(e,t,i)):(me&&a&&Hs(t),t.flags|=1,Pe(e,t,l,i),t.child)}function Po(e,t,l,a,n){if(kl(t),t.stateNode===null){var i=ha,c=l.contextType;typeof c=="object"&&c!==null&&(i=Ie(c)),i=new l(a,i),t.memoizedState=i.state!==null&&i.state!==void 0?i.state:null,i.updater=vc,t.stateNode=i,i._reactInternals=t,i=t.stateNode,i.props=a,i.state=t.memoizedState,i.refs={},Ks(t),c=l.contextType,i.context=typeof c=="object"&&c!==null?Ie(c):ha,i.state=t.memoizedState,c=l.getDerivedStateFromProps,typeof c=="function"&&(gc(t,l,c,a),i.state=t.memoizedState),typeof l.getDerivedStateFromProps=="function"||typeof i.getSnapshotBeforeUpdate=="function"||typeof i.UNSAFE_componentWillMount!="function"&&typeof i.componentWillMount!="function"||(c=i.state,typeof i.componentWillMount=="function"&&i.componentWillMount(),typeof i.UNSAFE_componentWillMount=="function"&&i.UNSAFE_componentWillMount(),c!==i.state&&vc.enqueueReplaceState(i,i.state,null),rn(t,a,i,n),un(),i.state=t.memoizedState),typeof i.componentDidMount=="function"&&(t.flags|=4194308),a=!0}else if(e===null){i=t.stateNode;var d=t.memoizedProps,y=Il(l,d);i.props=y;var S=i.context,N=l.contextType;c=ha,typeof N=="object"&&N!==null&&(c=Ie(N));var R=l.getDerivedStateFromProps;N=typeof R=="function"||typeof i.getSnapshotBeforeUpdate=="function",d=t.pendingProps!==d,N||typeof i.UNSAFE_componentWillReceiveProps!="function"&&typeof i.componentWillReceiveProps!="function"||(d||S!==c)&&Lo(t,i,a,c),pl=!1;var E=t.memoizedState;i.state=E,rn(t,a,i,n),un(),S=t.memoizedState,d||E!==S||pl?(typeof R=="function"&&(gc(t,l,R,a),S=t.memoizedState),(y=pl||Vo(t,l,y,a,E,S,c))?(N||typeof i.UNSAFE_componentWillMount!="function"&&typeof i.componentWillMount!="function"||(typeof i.componentWillMount=="function"&&i.componentWillMount(),typeof i.UNSAFE_componentWillMount=="function"&&i.UNSAFE_componentWillMount()),typeof i.componentDidMount=="function"&&(t.flags|=4194308)):(typeof i.componentDidMount=="function"&&(t.flags|=4194308),t.memoizedProps=a,t.memoizedState=S),i.props=a,i.state=S,i.context=c,a=y):(typeof i.componentDidMount=="function"&&(t.flags|=4194308),a=!1)}else{i=t.stateNode,Js(e,t),c=t.memoizedProps,N=Il(l,c),i.props=N,R=t.pendingProps,E=i.context,S=l.contextType,y=ha,typeof S=="object"&&S!==null&&(y=Ie(S)),d=l.getDerivedStateFromProps,(S=typeof d=="function"||typeof i.getSnapshotBeforeUpdate=="function")||typeof i.UNSAFE_componentWillReceiveProps!="function"&&typeof i.componentWillReceiveProps!="function"||(c!==R||E!==y)&&Lo(t,i,a,y),pl=!1,E=t.memoizedState,i.state=E,rn(t,a,i,n),un();var T=t.memoizedState;c!==R||E!==T||pl||e!==null&&e.dependencies!==null&&ni(e.dependencies)?(typeof d=="function"&&(gc(t,l,d,a),T=t.memoizedState),(N=pl||Vo(t,l,N,a,E,T,y)||e!==null&&e.dependencies!==null&&ni(e.dependencies))?(S||typeof i.UNSAFE_componentWillUpdate!="function"&&typeof i.componentWillUpdate!="function"||(typeof i.componentWillUpdate=="function"&&i.componentWillUpdate(a,T,y),typeof i.UNSAFE_componentWillUpdate=="function"&&i.UNSAFE_componentWillUpdate(a,T,y)),typeof i.componentDidUpdate=="function"&&(t.flags|=4),typeof i.getSnapshotBeforeUpdate=="function"&&(t.flags|=1024)):(typeof i.componentDidUpdate!="function"||c===e.memoizedProps&&E===e.memoizedState||(t.flags|=4),typeof i.getSnapshotBeforeUpdate!="function"||c===e.memoizedProps&&E===e.memoizedState||(t.flags|=1024),t.memoizedProps=a,t.memoizedState=T),i.props=a,i.state=T,i.context=y,a=N):(typeof i.componentDidUpdate!="function"||c===e.memoizedProps&&E===e.memoizedState||(t.flags|=4),typeof i.getSnapshotBeforeUpdate!="function"||c===e.memoizedProps&&E===e.memoizedState||(t.flags|=1024),a=!1)}return i=a,Ei(e,t),a=(t.flags&128)!==0,i||a?(i=t.stateNode,l=a&&typeof l.getDerivedStateFromError!="function"?null:i.render(),t.flags|=1,e!==null&&a?(t.child=Wl(t,e.child,null,n),t.child=Wl(t,null,l,n)):Pe(e,t,l,n),t.memoizedState=i.state,e=t.child):e=Pt(e,t,n),e}function ef(e,t,l,a){return Ql(),t.flags|=256,Pe(e,t,l,a),t.child}var Ec={dehydrated:null,treeContext:null,retryLane:0,hydrationErrors:null};function Tc(e){return{baseLanes:e,cachePool:Yr()}}function Ac(e,t,l){return e=e!==null?e.childLanes&~l:0,t&&(e|=jt),e}function tf(e,t,l){var a=t.pendingProps,n=!1,i=(t.flags&128)!==0,c;if((c=i)||(c=e!==null&&e.memoizedState===null?!1:(Ue.current&2)!==0),c&&(n=!0,t.flags&=-129),c=(t.flags&32)!==0,t.flags&=-33,e===null){if(me){if(n?bl(t):jl(),(e=Re)?(e=o0(e,Ot),e=e!==null&&e.data!=="&"?e:null,e!==null&&(t.memoizedState={dehydrated:e,treeContext:ml!==null?{id:Lt,overflow:Gt}:null,retryLane:536870912,hydrationErrors:null},l=_r(e),l.return=t,t.child=l,$e=t,Re=null)):e=null,e===null)throw yl(t);return cu(e)?t.lanes=32:t.lanes=536870912,null}var d=a.children;return a=a.fallback,n?(jl(),n=t.mode,d=Ti({mode:"hidden",children:d},n),a=Fl(a,n,l,null),d.return=t,a.return=t,d.sibling=a,t.child=d,a=t.child,a.memoizedState=Tc(l),a.childLanes=Ac(e,c,l),t.memoizedState=Ec,hn(null,a)):(bl(t),Nc(t,d))}var y=e.memoizedState;if(y!==null&&(d=y.dehydrated,d!==null)){if(i)t.flags&256?(bl(t),t.flags&=-257,t=Cc(e,t,l)):t.memoizedState!==null?(jl(),t.child=e.child,t.flags|=128,t=null):(jl(),d=a.fallback,n=t.mode,a=Ti({mode:"visible",children:a.children},n),d=Fl(d,n,l,null),d.flags|=2,a.return=t,d.return=t,a.sibling=d,t.child=a,Wl(t,e.child,null,l),a=t.child,a.memoizedState=Tc(l),a.childLanes=Ac(e,c,l),t.memoizedState=Ec,t=hn(null,a));else if(bl(t),cu(d)){if(c=d.nextSibling&&d.nextSibling.dataset,c)var S=c.dgst;c=S,a=Error(u(419)),a.stack="",a.digest=c,tn({value:a,source:null,stack:null}),t=Cc(e,t,l)}else if(qe||ga(e,t,l,!1),c=(l&e.childLanes)!==0,qe||c){if(c=Ce,c!==null&&(a=Gu(c,l),a!==0&&a!==y.retryLane))throw y.retryLane=a,Yl(e,a),dt(c,e,a),jc;su(d)||_i(),t=Cc(e,t,l)}else su(d)?(t.flags|=192,t.child=e.child,t=null):(e=y.treeContext,Re=_t(d.nextSibling),$e=t,me=!0,hl=null,Ot=!1,e!==null&&Ur(t,e),t=Nc(t,a.children),t.flags|=4096);return t}return n?(jl(),d=a.fallback,n=t.mode,y=e.child,S=y.sibling,a=Zt(y,{mode:"hidden",children:a.children}),a.subtreeFlags=y.subtreeFlags&65011712,S!==null?d=Zt(S,d):(d=Fl(d,n,l,null),d.flags|=2),d.return=t,a.return=t,a.sibling=d,t.child=a,hn(null,a),a=t.child,d=e.child.memoizedState,d===null?d=Tc(l):(n=d.cachePool,n!==null?(y=Le._currentValue,n=n.parent!==y?{parent:y,pool:y}:n):n=Yr(),d={baseLanes:d.baseLanes|l,cachePool:n}),a.memoizedState=d,a.childLanes=Ac(e,c,l),t.memoizedState=Ec,hn(e.child,a)):(bl(t),l=e.child,e=l.sibling,l=Zt(l,{mode:"visible",children:a.children}),l.return=t,l.sibling=null,e!==null&&(c=t.deletions,c===null?(t.deletions=[e],t.flags|=16):c.push(e)),t.child=l,t.memoizedState=null,l)}function Nc(e,t){return t=Ti({mode:"visible",children:t},e.mode),t.return=e,e.child=t}function Ti(e,t){return e=pt(22,e,null,t),e.lanes=0,e}function Cc(e,t,l){return Wl(t,e.child,null,l),e=Nc(t,t.pendingProps.children),e.flags|=2,t.memoizedState=null,e}function lf(e,t,l){e.lanes|=t;var a=e.alternate;a!==null&&(a.lanes|=t),Ys(e.return,t,l)}function zc(e,t,l,a,n,i){var c=e.memoizedState;c===null?e.memoizedState={isBackwards:t,rendering:null,renderingStartTime:0,last:a,tail:l,tailMode:n,treeForkCount:i}:(c.isBackwards=t,c.rendering=null,c.renderingStartTime=0,c.last=a,c.tail=l,c.tailMode=n,c.treeForkCount=i)}function af(e,t,l){var a=t.pendingProps,n=a.revealOrder,i=a.tail;a=a.children;var c=Ue.current,d=(c&2)!==0;if(d?(c=c&1|2,t.flags|=128):c&=1,D(Ue,c),Pe(e,t,a,l),a=me?en:0,!d&&e!==null&&(e.flags&128)!==0)e:for(e=t.child;e!==null;){if(e.tag===13)e.memoizedState!==null&&lf(e,l,t);else if(e.tag===19)lf(e,l,t);else if(e.child!==null){e.child.return=e,e=e.child;continue}if(e===t)break e;for(;e.sibling===null;){if(e.return===null||e.return===t)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}switch(n){case"forwards":for(l=t.child,n=null;l!==null;)e=l.alternate,e!==null&&di(e)===null&&(n=l),l=l.sibling;l=n,l===null?(n=t.child,t.child=null):(n=l.sibling,l.sibling=null),zc(t,!1,n,l,i,a);break;case"backwards":case"unstable_legacy-backwards":for(l=null,n=t.child,t.child=null;n!==null;){if(e=n.alternate,e!==null&&di(e)===null){t.child=n;break}e=n.sibling,n.sibling=l,l=n,n=e}zc(t,!0,l,null,i,a);break;case"together":zc(t,!1,null,null,void 0,a);break;default:t.memoizedState=null}return t.child}function Pt(e,t,l){if(e!==null&&(t.dependencies=e.dependencies),Tl|=t.lanes,(l&t.childLanes)===0)if(e!==null){if(ga(e,t,l,!1),(l&t.childLanes)===0)return null}else return null;if(e!==null&&t.child!==e.child)throw Error(u(153));if(t.child!==null){for(e=t.child,l=Zt(e,e.pendingProps),t.child=l,l.return=t;e.sibling!==null;)e=e.sibling,l=l.sibling=Zt(e,e.pendingProps),l.return=t;l.sibling=null}return t.child}function Mc(e,t){return(e.lanes&t)!==0?!0:(e=e.dependencies,!!(e!==null&&ni(e)))}function Cm(e,t,l){switch(t.tag){case 3:K(t,t.stateNode.containerInfo),xl(t,Le,e.memoizedState.cache),Ql();break;case 27:case 5:Qe(t);break;case 4:K(t,t.stateNode.containerInfo);break;case 10:xl(t,t.type,t.memoizedProps.value);break;case 31:if(t.memoizedState!==null)return t.flags|=128,ec(t),null;break;case 13:var a=t.memoizedState;if(a!==null)return a.dehydrated!==null?(bl(t),t.flags|=128,null):(l&t.child.childLanes)!==0?tf(e,t,l):(bl(t),e=Pt(e,t,l),e!==null?e.sibling:null);bl(t);break;case 19:var n=(e.flags&128)!==0;if(a=(l&t.childLanes)!==0,a||(ga(e,t,l,!1),a=(l&t.childLanes)!==0),n){if(a)return af(e,t,l);t.flags|=128}if(n=t.memoizedState,n!==null&&(n.rendering=null,n.tail=null,n.lastEffect=null),D(Ue,Ue.current),a)break;return null;case 22:return t.lanes=0,Jo(e,t,l,t.pendingProps);case 24:xl(t,Le,e.memoizedState.cache)}return Pt(e,t,l)}function nf(e,t,l){if(e!==null)if(e.memoizedProps!==t.pendingProps)qe=!0;else{if(!Mc(e,l)&&(t.flags&128)===0)return qe=!1,Cm(e,t,l);qe=(e.flags&131072)!==0}else qe=!1,me&&(t.flags&1048576)!==0&&Br(t,en,t.index);switch(t.lanes=0,t.tag){case 16:e:{var a=t.pendingProps;if(e=Kl(t.elementType),t.type=e,typeof e=="function")ws(e)?(a=Il(e,a),t.tag=1,t=Po(null,t,e,a,l)):(t.tag=0,t=Sc(null,t,e,a,l));else{if(e!=null){var n=e.$$typeof;if(n===ce){t.tag=11,t=ko(null,t,e,a,l);break e}else if(n===ee){t.tag=14,t=Zo(null,t,e,a,l);break e}}throw t=it(e)||e,Error(u(306,t,""))}}return t;case 0:return Sc(e,t,t.type,t.pendingProps,l);case 1:return a=t.type,n=Il(a,t.pendingProps),Po(e,t,a,n,l);case 3:e:{if(K(t,t.stateNode.containerInfo),e===null)throw Error(u(387));a=t.pendingProps;var i=t.memoizedState;n=i.element,Js(e,t),rn(t,a,null,l);var c=t.memoizedState;if(a=c.cache,xl(t,Le,a),a!==i.cache&&Fs(t,[Le],l,!0),un(),a=c.element,i.isDehydrated)if(i={element:a,isDehydrated:!1,cache:c.cache},t.updateQueue.baseState=i,t.memoizedState=i,t.flags&256){t=ef(e,t,a,l);break e}else if(a!==n){n=zt(Error(u(424)),t),tn(n),t=ef(e,t,a,l);break e}else{switch(e=t.stateNode.containerInfo,e.nodeType){case 9:e=e.body;break;default:e=e.nodeName==="HTML"?e.ownerDocument.body:e}for(Re=_t(e.firstChild),$e=t,me=!0,hl=null,Ot=!0,l=Kr(t,null,a,l),t.child=l;l;)l.flags=l.flags&-3|4096,l=l.sibling}else{if(Ql(),a===n){t=Pt(e,t,l);break e}Pe(e,t,a,l)}t=t.child}return t;case 26:return Ei(e,t),e===null?(l=x0(t.type,null,t.pendingProps,null))?t.memoizedState=l:me||(l=t.type,e=t.pendingProps,a=Gi(_.current).createElement(l),a[We]=t,a[st]=e,et(a,l,e),Ze(a),t.stateNode=a):t.memoizedState=x0(t.type,e.memoizedProps,t.pendingProps,e.memoizedState),null;case 27:return Qe(t),e===null&&me&&(a=t.stateNode=m0(t.type,t.pendingProps,_.current),$e=t,Ot=!0,n=Re,Ml(t.type)?(uu=n,Re=_t(a.firstChild)):Re=n),Pe(e,t,t.pendingProps.children,l),Ei(e,t),e===null&&(t.flags|=4194304),t.child;case 5:return e===null&&me&&((n=a=Re)&&(a=ah(a,t.type,t.pendingProps,Ot),a!==null?(t.stateNode=a,$e=t,Re=_t(a.firstChild),Ot=!1,n=!0):n=!1),n||yl(t)),Qe(t),n=t.type,i=t.pendingProps,c=e!==null?e.memoizedProps:null,a=i.children,au(n,i)?a=null:c!==null&&au(n,c)&&(t.flags|=32),t.memoizedState!==null&&(n=lc(e,t,gm,null,null,l),Mn._currentValue=n),Ei(e,t),Pe(e,t,a,l),t.child;case 6:return e===null&&me&&((e=l=Re)&&(l=nh(l,t.pendingProps,Ot),l!==null?(t.stateNode=l,$e=t,Re=null,e=!0):e=!1),e||yl(t)),null;case 13:return tf(e,t,l);case 4:return K(t,t.stateNode.containerInfo),a=t.pendingProps,e===null?t.child=Wl(t,null,a,l):Pe(e,t,a,l),t.child;case 11:return ko(e,t,t.type,t.pendingProps,l);case 7:return Pe(e,t,t.pendingProps,l),t.child;case 8:return Pe(e,t,t.pendingProps.children,l),t.child;case 12:return Pe(e,t,t.pendingProps.children,l),t.child;case 10:return a=t.pendingProps,xl(t,t.type,a.value),Pe(e,t,a.children,l),t.child;case 9:return n=t.type._context,a=t.pendingProps.children,kl(t),n=Ie(n),a=a(n),t.flags|=1,Pe(e,t,a,l),t.child;case 14:return Zo(e,t,t.type,t.pendingProps,l);case 15:return Ko(e,t,t.type,t.pendingProps,l);case 19:return af(e,t,l);case 31:return Nm(e,t,l);case 22:return Jo(e,t,l,t.pendingProps);case 24:return kl(t),a=Ie(Le),e===null?(n=ks(),n===null&&(n=Ce,i=Qs(),n.pooledCache=i,i.refCount++,i!==null&&(n.pooledCacheLanes|=l),n=i),t.memoizedState={parent:a,cache:n},Ks(t),xl(t,Le,n)):((e.lanes&l)!==0&&(Js(e,t),rn(t,null,null,l),un()),n=e.memoizedState,i=t.memoizedState,n.parent!==a?(n={parent:a,cache:a},t.memoizedState=n,t.lanes===0&&(t.memoizedState=t.updateQueue.baseState=n),xl(t,Le,a)):(a=i.cache,xl(t,Le,a),a!==n.cache&&Fs(t,[Le],l,!0))),Pe(e,t,t.pendingProps.children,l),t.child;case 29:throw t.pendingProps}throw Error(u(156,t.tag))}function el(e){e.flags|=4}function Rc(e,t,l,a,n){if((t=(e.mode&32)!==0)&&(t=!1),t){if(e.flags|=16777216,(n&335544128)===n)if(e.stateNode.complete)e.flags|=8192;else if(Df())e.flags|=8192;else throw Jl=ui,Zs}else e.flags&=-16777217}function sf(e,t){if(t.type!=="stylesheet"||(t.state.loading&4)!==0)e.flags&=-16777217;else if(e.flags|=16777216,!j0(t))if(Df())e.flags|=8192;else throw Jl=ui,Zs}function Ai(e,t){t!==null&&(e.flags|=4),e.flags&16384&&(t=e.tag!==22?Hu():536870912,e.lanes|=t,Ra|=t)}function yn(e,t){if(!me)switch(e.tailMode){case"hidden":t=e.tail;for(var l=null;t!==null;)t.alternate!==null&&(l=t),t=t.sibling;l===null?e.tail=null:l.sibling=null;break;case"collapsed":l=e.tail;for(var a=null;l!==null;)l.alternate!==null&&(a=l),l=l.sibling;a===null?t||e.tail===null?e.tail=null:e.tail.sibling=null:a.sibling=null}}function Oe(e){var t=e.alternate!==null&&e.alternate.child===e.child,l=0,a=0;if(t)for(var n=e.child;n!==null;)l|=n.lanes|n.childLanes,a|=n.subtreeFlags&65011712,a|=n.flags&65011712,n.return=e,n=n.sibling;else for(n=e.child;n!==null;)l|=n.lanes|n.childLanes,a|=n.subtreeFlags,a|=n.flags,n.return=e,n=n.sibling;return e.subtreeFlags|=a,e.childLanes=l,t}function zm(e,t,l){var a=t.pendingProps;switch(Vs(t),t.tag){case 16:case 15:case 0:case 11:case 7:case 8:case 12:case 9:case 14:return Oe(t),null;case 1:return Oe(t),null;case 3:return l=t.stateNode,a=null,e!==null&&(a=e.memoizedState.cache),t.memoizedState.cache!==a&&(t.flags|=2048),Wt(Le),ue(),l.pendingContext&&(l.context=l.pendingContext,l.pendingContext=null),(e===null||e.child===null)&&(pa(t)?el(t):e===null||e.memoizedState.isDehydrated&&(t.flags&256)===0||(t.flags|=1024,Gs())),Oe(t),null;case 26:var n=t.type,i=t.memoizedState;return e===null?(el(t),i!==null?(Oe(t),sf(t,i)):(Oe(t),Rc(t,n,null,a,l))):i?i!==e.memoizedState?(el(t),Oe(t),sf(t,i)):(Oe(t),t.flags&=-16777217):(e=e.memoizedProps,e!==a&&el(t),Oe(t),Rc(t,n,e,a,l)),null;case 27:if(Tt(t),l=_.current,n=t.type,e!==null&&t.stateNode!=null)e.memoizedProps!==a&&el(t);else{if(!a){if(t.stateNode===null)throw Error(u(166));return Oe(t),null}e=U.current,pa(t)?Hr(t):(e=m0(n,a,l),t.stateNode=e,el(t))}return Oe(t),null;case 5:if(Tt(t),n=t.type,e!==null&&t.stateNode!=null)e.memoizedProps!==a&&el(t);else{if(!a){if(t.stateNode===null)throw Error(u(166));return Oe(t),null}if(i=U.current,pa(t))Hr(t);else{var c=Gi(_.current);switch(i){case 1:i=c.createElementNS("http://www.w3.org/2000/svg",n);break;case 2:i=c.createElementNS("http://www.w3.org/1998/Math/MathML",n);break;default:switch(n){case"svg":i=c.createElementNS("http://www.w3.org/2000/svg",n);break;case"math":i=c.createElementNS("http://www.w3.org/1998/Math/MathML",n);break;case"script":i=c.createElement("div"),i.innerHTML="<script><\/script>",i=i.removeChild(i.firstChild);break;case"select":i=typeof a.is=="string"?c.createElement("select",{is:a.is}):c.createElement("select"),a.multiple?i.multiple=!0:a.size&&(i.size=a.size);break;default:i=typeof a.is=="string"?c.createElement(n,{is:a.is}):c.createElement(n)}}i[We]=t,i[st]=a;e:for(c=t.child;c!==null;){if(c.tag===5||c.tag===6)i.appendChild(c.stateNode);else if(c.tag!==4&&c.tag!==27&&c.child!==null){c.child.return=c,c=c.child;continue}if(c===t)break e;for(;c.sibling===null;){if(c.return===null||c.return===t)break e;c=c.return}c.sibling.return=c.return,c=c.sibling}t.stateNode=i;e:switch(et(i,n,a),n){case"button":case"input":case"select":case"textarea":a=!!a.autoFocus;break e;case"img":a=!0;break e;default:a=!1}a&&el(t)}}return Oe(t),Rc(t,t.type,e===null?null:e.memoizedProps,t.pendingProps,l),null;case 6:if(e&&t.stateNode!=null)e.memoizedProps!==a&&el(t);else{if(typeof a!="string"&&t.stateNode===null)throw Error(u(166));if(e=_.current,pa(t)){if(e=t.stateNode,l=t.memoizedProps,a=null,n=$e,n!==null)switch(n.tag){case 27:case 5:a=n.memoizedProps}e[We]=t,e=!!(e.nodeValue===l||a!==null&&a.suppressHydrationWarning===!0||l0(e.nodeValue,l)),e||yl(t,!0)}else e=Gi(e).createTextNode(a),e[We]=t,t.stateNode=e}return Oe(t),null;case 31:if(l=t.memoizedState,e===null||e.memoizedState!==null){if(a=pa(t),l!==null){if(e===null){if(!a)throw Error(u(318));if(e=t.memoizedState,e=e!==null?e.dehydrated:null,!e)throw Error(u(557));e[We]=t}else Ql(),(t.flags&128)===0&&(t.memoizedState=null),t.flags|=4;Oe(t),e=!1}else l=Gs(),e!==null&&e.memoizedState!==null&&(e.memoizedState.hydrationErrors=l),e=!0;if(!e)return t.flags&256?(vt(t),t):(vt(t),null);if((t.flags&128)!==0)throw Error(u(558))}return Oe(t),null;case 13:if(a=t.memoizedState,e===null||e.memoizedState!==null&&e.memoizedState.dehydrated!==null){if(n=pa(t),a!==null&&a.dehydrated!==null){if(e===null){if(!n)throw Error(u(318));if(n=t.memoizedState,n=n!==null?n.dehydrated:null,!n)throw Error(u(317));n[We]=t}else Ql(),(t.flags&128)===0&&(t.memoizedState=null),t.flags|=4;Oe(t),n=!1}else n=Gs(),e!==null&&e.memoizedState!==null&&(e.memoizedState.hydrationErrors=n),n=!0;if(!n)return t.flags&256?(vt(t),t):(vt(t),null)}return vt(t),(t.flags&128)!==0?(t.lanes=l,t):(l=a!==null,e=e!==null&&e.memoizedState!==null,l&&(a=t.child,n=null,a.alternate!==null&&a.alternate.memoizedState!==null&&a.alternate.memoizedState.cachePool!==null&&(n=a.alternate.memoizedState.cachePool.pool),i=null,a.memoizedState!==null&&a.memoizedState.cachePool!==null&&(i=a.memoizedState.cachePool.pool),i!==n&&(a.flags|=2048)),l!==e&&l&&(t.child.flags|=8192),Ai(t,t.updateQueue),Oe(t),null);case 4:return ue(),e===null&&Ic(t.stateNode.containerInfo),Oe(t),null;case 10:return Wt(t.type),Oe(t),null;case 19:if(g(Ue),a=t.memoizedState,a===null)return Oe(t),null;if(n=(t.flags&128)!==0,i=a.rendering,i===null)if(n)yn(a,!1);else{if(Be!==0||e!==null&&(e.flags&128)!==0)for(e=t.child;e!==null;){if(i=di(e),i!==null){for(t.flags|=128,yn(a,!1),e=i.updateQueue,t.updateQueue=e,Ai(t,e),t.subtreeFlags=0,e=l,l=t.child;l!==null;)Dr(l,e),l=l.sibling;return D(Ue,Ue.current&1|2),me&&Kt(t,a.treeForkCount),t.child}e=e.sibling}a.tail!==null&&mt()>Ri&&(t.flags|=128,n=!0,yn(a,!1),t.lanes=4194304)}else{if(!n)if(e=di(i),e!==null){if(t.flags|=128,n=!0,e=e.updateQueue,t.updateQueue=e,Ai(t,e),yn(a,!0),a.tail===null&&a.tailMode==="hidden"&&!i.alternate&&!me)return Oe(t),null}else 2*mt()-a.renderingStartTime>Ri&&l!==536870912&&(t.flags|=128,n=!0,yn(a,!1),t.lanes=4194304);a.isBackwards?(i.sibling=t.child,t.child=i):(e=a.last,e!==null?e.sibling=i:t.child=i,a.last=i)}return a.tail!==null?(e=a.tail,a.rendering=e,a.tail=e.sibling,a.renderingStartTime=mt(),e.sibling=null,l=Ue.current,D(Ue,n?l&1|2:l&1),me&&Kt(t,a.treeForkCount),e):(Oe(t),null);case 22:case 23:return vt(t),Ps(),a=t.memoizedState!==null,e!==null?e.memoizedState!==null!==a&&(t.flags|=8192):a&&(t.flags|=8192),a?(l&536870912)!==0&&(t.flags&128)===0&&(Oe(t),t.subtreeFlags&6&&(t.flags|=8192)):Oe(t),l=t.updateQueue,l!==null&&Ai(t,l.retryQueue),l=null,e!==null&&e.memoizedState!==null&&e.memoizedState.cachePool!==null&&(l=e.memoizedState.cachePool.pool),a=null,t.memoizedState!==null&&t.memoizedState.cachePool!==null&&(a=t.memoizedState.cachePool.pool),a!==l&&(t.flags|=2048),e!==null&&g(Zl),null;case 24:return l=null,e!==null&&(l=e.memoizedState.cache),t.memoizedState.cache!==l&&(t.flags|=2048),Wt(Le),Oe(t),null;case 25:return null;case 30:return null}throw Error(u(156,t.tag))}function Mm(e,t){switch(Vs(t),t.tag){case 1:return e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 3:return Wt(Le),ue(),e=t.flags,(e&65536)!==0&&(e&128)===0?(t.flags=e&-65537|128,t):null;case 26:case 27:case 5:return Tt(t),null;case 31:if(t.memoizedState!==null){if(vt(t),t.alternate===null)throw Error(u(340));Ql()}return e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 13:if(vt(t),e=t.memoizedState,e!==null&&e.dehydrated!==null){if(t.alternate===null)throw Error(u(340));Ql()}return e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 19:return g(Ue),null;case 4:return ue(),null;case 10:return Wt(t.type),null;case 22:case 23:return vt(t),Ps(),e!==null&&g(Zl),e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 24:return Wt(Le),null;case 25:return null;default:return null}}function cf(e,t){switch(Vs(t),t.tag){case 3:Wt(Le),ue();break;case 26:case 27:case 5:Tt(t);break;case 4:ue();break;case 31:t.memoizedState!==null&&vt(t);break;case 13:vt(t);break;case 19:g(Ue);break;case 10:Wt(t.type);break;case 22:case 23:vt(t),Ps(),e!==null&&g(Zl);break;case 24:Wt(Le)}}function xn(e,t){try{var l=t.updateQueue,a=l!==null?l.lastEffect:null;if(a!==null){var n=a.next;l=n;do{if((l.tag&e)===e){a=void 0;var i=l.create,c=l.inst;a=i(),c.destroy=a}l=l.next}while(l!==n)}}catch(d){Se(t,t.return,d)}}function Sl(e,t,l){try{var a=t.updateQueue,n=a!==null?a.lastEffect:null;if(n!==null){var i=n.next;a=i;do{if((a.tag&e)===e){var c=a.inst,d=c.destroy;if(d!==void 0){c.destroy=void 0,n=t;var y=l,S=d;try{S()}catch(N){Se(n,y,N)}}}a=a.next}while(a!==i)}}catch(N){Se(t,t.return,N)}}function uf(e){var t=e.updateQueue;if(t!==null){var l=e.stateNode;try{Wr(t,l)}catch(a){Se(e,e.return,a)}}}function rf(e,t,l){l.props=Il(e.type,e.memoizedProps),l.state=e.memoizedState;try{l.componentWillUnmount()}catch(a){Se(e,t,a)}}function pn(e,t){try{var l=e.ref;if(l!==null){switch(e.tag){case 26:case 27:case 5:var a=e.stateNode;break;case 30:a=e.stateNode;break;default:a=e.stateNode}typeof l=="function"?e.refCleanup=l(a):l.current=a}}catch(n){Se(e,t,n)}}function qt(e,t){var l=e.ref,a=e.refCleanup;if(l!==null)if(typeof a=="function")try{a()}catch(n){Se(e,t,n)}finally{e.refCleanup=null,e=e.alternate,e!=null&&(e.refCleanup=null)}else if(typeof l=="function")try{l(null)}catch(n){Se(e,t,n)}else l.current=null}function of(e){var t=e.type,l=e.memoizedProps,a=e.stateNode;try{e:switch(t){case"button":case"input":case"select":case"textarea":l.autoFocus&&a.focus();break e;case"img":l.src?a.src=l.src:l.srcSet&&(a.srcset=l.srcSet)}}catch(n){Se(e,e.return,n)}}function Oc(e,t,l){try{var a=e.stateNode;$m(a,e.type,l,t),a[st]=t}catch(n){Se(e,e.return,n)}}function ff(e){return e.tag===5||e.tag===3||e.tag===26||e.tag===27&&Ml(e.type)||e.tag===4}function Dc(e){e:for(;;){for(;e.sibling===null;){if(e.return===null||ff(e.return))return null;e=e.return}for(e.sibling.return=e.return,e=e.sibling;e.tag!==5&&e.tag!==6&&e.tag!==18;){if(e.tag===27&&Ml(e.type)||e.flags&2||e.child===null||e.tag===4)continue e;e.child.return=e,e=e.child}if(!(e.flags&2))return e.stateNode}}function _c(e,t,l){var a=e.tag;if(a===5||a===6)e=e.stateNode,t?(l.nodeType===9?l.body:l.nodeName==="HTML"?l.ownerDocument.body:l).insertBefore(e,t):(t=l.nodeType===9?l.body:l.nodeName==="HTML"?l.ownerDocument.body:l,t.appendChild(e),l=l._reactRootContainer,l!=null||t.onclick!==null||(t.onclick=Xt));else if(a!==4&&(a===27&&Ml(e.type)&&(l=e.stateNode,t=null),e=e.child,e!==null))for(_c(e,t,l),e=e.sibling;e!==null;)_c(e,t,l),e=e.sibling}function Ni(e,t,l){var a=e.tag;if(a===5||a===6)e=e.stateNode,t?l.insertBefore(e,t):l.appendChild(e);else if(a!==4&&(a===27&&Ml(e.type)&&(l=e.stateNode),e=e.child,e!==null))for(Ni(e,t,l),e=e.sibling;e!==null;)Ni(e,t,l),e=e.sibling}function df(e){var t=e.stateNode,l=e.memoizedProps;try{for(var a=e.type,n=t.attributes;n.length;)t.removeAttributeNode(n[0]);et(t,a,l),t[We]=e,t[st]=l}catch(i){Se(e,e.return,i)}}var tl=!1,Ye=!1,wc=!1,mf=typeof WeakSet=="function"?WeakSet:Set,Ke=null;function Rm(e,t){if(e=e.containerInfo,tu=Zi,e=Er(e),Cs(e)){if("selectionStart"in e)var l={start:e.selectionStart,end:e.selectionEnd};else e:{l=(l=e.ownerDocument)&&l.defaultView||window;var a=l.getSelection&&l.getSelection();if(a&&a.rangeCount!==0){l=a.anchorNode;var n=a.anchorOffset,i=a.focusNode;a=a.focusOffset;try{l.nodeType,i.nodeType}catch{l=null;break e}var c=0,d=-1,y=-1,S=0,N=0,R=e,E=null;t:for(;;){for(var T;R!==l||n!==0&&R.nodeType!==3||(d=c+n),R!==i||a!==0&&R.nodeType!==3||(y=c+a),R.nodeType===3&&(c+=R.nodeValue.length),(T=R.firstChild)!==null;)E=R,R=T;for(;;){if(R===e)break t;if(E===l&&++S===n&&(d=c),E===i&&++N===a&&(y=c),(T=R.nextSibling)!==null)break;R=E,E=R.parentNode}R=T}l=d===-1||y===-1?null:{start:d,end:y}}else l=null}l=l||{start:0,end:0}}else l=null;for(lu={focusedElem:e,selectionRange:l},Zi=!1,Ke=t;Ke!==null;)if(t=Ke,e=t.child,(t.subtreeFlags&1028)!==0&&e!==null)e.return=t,Ke=e;else for(;Ke!==null;){switch(t=Ke,i=t.alternate,e=t.flags,t.tag){case 0:if((e&4)!==0&&(e=t.updateQueue,e=e!==null?e.events:null,e!==null))for(l=0;l<e.length;l++)n=e[l],n.ref.impl=n.nextImpl;break;case 11:case 15:break;case 1:if((e&1024)!==0&&i!==null){e=void 0,l=t,n=i.memoizedProps,i=i.memoizedState,a=l.stateNode;try{var Y=Il(l.type,n);e=a.getSnapshotBeforeUpdate(Y,i),a.__reactInternalSnapshotBeforeUpdate=e}catch($){Se(l,l.return,$)}}break;case 3:if((e&1024)!==0){if(e=t.stateNode.containerInfo,l=e.nodeType,l===9)iu(e);else if(l===1)switch(e.nodeName){case"HEAD":case"HTML":case"BODY":iu(e);break;default:e.textContent=""}}break;case 5:case 26:case 27:case 6:case 4:case 17:break;default:if((e&1024)!==0)throw Error(u(163))}if(e=t.sibling,e!==null){e.return=t.return,Ke=e;break}Ke=t.return}}function hf(e,t,l){var a=l.flags;switch(l.tag){case 0:case 11:case 15:al(e,l),a&4&&xn(5,l);break;case 1:if(al(e,l),a&4)if(e=l.stateNode,t===null)try{e.componentDidMount()}catch(c){Se(l,l.return,c)}else{var n=Il(l.type,t.memoizedProps);t=t.memoizedState;try{e.componentDidUpdate(n,t,e.__reactInternalSnapshotBeforeUpdate)}catch(c){Se(l,l.return,c)}}a&64&&uf(l),a&512&&pn(l,l.return);break;case 3:if(al(e,l),a&64&&(e=l.updateQueue,e!==null)){if(t=null,l.child!==null)switch(l.child.tag){case 27:case 5:t=l.child.stateNode;break;case 1:t=l.child.stateNode}try{Wr(e,t)}catch(c){Se(l,l.return,c)}}break;case 27:t===null&&a&4&&df(l);case 26:case 5:al(e,l),t===null&&a&4&&of(l),a&512&&pn(l,l.return);break;case 12:al(e,l);break;case 31:al(e,l),a&4&&pf(e,l);break;case 13:al(e,l),a&4&&gf(e,l),a&64&&(e=l.memoizedState,e!==null&&(e=e.dehydrated,e!==null&&(l=Lm.bind(null,l),ih(e,l))));break;case 22:if(a=l.memoizedState!==null||tl,!a){t=t!==null&&t.memoizedState!==null||Ye,n=tl;var i=Ye;tl=a,(Ye=t)&&!i?nl(e,l,(l.subtreeFlags&8772)!==0):al(e,l),tl=n,Ye=i}break;case 30:break;default:al(e,l)}}function yf(e){var t=e.alternate;t!==null&&(e.alternate=null,yf(t)),e.child=null,e.deletions=null,e.sibling=null,e.tag===5&&(t=e.stateNode,t!==null&&os(t)),e.stateNode=null,e.return=null,e.dependencies=null,e.memoizedProps=null,e.memoizedState=null,e.pendingProps=null,e.stateNode=null,e.updateQueue=null}var De=null,ut=!1;function ll(e,t,l){for(l=l.child;l!==null;)xf(e,t,l),l=l.sibling}function xf(e,t,l){if(ht&&typeof ht.onCommitFiberUnmount=="function")try{ht.onCommitFiberUnmount(Ga,l)}catch{}switch(l.tag){case 26:Ye||qt(l,t),ll(e,t,l),l.memoizedState?l.memoizedState.count--:l.stateNode&&(l=l.stateNode,l.parentNode.removeChild(l));break;case 27:Ye||qt(l,t);var a=De,n=ut;Ml(l.type)&&(De=l.stateNode,ut=!1),ll(e,t,l),Nn(l.stateNode),De=a,ut=n;break;case 5:Ye||qt(l,t);case 6:if(a=De,n=ut,De=null,ll(e,t,l),De=a,ut=n,De!==null)if(ut)try{(De.nodeType===9?De.body:De.nodeName==="HTML"?De.ownerDocument.body:De).removeChild(l.stateNode)}catch(i){Se(l,t,i)}else try{De.removeChild(l.stateNode)}catch(i){Se(l,t,i)}break;case 18:De!==null&&(ut?(e=De,u0(e.nodeType===9?e.body:e.nodeName==="HTML"?e.ownerDocument.body:e,l.stateNode),Va(e)):u0(De,l.stateNode));break;case 4:a=De,n=ut,De=l.stateNode.containerInfo,ut=!0,ll(e,t,l),De=a,ut=n;break;case 0:case 11:case 14:case 15:Sl(2,l,t),Ye||Sl(4,l,t),ll(e,t,l);break;case 1:Ye||(qt(l,t),a=l.stateNode,typeof a.componentWillUnmount=="function"&&rf(l,t,a)),ll(e,t,l);break;case 21:ll(e,t,l);break;case 22:Ye=(a=Ye)||l.memoizedState!==null,ll(e,t,l),Ye=a;break;default:ll(e,t,l)}}function pf(e,t){if(t.memoizedState===null&&(e=t.alternate,e!==null&&(e=e.memoizedState,e!==null))){e=e.dehydrated;try{Va(e)}catch(l){Se(t,t.return,l)}}}function gf(e,t){if(t.memoizedState===null&&(e=t.alternate,e!==null&&(e=e.memoizedState,e!==null&&(e=e.dehydrated,e!==null))))try{Va(e)}catch(l){Se(t,t.return,l)}}function Om(e){switch(e.tag){case 31:case 13:case 19:var t=e.stateNode;return t===null&&(t=e.stateNode=new mf),t;case 22:return e=e.stateNode,t=e._retryCache,t===null&&(t=e._retryCache=new mf),t;default:throw Error(u(435,e.tag))}}function Ci(e,t){var l=Om(e);t.forEach(function(a){if(!l.has(a)){l.add(a);var n=Gm.bind(null,e,a);a.then(n,n)}})}function rt(e,t){var l=t.deletions;if(l!==null)for(var a=0;a<l.length;a++){var n=l[a],i=e,c=t,d=c;e:for(;d!==null;){switch(d.tag){case 27:if(Ml(d.type)){De=d.stateNode,ut=!1;break e}break;case 5:De=d.stateNode,ut=!1;break e;case 3:case 4:De=d.stateNode.containerInfo,ut=!0;break e}d=d.return}if(De===null)throw Error(u(160));xf(i,c,n),De=null,ut=!1,i=n.alternate,i!==null&&(i.return=null),n.return=null}if(t.subtreeFlags&13886)for(t=t.child;t!==null;)vf(t,e),t=t.sibling}var Ut=null;function vf(e,t){var l=e.alternate,a=e.flags;switch(e.tag){case 0:case 11:case 14:case 15:rt(t,e),ot(e),a&4&&(Sl(3,e,e.return),xn(3,e),Sl(5,e,e.return));break;case 1:rt(t,e),ot(e),a&512&&(Ye||l===null||qt(l,l.return)),a&64&&tl&&(e=e.updateQueue,e!==null&&(a=e.callbacks,a!==null&&(l=e.shared.hiddenCallbacks,e.shared.hiddenCallbacks=l===null?a:l.concat(a))));break;case 26:var n=Ut;if(rt(t,e),ot(e),a&512&&(Ye||l===null||qt(l,l.return)),a&4){var i=l!==null?l.memoizedState:null;if(a=e.memoizedState,l===null)if(a===null)if(e.stateNode===null){e:{a=e.type,l=e.memoizedProps,n=n.ownerDocument||n;t:switch(a){case"title":i=n.getElementsByTagName("title")[0],(!i||i[Fa]||i[We]||i.namespaceURI==="http://www.w3.org/2000/svg"||i.hasAttribute("itemprop"))&&(i=n.createElement(a),n.head.insertBefore(i,n.querySelector("head > title"))),et(i,a,l),i[We]=e,Ze(i),a=i;break e;case"link":var c=v0("link","href",n).get(a+(l.href||""));if(c){for(var d=0;d<c.length;d++)if(i=c[d],i.getAttribute("href")===(l.href==null||l.href===""?null:l.href)&&i.getAttribute("rel")===(l.rel==null?null:l.rel)&&i.getAttribute("title")===(l.title==null?null:l.title)&&i.getAttribute("crossorigin")===(l.crossOrigin==null?null:l.crossOrigin)){c.splice(d,1);break t}}i=n.createElement(a),et(i,a,l),n.head.appendChild(i);break;case"meta":if(c=v0("meta","content",n).get(a+(l.content||""))){for(d=0;d<c.length;d++)if(i=c[d],i.getAttribute("content")===(l.content==null?null:""+l.content)&&i.getAttribute("name")===(l.name==null?null:l.name)&&i.getAttribute("property")===(l.property==null?null:l.property)&&i.getAttribute("http-equiv")===(l.httpEquiv==null?null:l.httpEquiv)&&i.getAttribute("charset")===(l.charSet==null?null:l.charSet)){c.splice(d,1);break t}}i=n.createElement(a),et(i,a,l),n.head.appendChild(i);break;default:throw Error(u(468,a))}i[We]=e,Ze(i),a=i}e.stateNode=a}else b0(n,e.type,e.stateNode);else e.stateNode=g0(n,a,e.memoizedProps);else i!==a?(i===null?l.stateNode!==null&&(l=l.stateNode,l.parentNode.removeChild(l)):i.count--,a===null?b0(n,e.type,e.stateNode):g0(n,a,e.memoizedProps)):a===null&&e.stateNode!==null&&Oc(e,e.memoizedProps,l.memoizedProps)}break;case 27:rt(t,e),ot(e),a&512&&(Ye||l===null||qt(l,l.return)),l!==null&&a&4&&Oc(e,e.memoizedProps,l.memoizedProps);break;case 5:if(rt(t,e),ot(e),a&512&&(Ye||l===null||qt(l,l.return)),e.flags&32){n=e.stateNode;try{ca(n,"")}catch(Y){Se(e,e.return,Y)}}a&4&&e.stateNode!=null&&(n=e.memoizedProps,Oc(e,n,l!==null?l.memoizedProps:n)),a&1024&&(wc=!0);break;case 6:if(rt(t,e),ot(e),a&4){if(e.stateNode===null)throw Error(u(162));a=e.memoizedProps,l=e.stateNode;try{l.nodeValue=a}catch(Y){Se(e,e.return,Y)}}break;case 3:if(Fi=null,n=Ut,Ut=qi(t.containerInfo),rt(t,e),Ut=n,ot(e),a&4&&l!==null&&l.memoizedState.isDehydrated)try{Va(t.containerInfo)}catch(Y){Se(e,e.return,Y)}wc&&(wc=!1,bf(e));break;case 4:a=Ut,Ut=qi(e.stateNode.containerInfo),rt(t,e),ot(e),Ut=a;break;case 12:rt(t,e),ot(e);break;case 31:rt(t,e),ot(e),a&4&&(a=e.updateQueue,a!==null&&(e.updateQueue=null,Ci(e,a)));break;case 13:rt(t,e),ot(e),e.child.flags&8192&&e.memoizedState!==null!=(l!==null&&l.memoizedState!==null)&&(Mi=mt()),a&4&&(a=e.updateQueue,a!==null&&(e.updateQueue=null,Ci(e,a)));break;case 22:n=e.memoizedState!==null;var y=l!==null&&l.memoizedState!==null,S=tl,N=Ye;if(tl=S||n,Ye=N||y,rt(t,e),Ye=N,tl=S,ot(e),a&8192)e:for(t=e.stateNode,t._visibility=n?t._visibility&-2:t._visibility|1,n&&(l===null||y||tl||Ye||Pl(e)),l=null,t=e;;){if(t.tag===5||t.tag===26){if(l===null){y=l=t;try{if(i=y.stateNode,n)c=i.style,typeof c.setProperty=="function"?c.setProperty("display","none","important"):c.display="none";else{d=y.stateNode;var R=y.memoizedProps.style,E=R!=null&&R.hasOwnProperty("display")?R.display:null;d.style.display=E==null||typeof E=="boolean"?"":(""+E).trim()}}catch(Y){Se(y,y.return,Y)}}}else if(t.tag===6){if(l===null){y=t;try{y.stateNode.nodeValue=n?"":y.memoizedProps}catch(Y){Se(y,y.return,Y)}}}else if(t.tag===18){if(l===null){y=t;try{var T=y.stateNode;n?r0(T,!0):r0(y.stateNode,!1)}catch(Y){Se(y,y.return,Y)}}}else if((t.tag!==22&&t.tag!==23||t.memoizedState===null||t===e)&&t.child!==null){t.child.return=t,t=t.child;continue}if(t===e)break e;for(;t.sibling===null;){if(t.return===null||t.return===e)break e;l===t&&(l=null),t=t.return}l===t&&(l=null),t.sibling.return=t.return,t=t.sibling}a&4&&(a=e.updateQueue,a!==null&&(l=a.retryQueue,l!==null&&(a.retryQueue=null,Ci(e,l))));break;case 19:rt(t,e),ot(e),a&4&&(a=e.updateQueue,a!==null&&(e.updateQueue=null,Ci(e,a)));break;case 30:break;case 21:break;default:rt(t,e),ot(e)}}function ot(e){var t=e.flags;if(t&2){try{for(var l,a=e.return;a!==null;){if(ff(a)){l=a;break}a=a.return}if(l==null)throw Error(u(160));switch(l.tag){case 27:var n=l.stateNode,i=Dc(e);Ni(e,i,n);break;case 5:var c=l.stateNode;l.flags&32&&(ca(c,""),l.flags&=-33);var d=Dc(e);Ni(e,d,c);break;case 3:case 4:var y=l.stateNode.containerInfo,S=Dc(e);_c(e,S,y);break;default:throw Error(u(161))}}catch(N){Se(e,e.return,N)}e.flags&=-3}t&4096&&(e.flags&=-4097)}function bf(e){if(e.subtreeFlags&1024)for(e=e.child;e!==null;){var t=e;bf(t),t.tag===5&&t.flags&1024&&t.stateNode.reset(),e=e.sibling}}function al(e,t){if(t.subtreeFlags&8772)for(t=t.child;t!==null;)hf(e,t.alternate,t),t=t.sibling}function Pl(e){for(e=e.child;e!==null;){var t=e;switch(t.tag){case 0:case 11:case 14:case 15:Sl(4,t,t.return),Pl(t);break;case 1:qt(t,t.return);var l=t.stateNode;typeof l.componentWillUnmount=="function"&&rf(t,t.return,l),Pl(t);break;case 27:Nn(t.stateNode);case 26:case 5:qt(t,t.return),Pl(t);break;case 22:t.memoizedState===null&&Pl(t);break;case 30:Pl(t);break;default:Pl(t)}e=e.sibling}}function nl(e,t,l){for(l=l&&(t.subtreeFlags&8772)!==0,t=t.child;t!==null;){var a=t.alternate,n=e,i=t,c=i.flags;switch(i.tag){case 0:case 11:case 15:nl(n,i,l),xn(4,i);break;case 1:if(nl(n,i,l),a=i,n=a.stateNode,typeof n.componentDidMount=="function")try{n.componentDidMount()}catch(S){Se(a,a.return,S)}if(a=i,n=a.updateQueue,n!==null){var d=a.stateNode;try{var y=n.shared.hiddenCallbacks;if(y!==null)for(n.shared.hiddenCallbacks=null,n=0;n<y.length;n++)Jr(y[n],d)}catch(S){Se(a,a.return,S)}}l&&c&64&&uf(i),pn(i,i.return);break;case 27:df(i);case 26:case 5:nl(n,i,l),l&&a===null&&c&4&&of(i),pn(i,i.return);break;case 12:nl(n,i,l);break;case 31:nl(n,i,l),l&&c&4&&pf(n,i);break;case 13:nl(n,i,l),l&&c&4&&gf(n,i);break;case 22:i.memoizedState===null&&nl(n,i,l),pn(i,i.return);break;case 30:break;default:nl(n,i,l)}t=t.sibling}}function Bc(e,t){var l=null;e!==null&&e.memoizedState!==null&&e.memoizedState.cachePool!==null&&(l=e.memoizedState.cachePool.pool),e=null,t.memoizedState!==null&&t.memoizedState.cachePool!==null&&(e=t.memoizedState.cachePool.pool),e!==l&&(e!=null&&e.refCount++,l!=null&&ln(l))}function Uc(e,t){e=null,t.alternate!==null&&(e=t.alternate.memoizedState.cache),t=t.memoizedState.cache,t!==e&&(t.refCount++,e!=null&&ln(e))}function Ht(e,t,l,a){if(t.subtreeFlags&10256)for(t=t.child;t!==null;)jf(e,t,l,a),t=t.sibling}function jf(e,t,l,a){var n=t.flags;switch(t.tag){case 0:case 11:case 15:Ht(e,t,l,a),n&2048&&xn(9,t);break;case 1:Ht(e,t,l,a);break;case 3:Ht(e,t,l,a),n&2048&&(e=null,t.alternate!==null&&(e=t.alternate.memoizedState.cache),t=t.memoizedState.cache,t!==e&&(t.refCount++,e!=null&&ln(e)));break;case 12:if(n&2048){Ht(e,t,l,a),e=t.stateNode;try{var i=t.memoizedProps,c=i.id,d=i.onPostCommit;typeof d=="function"&&d(c,t.alternate===null?"mount":"update",e.passiveEffectDuration,-0)}catch(y){Se(t,t.return,y)}}else Ht(e,t,l,a);break;case 31:Ht(e,t,l,a);break;case 13:Ht(e,t,l,a);break;case 23:break;case 22:i=t.stateNode,c=t.alternate,t.memoizedState!==null?i._visibility&2?Ht(e,t,l,a):gn(e,t):i._visibility&2?Ht(e,t,l,a):(i._visibility|=2,Ca(e,t,l,a,(t.subtreeFlags&10256)!==0||!1)),n&2048&&Bc(c,t);break;case 24:Ht(e,t,l,a),n&2048&&Uc(t.alternate,t);break;default:Ht(e,t,l,a)}}function Ca(e,t,l,a,n){for(n=n&&((t.subtreeFlags&10256)!==0||!1),t=t.child;t!==null;){var i=e,c=t,d=l,y=a,S=c.flags;switch(c.tag){case 0:case 11:case 15:Ca(i,c,d,y,n),xn(8,c);break;case 23:break;case 22:var N=c.stateNode;c.memoizedState!==null?N._visibility&2?Ca(i,c,d,y,n):gn(i,c):(N._visibility|=2,Ca(i,c,d,y,n)),n&&S&2048&&Bc(c.alternate,c);break;case 24:Ca(i,c,d,y,n),n&&S&2048&&Uc(c.alternate,c);break;default:Ca(i,c,d,y,n)}t=t.sibling}}function gn(e,t){if(t.subtreeFlags&10256)for(t=t.child;t!==null;){var l=e,a=t,n=a.flags;switch(a.tag){case 22:gn(l,a),n&2048&&Bc(a.alternate,a);break;case 24:gn(l,a),n&2048&&Uc(a.alternate,a);break;default:gn(l,a)}t=t.sibling}}var vn=8192;function za(e,t,l){if(e.subtreeFlags&vn)for(e=e.child;e!==null;)Sf(e,t,l),e=e.sibling}function Sf(e,t,l){switch(e.tag){case 26:za(e,t,l),e.flags&vn&&e.memoizedState!==null&&ph(l,Ut,e.memoizedState,e.memoizedProps);break;case 5:za(e,t,l);break;case 3:case 4:var a=Ut;Ut=qi(e.stateNode.containerInfo),za(e,t,l),Ut=a;break;case 22:e.memoizedState===null&&(a=e.alternate,a!==null&&a.memoizedState!==null?(a=vn,vn=16777216,za(e,t,l),vn=a):za(e,t,l));break;default:za(e,t,l)}}function Ef(e){var t=e.alternate;if(t!==null&&(e=t.child,e!==null)){t.child=null;do t=e.sibling,e.sibling=null,e=t;while(e!==null)}}function bn(e){var t=e.deletions;if((e.flags&16)!==0){if(t!==null)for(var l=0;l<t.length;l++){var a=t[l];Ke=a,Af(a,e)}Ef(e)}if(e.subtreeFlags&10256)for(e=e.child;e!==null;)Tf(e),e=e.sibling}function Tf(e){switch(e.tag){case 0:case 11:case 15:bn(e),e.flags&2048&&Sl(9,e,e.return);break;case 3:bn(e);break;case 12:bn(e);break;case 22:var t=e.stateNode;e.memoizedState!==null&&t._visibility&2&&(e.return===null||e.return.tag!==13)?(t._visibility&=-3,zi(e)):bn(e);break;default:bn(e)}}function zi(e){var t=e.deletions;if((e.flags&16)!==0){if(t!==null)for(var l=0;l<t.length;l++){var a=t[l];Ke=a,Af(a,e)}Ef(e)}for(e=e.child;e!==null;){switch(t=e,t.tag){case 0:case 11:case 15:Sl(8,t,t.return),zi(t);break;case 22:l=t.stateNode,l._visibility&2&&(l._visibility&=-3,zi(t));break;default:zi(t)}e=e.sibling}}function Af(e,t){for(;Ke!==null;){var l=Ke;switch(l.tag){case 0:case 11:case 15:Sl(8,l,t);break;case 23:case 22:if(l.memoizedState!==null&&l.memoizedState.cachePool!==null){var a=l.memoizedState.cachePool.pool;a!=null&&a.refCount++}break;case 24:ln(l.memoizedState.cache)}if(a=l.child,a!==null)a.return=l,Ke=a;else e:for(l=e;Ke!==null;){a=Ke;var n=a.sibling,i=a.return;if(yf(a),a===l){Ke=null;break e}if(n!==null){n.return=i,Ke=n;break e}Ke=i}}}var Dm={getCacheForType:function(e){var t=Ie(Le),l=t.data.get(e);return l===void 0&&(l=e(),t.data.set(e,l)),l},cacheSignal:function(){return Ie(Le).controller.signal}},_m=typeof WeakMap=="function"?WeakMap:Map,ve=0,Ce=null,re=null,fe=0,je=0,bt=null,El=!1,Ma=!1,Hc=!1,il=0,Be=0,Tl=0,ea=0,Vc=0,jt=0,Ra=0,jn=null,ft=null,Lc=!1,Mi=0,Nf=0,Ri=1/0,Oi=null,Al=null,Xe=0,Nl=null,Oa=null,sl=0,Gc=0,qc=null,Cf=null,Sn=0,Yc=null;function St(){return(ve&2)!==0&&fe!==0?fe&-fe:A.T!==null?Kc():qu()}function zf(){if(jt===0)if((fe&536870912)===0||me){var e=Ln;Ln<<=1,(Ln&3932160)===0&&(Ln=262144),jt=e}else jt=536870912;return e=gt.current,e!==null&&(e.flags|=32),jt}function dt(e,t,l){(e===Ce&&(je===2||je===9)||e.cancelPendingCommit!==null)&&(Da(e,0),Cl(e,fe,jt,!1)),Ya(e,l),((ve&2)===0||e!==Ce)&&(e===Ce&&((ve&2)===0&&(ea|=l),Be===4&&Cl(e,fe,jt,!1)),Yt(e))}function Mf(e,t,l){if((ve&6)!==0)throw Error(u(327));var a=!l&&(t&127)===0&&(t&e.expiredLanes)===0||qa(e,t),n=a?Um(e,t):Qc(e,t,!0),i=a;do{if(n===0){Ma&&!a&&Cl(e,t,0,!1);break}else{if(l=e.current.alternate,i&&!wm(l)){n=Qc(e,t,!1),i=!1;continue}if(n===2){if(i=t,e.errorRecoveryDisabledLanes&i)var c=0;else c=e.pendingLanes&-536870913,c=c!==0?c:c&536870912?536870912:0;if(c!==0){t=c;e:{var d=e;n=jn;var y=d.current.memoizedState.isDehydrated;if(y&&(Da(d,c).flags|=256),c=Qc(d,c,!1),c!==2){if(Hc&&!y){d.errorRecoveryDisabledLanes|=i,ea|=i,n=4;break e}i=ft,ft=n,i!==null&&(ft===null?ft=i:ft.push.apply(ft,i))}n=c}if(i=!1,n!==2)continue}}if(n===1){Da(e,0),Cl(e,t,0,!0);break}e:{switch(a=e,i=n,i){case 0:case 1:throw Error(u(345));case 4:if((t&4194048)!==t)break;case 6:Cl(a,t,jt,!El);break e;case 2:ft=null;break;case 3:case 5:break;default:throw Error(u(329))}if((t&62914560)===t&&(n=Mi+300-mt(),10<n)){if(Cl(a,t,jt,!El),qn(a,0,!0)!==0)break e;sl=t,a.timeoutHandle=s0(Rf.bind(null,a,l,ft,Oi,Lc,t,jt,ea,Ra,El,i,"Throttled",-0,0),n);break e}Rf(a,l,ft,Oi,Lc,t,jt,ea,Ra,El,i,null,-0,0)}}break}while(!0);Yt(e)}function Rf(e,t,l,a,n,i,c,d,y,S,N,R,E,T){if(e.timeoutHandle=-1,R=t.subtreeFlags,R&8192||(R&16785408)===16785408){R={stylesheets:null,count:0,imgCount:0,imgBytes:0,suspenseyImages:[],waitingForImages:!0,waitingForViewTransition:!1,unsuspend:Xt},Sf(t,i,R);var Y=(i&62914560)===i?Mi-mt():(i&4194048)===i?Nf-mt():0;if(Y=gh(R,Y),Y!==null){sl=i,e.cancelPendingCommit=Y(Vf.bind(null,e,t,i,l,a,n,c,d,y,N,R,null,E,T)),Cl(e,i,c,!S);return}}Vf(e,t,i,l,a,n,c,d,y)}function wm(e){for(var t=e;;){var l=t.tag;if((l===0||l===11||l===15)&&t.flags&16384&&(l=t.updateQueue,l!==null&&(l=l.stores,l!==null)))for(var a=0;a<l.length;a++){var n=l[a],i=n.getSnapshot;n=n.value;try{if(!xt(i(),n))return!1}catch{return!1}}if(l=t.child,t.subtreeFlags&16384&&l!==null)l.return=t,t=l;else{if(t===e)break;for(;t.sibling===null;){if(t.return===null||t.return===e)return!0;t=t.return}t.sibling.return=t.return,t=t.sibling}}return!0}function Cl(e,t,l,a){t&=~Vc,t&=~ea,e.suspendedLanes|=t,e.pingedLanes&=~t,a&&(e.warmLanes|=t),a=e.expirationTimes;for(var n=t;0<n;){var i=31-yt(n),c=1<<i;a[i]=-1,n&=~c}l!==0&&Vu(e,l,t)}function Di(){return(ve&6)===0?(En(0),!1):!0}function Fc(){if(re!==null){if(je===0)var e=re.return;else e=re,Jt=Xl=null,ic(e),Sa=null,nn=0,e=re;for(;e!==null;)cf(e.alternate,e),e=e.return;re=null}}function Da(e,t){var l=e.timeoutHandle;l!==-1&&(e.timeoutHandle=-1,eh(l)),l=e.cancelPendingCommit,l!==null&&(e.cancelPendingCommit=null,l()),sl=0,Fc(),Ce=e,re=l=Zt(e.current,null),fe=t,je=0,bt=null,El=!1,Ma=qa(e,t),Hc=!1,Ra=jt=Vc=ea=Tl=Be=0,ft=jn=null,Lc=!1,(t&8)!==0&&(t|=t&32);var a=e.entangledLanes;if(a!==0)for(e=e.entanglements,a&=t;0<a;){var n=31-yt(a),i=1<<n;t|=e[n],a&=~i}return il=t,Pn(),l}function Of(e,t){ne=null,A.H=mn,t===ja||t===ci?(t=Xr(),je=3):t===Zs?(t=Xr(),je=4):je=t===jc?8:t!==null&&typeof t=="object"&&typeof t.then=="function"?6:1,bt=t,re===null&&(Be=1,ji(e,zt(t,e.current)))}function Df(){var e=gt.current;return e===null?!0:(fe&4194048)===fe?Dt===null:(fe&62914560)===fe||(fe&536870912)!==0?e===Dt:!1}function _f(){var e=A.H;return A.H=mn,e===null?mn:e}function wf(){var e=A.A;return A.A=Dm,e}function _i(){Be=4,El||(fe&4194048)!==fe&&gt.current!==null||(Ma=!0),(Tl&134217727)===0&&(ea&134217727)===0||Ce===null||Cl(Ce,fe,jt,!1)}function Qc(e,t,l){var a=ve;ve|=2;var n=_f(),i=wf();(Ce!==e||fe!==t)&&(Oi=null,Da(e,t)),t=!1;var c=Be;e:do try{if(je!==0&&re!==null){var d=re,y=bt;switch(je){case 8:Fc(),c=6;break e;case 3:case 2:case 9:case 6:gt.current===null&&(t=!0);var S=je;if(je=0,bt=null,_a(e,d,y,S),l&&Ma){c=0;break e}break;default:S=je,je=0,bt=null,_a(e,d,y,S)}}Bm(),c=Be;break}catch(N){Of(e,N)}while(!0);return t&&e.shellSuspendCounter++,Jt=Xl=null,ve=a,A.H=n,A.A=i,re===null&&(Ce=null,fe=0,Pn()),c}function Bm(){for(;re!==null;)Bf(re)}function Um(e,t){var l=ve;ve|=2;var a=_f(),n=wf();Ce!==e||fe!==t?(Oi=null,Ri=mt()+500,Da(e,t)):Ma=qa(e,t);e:do try{if(je!==0&&re!==null){t=re;var i=bt;t:switch(je){case 1:je=0,bt=null,_a(e,t,i,1);break;case 2:case 9:if(Fr(i)){je=0,bt=null,Uf(t);break}t=function(){je!==2&&je!==9||Ce!==e||(je=7),Yt(e)},i.then(t,t);break e;case 3:je=7;break e;case 4:je=5;break e;case 7:Fr(i)?(je=0,bt=null,Uf(t)):(je=0,bt=null,_a(e,t,i,7));break;case 5:var c=null;switch(re.tag){case 26:c=re.memoizedState;case 5:case 27:var d=re;if(c?j0(c):d.stateNode.complete){je=0,bt=null;var y=d.sibling;if(y!==null)re=y;else{var S=d.return;S!==null?(re=S,wi(S)):re=null}break t}}je=0,bt=null,_a(e,t,i,5);break;case 6:je=0,bt=null,_a(e,t,i,6);break;case 8:Fc(),Be=6;break e;default:throw Error(u(462))}}Hm();break}catch(N){Of(e,N)}while(!0);return Jt=Xl=null,A.H=a,A.A=n,ve=l,re!==null?0:(Ce=null,fe=0,Pn(),Be)}function Hm(){for(;re!==null&&!sd();)Bf(re)}function Bf(e){var t=nf(e.alternate,e,il);e.memoizedProps=e.pendingProps,t===null?wi(e):re=t}function Uf(e){var t=e,l=t.alternate;switch(t.tag){case 15:case 0:t=Io(l,t,t.pendingProps,t.type,void 0,fe);break;case 11:t=Io(l,t,t.pendingProps,t.type.render,t.ref,fe);break;case 5:ic(t);default:cf(l,t),t=re=Dr(t,il),t=nf(l,t,il)}e.memoizedProps=e.pendingProps,t===null?wi(e):re=t}function _a(e,t,l,a){Jt=Xl=null,ic(t),Sa=null,nn=0;var n=t.return;try{if(Am(e,n,t,l,fe)){Be=1,ji(e,zt(l,e.current)),re=null;return}}catch(i){if(n!==null)throw re=n,i;Be=1,ji(e,zt(l,e.current)),re=null;return}t.flags&32768?(me||a===1?e=!0:Ma||(fe&536870912)!==0?e=!1:(El=e=!0,(a===2||a===9||a===3||a===6)&&(a=gt.current,a!==null&&a.tag===13&&(a.flags|=16384))),Hf(t,e)):wi(t)}function wi(e){var t=e;do{if((t.flags&32768)!==0){Hf(t,El);return}e=t.return;var l=zm(t.alternate,t,il);if(l!==null){re=l;return}if(t=t.sibling,t!==null){re=t;return}re=t=e}while(t!==null);Be===0&&(Be=5)}function Hf(e,t){do{var l=Mm(e.alternate,e);if(l!==null){l.flags&=32767,re=l;return}if(l=e.return,l!==null&&(l.flags|=32768,l.subtreeFlags=0,l.deletions=null),!t&&(e=e.sibling,e!==null)){re=e;return}re=e=l}while(e!==null);Be=6,re=null}function Vf(e,t,l,a,n,i,c,d,y){e.cancelPendingCommit=null;do Bi();while(Xe!==0);if((ve&6)!==0)throw Error(u(327));if(t!==null){if(t===e.current)throw Error(u(177));if(i=t.lanes|t.childLanes,i|=Ds,xd(e,l,i,c,d,y),e===Ce&&(re=Ce=null,fe=0),Oa=t,Nl=e,sl=l,Gc=i,qc=n,Cf=a,(t.subtreeFlags&10256)!==0||(t.flags&10256)!==0?(e.callbackNode=null,e.callbackPriority=0,qm(Hn,function(){return Ff(),null})):(e.callbackNode=null,e.callbackPriority=0),a=(t.flags&13878)!==0,(t.subtreeFlags&13878)!==0||a){a=A.T,A.T=null,n=H.p,H.p=2,c=ve,ve|=4;try{Rm(e,t,l)}finally{ve=c,H.p=n,A.T=a}}Xe=1,Lf(),Gf(),qf()}}function Lf(){if(Xe===1){Xe=0;var e=Nl,t=Oa,l=(t.flags&13878)!==0;if((t.subtreeFlags&13878)!==0||l){l=A.T,A.T=null;var a=H.p;H.p=2;var n=ve;ve|=4;try{vf(t,e);var i=lu,c=Er(e.containerInfo),d=i.focusedElem,y=i.selectionRange;if(c!==d&&d&&d.ownerDocument&&Sr(d.ownerDocument.documentElement,d)){if(y!==null&&Cs(d)){var S=y.start,N=y.end;if(N===void 0&&(N=S),"selectionStart"in d)d.selectionStart=S,d.selectionEnd=Math.min(N,d.value.length);else{var R=d.ownerDocument||document,E=R&&R.defaultView||window;if(E.getSelection){var T=E.getSelection(),Y=d.textContent.length,$=Math.min(y.start,Y),Ae=y.end===void 0?$:Math.min(y.end,Y);!T.extend&&$>Ae&&(c=Ae,Ae=$,$=c);var v=jr(d,$),p=jr(d,Ae);if(v&&p&&(T.rangeCount!==1||T.anchorNode!==v.node||T.anchorOffset!==v.offset||T.focusNode!==p.node||T.focusOffset!==p.offset)){var j=R.createRange();j.setStart(v.node,v.offset),T.removeAllRanges(),$>Ae?(T.addRange(j),T.extend(p.node,p.offset)):(j.setEnd(p.node,p.offset),T.addRange(j))}}}}for(R=[],T=d;T=T.parentNode;)T.nodeType===1&&R.push({element:T,left:T.scrollLeft,top:T.scrollTop});for(typeof d.focus=="function"&&d.focus(),d=0;d<R.length;d++){var C=R[d];C.element.scrollLeft=C.left,C.element.scrollTop=C.top}}Zi=!!tu,lu=tu=null}finally{ve=n,H.p=a,A.T=l}}e.current=t,Xe=2}}function Gf(){if(Xe===2){Xe=0;var e=Nl,t=Oa,l=(t.flags&8772)!==0;if((t.subtreeFlags&8772)!==0||l){l=A.T,A.T=null;var a=H.p;H.p=2;var n=ve;ve|=4;try{hf(e,t.alternate,t)}finally{ve=n,H.p=a,A.T=l}}Xe=3}}function qf(){if(Xe===4||Xe===3){Xe=0,cd();var e=Nl,t=Oa,l=sl,a=Cf;(t.subtreeFlags&10256)!==0||(t.flags&10256)!==0?Xe=5:(Xe=0,Oa=Nl=null,Yf(e,e.pendingLanes));var n=e.pendingLanes;if(n===0&&(Al=null),us(l),t=t.stateNode,ht&&typeof ht.onCommitFiberRoot=="function")try{ht.onCommitFiberRoot(Ga,t,void 0,(t.current.flags&128)===128)}catch{}if(a!==null){t=A.T,n=H.p,H.p=2,A.T=null;try{for(var i=e.onRecoverableError,c=0;c<a.length;c++){var d=a[c];i(d.value,{componentStack:d.stack})}}finally{A.T=t,H.p=n}}(sl&3)!==0&&Bi(),Yt(e),n=e.pendingLanes,(l&261930)!==0&&(n&42)!==0?e===Yc?Sn++:(Sn=0,Yc=e):Sn=0,En(0)}}function Yf(e,t){(e.pooledCacheLanes&=t)===0&&(t=e.pooledCache,t!=null&&(e.pooledCache=null,ln(t)))}function Bi(){return Lf(),Gf(),qf(),Ff()}function Ff(){if(Xe!==5)return!1;var e=Nl,t=Gc;Gc=0;var l=us(sl),a=A.T,n=H.p;try{H.p=32>l?32:l,A.T=null,l=qc,qc=null;var i=Nl,c=sl;if(Xe=0,Oa=Nl=null,sl=0,(ve&6)!==0)throw Error(u(331));var d=ve;if(ve|=4,Tf(i.current),jf(i,i.current,c,l),ve=d,En(0,!1),ht&&typeof ht.onPostCommitFiberRoot=="function")try{ht.onPostCommitFiberRoot(Ga,i)}catch{}return!0}finally{H.p=n,A.T=a,Yf(e,t)}}function Qf(e,t,l){t=zt(l,t),t=bc(e.stateNode,t,2),e=vl(e,t,2),e!==null&&(Ya(e,2),Yt(e))}function Se(e,t,l){if(e.tag===3)Qf(e,e,l);else for(;t!==null;){if(t.tag===3){Qf(t,e,l);break}else if(t.tag===1){var a=t.stateNode;if(typeof t.type.getDerivedStateFromError=="function"||typeof a.componentDidCatch=="function"&&(Al===null||!Al.has(a))){e=zt(l,e),l=Qo(2),a=vl(t,l,2),a!==null&&(Xo(l,a,t,e),Ya(a,2),Yt(a));break}}t=t.return}}function Xc(e,t,l){var a=e.pingCache;if(a===null){a=e.pingCache=new _m;var n=new Set;a.set(t,n)}else n=a.get(t),n===void 0&&(n=new Set,a.set(t,n));n.has(l)||(Hc=!0,n.add(l),e=Vm.bind(null,e,t,l),t.then(e,e))}function Vm(e,t,l){var a=e.pingCache;a!==null&&a.delete(t),e.pingedLanes|=e.suspendedLanes&l,e.warmLanes&=~l,Ce===e&&(fe&l)===l&&(Be===4||Be===3&&(fe&62914560)===fe&&300>mt()-Mi?(ve&2)===0&&Da(e,0):Vc|=l,Ra===fe&&(Ra=0)),Yt(e)}function Xf(e,t){t===0&&(t=Hu()),e=Yl(e,t),e!==null&&(Ya(e,t),Yt(e))}function Lm(e){var t=e.memoizedState,l=0;t!==null&&(l=t.retryLane),Xf(e,l)}function Gm(e,t){var l=0;switch(e.tag){case 31:case 13:var a=e.stateNode,n=e.memoizedState;n!==null&&(l=n.retryLane);break;case 19:a=e.stateNode;break;case 22:a=e.stateNode._retryCache;break;default:throw Error(u(314))}a!==null&&a.delete(t),Xf(e,l)}function qm(e,t){return ns(e,t)}var Ui=null,wa=null,kc=!1,Hi=!1,Zc=!1,zl=0;function Yt(e){e!==wa&&e.next===null&&(wa===null?Ui=wa=e:wa=wa.next=e),Hi=!0,kc||(kc=!0,Fm())}function En(e,t){if(!Zc&&Hi){Zc=!0;do for(var l=!1,a=Ui;a!==null;){if(e!==0){var n=a.pendingLanes;if(n===0)var i=0;else{var c=a.suspendedLanes,d=a.pingedLanes;i=(1<<31-yt(42|e)+1)-1,i&=n&~(c&~d),i=i&201326741?i&201326741|1:i?i|2:0}i!==0&&(l=!0,Jf(a,i))}else i=fe,i=qn(a,a===Ce?i:0,a.cancelPendingCommit!==null||a.timeoutHandle!==-1),(i&3)===0||qa(a,i)||(l=!0,Jf(a,i));a=a.next}while(l);Zc=!1}}function Ym(){kf()}function kf(){Hi=kc=!1;var e=0;zl!==0&&Pm()&&(e=zl);for(var t=mt(),l=null,a=Ui;a!==null;){var n=a.next,i=Zf(a,t);i===0?(a.next=null,l===null?Ui=n:l.next=n,n===null&&(wa=l)):(l=a,(e!==0||(i&3)!==0)&&(Hi=!0)),a=n}Xe!==0&&Xe!==5||En(e),zl!==0&&(zl=0)}function Zf(e,t){for(var l=e.suspendedLanes,a=e.pingedLanes,n=e.expirationTimes,i=e.pendingLanes&-62914561;0<i;){var c=31-yt(i),d=1<<c,y=n[c];y===-1?((d&l)===0||(d&a)!==0)&&(n[c]=yd(d,t)):y<=t&&(e.expiredLanes|=d),i&=~d}if(t=Ce,l=fe,l=qn(e,e===t?l:0,e.cancelPendingCommit!==null||e.timeoutHandle!==-1),a=e.callbackNode,l===0||e===t&&(je===2||je===9)||e.cancelPendingCommit!==null)return a!==null&&a!==null&&is(a),e.callbackNode=null,e.callbackPriority=0;if((l&3)===0||qa(e,l)){if(t=l&-l,t===e.callbackPriority)return t;switch(a!==null&&is(a),us(l)){case 2:case 8:l=Bu;break;case 32:l=Hn;break;case 268435456:l=Uu;break;default:l=Hn}return a=Kf.bind(null,e),l=ns(l,a),e.callbackPriority=t,e.callbackNode=l,t}return a!==null&&a!==null&&is(a),e.callbackPriority=2,e.callbackNode=null,2}function Kf(e,t){if(Xe!==0&&Xe!==5)return e.callbackNode=null,e.callbackPriority=0,null;var l=e.callbackNode;if(Bi()&&e.callbackNode!==l)return null;var a=fe;return a=qn(e,e===Ce?a:0,e.cancelPendingCommit!==null||e.timeoutHandle!==-1),a===0?null:(Mf(e,a,t),Zf(e,mt()),e.callbackNode!=null&&e.callbackNode===l?Kf.bind(null,e):null)}function Jf(e,t){if(Bi())return null;Mf(e,t,!0)}function Fm(){th(function(){(ve&6)!==0?ns(wu,Ym):kf()})}function Kc(){if(zl===0){var e=va;e===0&&(e=Vn,Vn<<=1,(Vn&261888)===0&&(Vn=256)),zl=e}return zl}function Wf(e){return e==null||typeof e=="symbol"||typeof e=="boolean"?null:typeof e=="function"?e:Xn(""+e)}function $f(e,t){var l=t.ownerDocument.createElement("input");return l.name=t.name,l.value=t.value,e.id&&l.setAttribute("form",e.id),t.parentNode.insertBefore(l,t),e=new FormData(e),l.parentNode.removeChild(l),e}function Qm(e,t,l,a,n){if(t==="submit"&&l&&l.stateNode===n){var i=Wf((n[st]||null).action),c=a.submitter;c&&(t=(t=c[st]||null)?Wf(t.formAction):c.getAttribute("formAction"),t!==null&&(i=t,c=null));var d=new Jn("action","action",null,a,n);e.push({event:d,listeners:[{instance:null,listener:function(){if(a.defaultPrevented){if(zl!==0){var y=c?$f(n,c):new FormData(n);hc(l,{pending:!0,data:y,method:n.method,action:i},null,y)}}else typeof i=="function"&&(d.preventDefault(),y=c?$f(n,c):new FormData(n),hc(l,{pending:!0,data:y,method:n.method,action:i},i,y))},currentTarget:n}]})}}for(var Jc=0;Jc<Os.length;Jc++){var Wc=Os[Jc],Xm=Wc.toLowerCase(),km=Wc[0].toUpperCase()+Wc.slice(1);Bt(Xm,"on"+km)}Bt(Nr,"onAnimationEnd"),Bt(Cr,"onAnimationIteration"),Bt(zr,"onAnimationStart"),Bt("dblclick","onDoubleClick"),Bt("focusin","onFocus"),Bt("focusout","onBlur"),Bt(um,"onTransitionRun"),Bt(rm,"onTransitionStart"),Bt(om,"onTransitionCancel"),Bt(Mr,"onTransitionEnd"),ia("onMouseEnter",["mouseout","mouseover"]),ia("onMouseLeave",["mouseout","mouseover"]),ia("onPointerEnter",["pointerout","pointerover"]),ia("onPointerLeave",["pointerout","pointerover"]),Vl("onChange","change click focusin focusout input keydown keyup selectionchange".split(" ")),Vl("onSelect","focusout contextmenu dragend focusin keydown keyup mousedown mouseup selectionchange".split(" ")),Vl("onBeforeInput",["compositionend","keypress","textInput","paste"]),Vl("onCompositionEnd","compositionend focusout keydown keypress keyup mousedown".split(" ")),Vl("onCompositionStart","compositionstart focusout keydown keypress keyup mousedown".split(" ")),Vl("onCompositionUpdate","compositionupdate focusout keydown keypress keyup mousedown".split(" "));var Tn="abort canplay canplaythrough durationchange emptied encrypted ended error loadeddata loadedmetadata loadstart pause play playing progress ratechange resize seeked seeking stalled suspend timeupdate volumechange waiting".split(" "),Zm=new Set("beforetoggle cancel close invalid load scroll scrollend toggle".split(" ").concat(Tn));function If(e,t){t=(t&4)!==0;for(var l=0;l<e.length;l++){var a=e[l],n=a.event;a=a.listeners;e:{var i=void 0;if(t)for(var c=a.length-1;0<=c;c--){var d=a[c],y=d.instance,S=d.currentTarget;if(d=d.listener,y!==i&&n.isPropagationStopped())break e;i=d,n.currentTarget=S;try{i(n)}catch(N){In(N)}n.currentTarget=null,i=y}else for(c=0;c<a.length;c++){if(d=a[c],y=d.instance,S=d.currentTarget,d=d.listener,y!==i&&n.isPropagationStopped())break e;i=d,n.currentTarget=S;try{i(n)}catch(N){In(N)}n.currentTarget=null,i=y}}}}function oe(e,t){var l=t[rs];l===void 0&&(l=t[rs]=new Set);var a=e+"__bubble";l.has(a)||(Pf(t,e,2,!1),l.add(a))}function $c(e,t,l){var a=0;t&&(a|=4),Pf(l,e,a,t)}var Vi="_reactListening"+Math.random().toString(36).slice(2);function Ic(e){if(!e[Vi]){e[Vi]=!0,Qu.forEach(function(l){l!=="selectionchange"&&(Zm.has(l)||$c(l,!1,e),$c(l,!0,e))});var t=e.nodeType===9?e:e.ownerDocument;t===null||t[Vi]||(t[Vi]=!0,$c("selectionchange",!1,t))}}function Pf(e,t,l,a){switch(z0(t)){case 2:var n=jh;break;case 8:n=Sh;break;default:n=mu}l=n.bind(null,t,l,e),n=void 0,!gs||t!=="touchstart"&&t!=="touchmove"&&t!=="wheel"||(n=!0),a?n!==void 0?e.addEventListener(t,l,{capture:!0,passive:n}):e.addEventListener(t,l,!0):n!==void 0?e.addEventListener(t,l,{passive:n}):e.addEventListener(t,l,!1)}function Pc(e,t,l,a,n){var i=a;if((t&1)===0&&(t&2)===0&&a!==null)e:for(;;){if(a===null)return;var c=a.tag;if(c===3||c===4){var d=a.stateNode.containerInfo;if(d===n)break;if(c===4)for(c=a.return;c!==null;){var y=c.tag;if((y===3||y===4)&&c.stateNode.containerInfo===n)return;c=c.return}for(;d!==null;){if(c=la(d),c===null)return;if(y=c.tag,y===5||y===6||y===26||y===27){a=i=c;continue e}d=d.parentNode}}a=a.return}lr(function(){var S=i,N=xs(l),R=[];e:{var E=Rr.get(e);if(E!==void 0){var T=Jn,Y=e;switch(e){case"keypress":if(Zn(l)===0)break e;case"keydown":case"keyup":T=Gd;break;case"focusin":Y="focus",T=Ss;break;case"focusout":Y="blur",T=Ss;break;case"beforeblur":case"afterblur":T=Ss;break;case"click":if(l.button===2)break e;case"auxclick":case"dblclick":case"mousedown":case"mousemove":case"mouseup":case"mouseout":case"mouseover":case"contextmenu":T=ir;break;case"drag":case"dragend":case"dragenter":case"dragexit":case"dragleave":case"dragover":case"dragstart":case"drop":T=zd;break;case"touchcancel":case"touchend":case"touchmove":case"touchstart":T=Fd;break;case Nr:case Cr:case zr:T=Od;break;case Mr:T=Xd;break;case"scroll":case"scrollend":T=Nd;break;case"wheel":T=Zd;break;case"copy":case"cut":case"paste":T=_d;break;case"gotpointercapture":case"lostpointercapture":case"pointercancel":case"pointerdown":case"pointermove":case"pointerout":case"pointerover":case"pointerup":T=cr;break;case"toggle":case"beforetoggle":T=Jd}var $=(t&4)!==0,Ae=!$&&(e==="scroll"||e==="scrollend"),v=$?E!==null?E+"Capture":null:E;$=[];for(var p=S,j;p!==null;){var C=p;if(j=C.stateNode,C=C.tag,C!==5&&C!==26&&C!==27||j===null||v===null||(C=Xa(p,v),C!=null&&$.push(An(p,C,j))),Ae)break;p=p.return}0<$.length&&(E=new T(E,Y,null,l,N),R.push({event:E,listeners:$}))}}if((t&7)===0){e:{if(E=e==="mouseover"||e==="pointerover",T=e==="mouseout"||e==="pointerout",E&&l!==ys&&(Y=l.relatedTarget||l.fromElement)&&(la(Y)||Y[ta]))break e;if((T||E)&&(E=N.window===N?N:(E=N.ownerDocument)?E.defaultView||E.parentWindow:window,T?(Y=l.relatedTarget||l.toElement,T=S,Y=Y?la(Y):null,Y!==null&&(Ae=x(Y),$=Y.tag,Y!==Ae||$!==5&&$!==27&&$!==6)&&(Y=null)):(T=null,Y=S),T!==Y)){if($=ir,C="onMouseLeave",v="onMouseEnter",p="mouse",(e==="pointerout"||e==="pointerover")&&($=cr,C="onPointerLeave",v="onPointerEnter",p="pointer"),Ae=T==null?E:Qa(T),j=Y==null?E:Qa(Y),E=new $(C,p+"leave",T,l,N),E.target=Ae,E.relatedTarget=j,C=null,la(N)===S&&($=new $(v,p+"enter",Y,l,N),$.target=j,$.relatedTarget=Ae,C=$),Ae=C,T&&Y)t:{for($=Km,v=T,p=Y,j=0,C=v;C;C=$(C))j++;C=0;for(var J=p;J;J=$(J))C++;for(;0<j-C;)v=$(v),j--;for(;0<C-j;)p=$(p),C--;for(;j--;){if(v===p||p!==null&&v===p.alternate){$=v;break t}v=$(v),p=$(p)}$=null}else $=null;T!==null&&e0(R,E,T,$,!1),Y!==null&&Ae!==null&&e0(R,Ae,Y,$,!0)}}e:{if(E=S?Qa(S):window,T=E.nodeName&&E.nodeName.toLowerCase(),T==="select"||T==="input"&&E.type==="file")var ye=yr;else if(mr(E))if(xr)ye=im;else{ye=am;var F=lm}else T=E.nodeName,!T||T.toLowerCase()!=="input"||E.type!=="checkbox"&&E.type!=="radio"?S&&hs(S.elementType)&&(ye=yr):ye=nm;if(ye&&(ye=ye(e,S))){hr(R,ye,l,N);break e}F&&F(e,E,S),e==="focusout"&&S&&E.type==="number"&&S.memoizedProps.value!=null&&ms(E,"number",E.value)}switch(F=S?Qa(S):window,e){case"focusin":(mr(F)||F.contentEditable==="true")&&(fa=F,zs=S,Pa=null);break;case"focusout":Pa=zs=fa=null;break;case"mousedown":Ms=!0;break;case"contextmenu":case"mouseup":case"dragend":Ms=!1,Tr(R,l,N);break;case"selectionchange":if(cm)break;case"keydown":case"keyup":Tr(R,l,N)}var ie;if(Ts)e:{switch(e){case"compositionstart":var de="onCompositionStart";break e;case"compositionend":de="onCompositionEnd";break e;case"compositionupdate":de="onCompositionUpdate";break e}de=void 0}else oa?fr(e,l)&&(de="onCompositionEnd"):e==="keydown"&&l.keyCode===229&&(de="onCompositionStart");de&&(ur&&l.locale!=="ko"&&(oa||de!=="onCompositionStart"?de==="onCompositionEnd"&&oa&&(ie=ar()):(dl=N,vs="value"in dl?dl.value:dl.textContent,oa=!0)),F=Li(S,de),0<F.length&&(de=new sr(de,e,null,l,N),R.push({event:de,listeners:F}),ie?de.data=ie:(ie=dr(l),ie!==null&&(de.data=ie)))),(ie=$d?Id(e,l):Pd(e,l))&&(de=Li(S,"onBeforeInput"),0<de.length&&(F=new sr("onBeforeInput","beforeinput",null,l,N),R.push({event:F,listeners:de}),F.data=ie)),Qm(R,e,S,l,N)}If(R,t)})}function An(e,t,l){return{instance:e,listener:t,currentTarget:l}}function Li(e,t){for(var l=t+"Capture",a=[];e!==null;){var n=e,i=n.stateNode;if(n=n.tag,n!==5&&n!==26&&n!==27||i===null||(n=Xa(e,l),n!=null&&a.unshift(An(e,n,i)),n=Xa(e,t),n!=null&&a.push(An(e,n,i))),e.tag===3)return a;e=e.return}return[]}function Km(e){if(e===null)return null;do e=e.return;while(e&&e.tag!==5&&e.tag!==27);return e||null}function e0(e,t,l,a,n){for(var i=t._reactName,c=[];l!==null&&l!==a;){var d=l,y=d.alternate,S=d.stateNode;if(d=d.tag,y!==null&&y===a)break;d!==5&&d!==26&&d!==27||S===null||(y=S,n?(S=Xa(l,i),S!=null&&c.unshift(An(l,S,y))):n||(S=Xa(l,i),S!=null&&c.push(An(l,S,y)))),l=l.return}c.length!==0&&e.push({event:t,listeners:c})}var Jm=/\r\n?/g,Wm=/\u0000|\uFFFD/g;function t0(e){return(typeof e=="string"?e:""+e).replace(Jm,`
-`).replace(Wm,"")}function l0(e,t){return t=t0(t),t0(e)===t}function Te(e,t,l,a,n,i){switch(l){case"children":typeof a=="string"?t==="body"||t==="textarea"&&a===""||ca(e,a):(typeof a=="number"||typeof a=="bigint")&&t!=="body"&&ca(e,""+a);break;case"className":Fn(e,"class",a);break;case"tabIndex":Fn(e,"tabindex",a);break;case"dir":case"role":case"viewBox":case"width":case"height":Fn(e,l,a);break;case"style":er(e,a,i);break;case"data":if(t!=="object"){Fn(e,"data",a);break}case"src":case"href":if(a===""&&(t!=="a"||l!=="href")){e.removeAttribute(l);break}if(a==null||typeof a=="function"||typeof a=="symbol"||typeof a=="boolean"){e.removeAttribute(l);break}a=Xn(""+a),e.setAttribute(l,a);break;case"action":case"formAction":if(typeof a=="function"){e.setAttribute(l,"javascript:throw new Error('A React form was unexpectedly submitted. If you called form.submit() manually, consider using form.requestSubmit() instead. If you\\'re trying to use event.stopPropagation() in a submit event handler, consider also calling event.preventDefault().')");break}else typeof i=="function"&&(l==="formAction"?(t!=="input"&&Te(e,t,"name",n.name,n,null),Te(e,t,"formEncType",n.formEncType,n,null),Te(e,t,"formMethod",n.formMethod,n,null),Te(e,t,"formTarget",n.formTarget,n,null)):(Te(e,t,"encType",n.encType,n,null),Te(e,t,"method",n.method,n,null),Te(e,t,"target",n.target,n,null)));if(a==null||typeof a=="symbol"||typeof a=="boolean"){e.removeAttribute(l);break}a=Xn(""+a),e.setAttribute(l,a);break;case"onClick":a!=null&&(e.onclick=Xt);break;case"onScroll":a!=null&&oe("scroll",e);break;case"onScrollEnd":a!=null&&oe("scrollend",e);break;case"dangerouslySetInnerHTML":if(a!=null){if(typeof a!="object"||!("__html"in a))throw Error(u(61));if(l=a.__html,l!=null){if(n.children!=null)throw Error(u(60));e.innerHTML=l}}break;case"multiple":e.multiple=a&&typeof a!="function"&&typeof a!="symbol";break;case"muted":e.muted=a&&typeof a!="function"&&typeof a!="symbol";break;case"suppressContentEditableWarning":case"suppressHydrationWarning":case"defaultValue":case"defaultChecked":case"innerHTML":case"ref":break;case"autoFocus":break;case"xlinkHref":if(a==null||typeof a=="function"||typeof a=="boolean"||typeof a=="symbol"){e.removeAttribute("xlink:href");break}l=Xn(""+a),e.setAttributeNS("http://www.w3.org/1999/xlink","xlink:href",l);break;case"contentEditable":case"spellCheck":case"draggable":case"value":case"autoReverse":case"externalResourcesRequired":case"focusable":case"preserveAlpha":a!=null&&typeof a!="function"&&typeof a!="symbol"?e.setAttribute(l,""+a):e.removeAttribute(l);break;case"inert":case"allowFullScreen":case"async":case"autoPlay":case"controls":case"default":case"defer":case"disabled":case"disablePictureInPicture":case"disableRemotePlayback":case"formNoValidate":case"hidden":case"loop":case"noModule":case"noValidate":case"open":case"playsInline":case"readOnly":case"required":case"reversed":case"scoped":case"seamless":case"itemScope":a&&typeof a!="function"&&typeof a!="symbol"?e.setAttribute(l,""):e.removeAttribute(l);break;case"capture":case"download":a===!0?e.setAttribute(l,""):a!==!1&&a!=null&&typeof a!="function"&&typeof a!="symbol"?e.setAttribute(l,a):e.removeAttribute(l);break;case"cols":case"rows":case"size":case"span":a!=null&&typeof a!="function"&&typeof a!="symbol"&&!isNaN(a)&&1<=a?e.setAttribute(l,a):e.removeAttribute(l);break;case"rowSpan":case"start":a==null||typeof a=="function"||typeof a=="symbol"||isNaN(a)?e.removeAttribute(l):e.setAttribute(l,a);break;case"popover":oe("beforetoggle",e),oe("toggle",e),Yn(e,"popover",a);break;case"xlinkActuate":Qt(e,"http://www.w3.org/1999/xlink","xlink:actuate",a);break;case"xlinkArcrole":Qt(e,"http://www.w3.org/1999/xlink","xlink:arcrole",a);break;case"xlinkRole":Qt(e,"http://www.w3.org/1999/xlink","xlink:role",a);break;case"xlinkShow":Qt(e,"http://www.w3.org/1999/xlink","xlink:show",a);break;case"xlinkTitle":Qt(e,"http://www.w3.org/1999/xlink","xlink:title",a);break;case"xlinkType":Qt(e,"http://www.w3.org/1999/xlink","xlink:type",a);break;case"xmlBase":Qt(e,"http://www.w3.org/XML/1998/namespace","xml:base",a);break;case"xmlLang":Qt(e,"http://www.w3.org/XML/1998/namespace","xml:lang",a);break;case"xmlSpace":Qt(e,"http://www.w3.org/XML/1998/namespace","xml:space",a);break;case"is":Yn(e,"is",a);break;case"innerText":case"textContent":break;default:(!(2<l.length)||l[0]!=="o"&&l[0]!=="O"||l[1]!=="n"&&l[1]!=="N")&&(l=Td.get(l)||l,Yn(e,l,a))}}function eu(e,t,l,a,n,i){switch(l){case"style":er(e,a,i);break;case"dangerouslySetInnerHTML":if(a!=null){if(typeof a!="object"||!("__html"in a))throw Error(u(61));if(l=a.__html,l!=null){if(n.children!=null)throw Error(u(60));e.innerHTML=l}}break;case"children":typeof a=="string"?ca(e,a):(typeof a=="number"||typeof a=="bigint")&&ca(e,""+a);break;case"onScroll":a!=null&&oe("scroll",e);break;case"onScrollEnd":a!=null&&oe("scrollend",e);break;case"onClick":a!=null&&(e.onclick=Xt);break;case"suppressContentEditableWarning":case"suppressHydrationWarning":case"innerHTML":case"ref":break;case"innerText":case"textContent":break;default:if(!Xu.hasOwnProperty(l))e:{if(l[0]==="o"&&l[1]==="n"&&(n=l.endsWith("Capture"),t=l.slice(2,n?l.length-7:void 0),i=e[st]||null,i=i!=null?i[l]:null,typeof i=="function"&&e.removeEventListener(t,i,n),typeof a=="function")){typeof i!="function"&&i!==null&&(l in e?e[l]=null:e.hasAttribute(l)&&e.removeAttribute(l)),e.addEventListener(t,a,n);break e}l in e?e[l]=a:a===!0?e.setAttribute(l,""):Yn(e,l,a)}}}function et(e,t,l){switch(t){case"div":case"span":case"svg":case"path":case"a":case"g":case"p":case"li":break;case"img":oe("error",e),oe("load",e);var a=!1,n=!1,i;for(i in l)if(l.hasOwnProperty(i)){var c=l[i];if(c!=null)switch(i){case"src":a=!0;break;case"srcSet":n=!0;break;case"children":case"dangerouslySetInnerHTML":throw Error(u(137,t));default:Te(e,t,i,c,l,null)}}n&&Te(e,t,"srcSet",l.srcSet,l,null),a&&Te(e,t,"src",l.src,l,null);return;case"input":oe("invalid",e);var d=i=c=n=null,y=null,S=null;for(a in l)if(l.hasOwnProperty(a)){var N=l[a];if(N!=null)switch(a){case"name":n=N;break;case"type":c=N;break;case"checked":y=N;break;case"defaultChecked":S=N;break;case"value":i=N;break;case"defaultValue":d=N;break;case"children":case"dangerouslySetInnerHTML":if(N!=null)throw Error(u(137,t));break;default:Te(e,t,a,N,l,null)}}Wu(e,i,d,y,S,c,n,!1);return;case"select":oe("invalid",e),a=c=i=null;for(n in l)if(l.hasOwnProperty(n)&&(d=l[n],d!=null))switch(n){case"value":i=d;break;case"defaultValue":c=d;break;case"multiple":a=d;default:Te(e,t,n,d,l,null)}t=i,l=c,e.multiple=!!a,t!=null?sa(e,!!a,t,!1):l!=null&&sa(e,!!a,l,!0);return;case"textarea":oe("invalid",e),i=n=a=null;for(c in l)if(l.hasOwnProperty(c)&&(d=l[c],d!=null))switch(c){case"value":a=d;break;case"defaultValue":n=d;break;case"children":i=d;break;case"dangerouslySetInnerHTML":if(d!=null)throw Error(u(91));break;default:Te(e,t,c,d,l,null)}Iu(e,a,n,i);return;case"option":for(y in l)if(l.hasOwnProperty(y)&&(a=l[y],a!=null))switch(y){case"selected":e.selected=a&&typeof a!="function"&&typeof a!="symbol";break;default:Te(e,t,y,a,l,null)}return;case"dialog":oe("beforetoggle",e),oe("toggle",e),oe("cancel",e),oe("close",e);break;case"iframe":case"object":oe("load",e);break;case"video":case"audio":for(a=0;a<Tn.length;a++)oe(Tn[a],e);break;case"image":oe("error",e),oe("load",e);break;case"details":oe("toggle",e);break;case"embed":case"source":case"link":oe("error",e),oe("load",e);case"area":case"base":case"br":case"col":case"hr":case"keygen":case"meta":case"param":case"track":case"wbr":case"menuitem":for(S in l)if(l.hasOwnProperty(S)&&(a=l[S],a!=null))switch(S){case"children":case"dangerouslySetInnerHTML":throw Error(u(137,t));default:Te(e,t,S,a,l,null)}return;default:if(hs(t)){for(N in l)l.hasOwnProperty(N)&&(a=l[N],a!==void 0&&eu(e,t,N,a,l,void 0));return}}for(d in l)l.hasOwnProperty(d)&&(a=l[d],a!=null&&Te(e,t,d,a,l,null))}function $m(e,t,l,a){switch(t){case"div":case"span":case"svg":case"path":case"a":case"g":case"p":case"li":break;case"input":var n=null,i=null,c=null,d=null,y=null,S=null,N=null;for(T in l){var R=l[T];if(l.hasOwnProperty(T)&&R!=null)switch(T){case"checked":break;case"value":break;case"defaultValue":y=R;default:a.hasOwnProperty(T)||Te(e,t,T,null,a,R)}}for(var E in a){var T=a[E];if(R=l[E],a.hasOwnProperty(E)&&(T!=null||R!=null))switch(E){case"type":i=T;break;case"name":n=T;break;case"checked":S=T;break;case"defaultChecked":N=T;break;case"value":c=T;break;case"defaultValue":d=T;break;case"children":case"dangerouslySetInnerHTML":if(T!=null)throw Error(u(137,t));break;default:T!==R&&Te(e,t,E,T,a,R)}}ds(e,c,d,y,S,N,i,n);return;case"select":T=c=d=E=null;for(i in l)if(y=l[i],l.hasOwnProperty(i)&&y!=null)switch(i){case"value":break;case"multiple":T=y;default:a.hasOwnProperty(i)||Te(e,t,i,null,a,y)}for(n in a)if(i=a[n],y=l[n],a.hasOwnProperty(n)&&(i!=null||y!=null))switch(n){case"value":E=i;break;case"defaultValue":d=i;break;case"multiple":c=i;default:i!==y&&Te(e,t,n,i,a,y)}t=d,l=c,a=T,E!=null?sa(e,!!l,E,!1):!!a!=!!l&&(t!=null?sa(e,!!l,t,!0):sa(e,!!l,l?[]:"",!1));return;case"textarea":T=E=null;for(d in l)if(n=l[d],l.hasOwnProperty(d)&&n!=null&&!a.hasOwnProperty(d))switch(d){case"value":break;case"children":break;default:Te(e,t,d,null,a,n)}for(c in a)if(n=a[c],i=l[c],a.hasOwnProperty(c)&&(n!=null||i!=null))switch(c){case"value":E=n;break;case"defaultValue":T=n;break;case"children":break;case"dangerouslySetInnerHTML":if(n!=null)throw Error(u(91));break;default:n!==i&&Te(e,t,c,n,a,i)}$u(e,E,T);return;case"option":for(var Y in l)if(E=l[Y],l.hasOwnProperty(Y)&&E!=null&&!a.hasOwnProperty(Y))switch(Y){case"selected":e.selected=!1;break;default:Te(e,t,Y,null,a,E)}for(y in a)if(E=a[y],T=l[y],a.hasOwnProperty(y)&&E!==T&&(E!=null||T!=null))switch(y){case"selected":e.selected=E&&typeof E!="function"&&typeof E!="symbol";break;default:Te(e,t,y,E,a,T)}return;case"img":case"link":case"area":case"base":case"br":case"col":case"embed":case"hr":case"keygen":case"meta":case"param":case"source":case"track":case"wbr":case"menuitem":for(var $ in l)E=l[$],l.hasOwnProperty($)&&E!=null&&!a.hasOwnProperty($)&&Te(e,t,$,null,a,E);for(S in a)if(E=a[S],T=l[S],a.hasOwnProperty(S)&&E!==T&&(E!=null||T!=null))switch(S){case"children":case"dangerouslySetInnerHTML":if(E!=null)throw Error(u(137,t));break;default:Te(e,t,S,E,a,T)}return;default:if(hs(t)){for(var Ae in l)E=l[Ae],l.hasOwnProperty(Ae)&&E!==void 0&&!a.hasOwnProperty(Ae)&&eu(e,t,Ae,void 0,a,E);for(N in a)E=a[N],T=l[N],!a.hasOwnProperty(N)||E===T||E===void 0&&T===void 0||eu(e,t,N,E,a,T);return}}for(var v in l)E=l[v],l.hasOwnProperty(v)&&E!=null&&!a.hasOwnProperty(v)&&Te(e,t,v,null,a,E);for(R in a)E=a[R],T=l[R],!a.hasOwnProperty(R)||E===T||E==null&&T==null||Te(e,t,R,E,a,T)}function a0(e){switch(e){case"css":case"script":case"font":case"img":case"image":case"input":case"link":return!0;default:return!1}}function Im(){if(typeof performance.getEntriesByType=="function"){for(var e=0,t=0,l=performance.getEntriesByType("resource"),a=0;a<l.length;a++){var n=l[a],i=n.transferSize,c=n.initiatorType,d=n.duration;if(i&&d&&a0(c)){for(c=0,d=n.responseEnd,a+=1;a<l.length;a++){var y=l[a],S=y.startTime;if(S>d)break;var N=y.transferSize,R=y.initiatorType;N&&a0(R)&&(y=y.responseEnd,c+=N*(y<d?1:(d-S)/(y-S)))}if(--a,t+=8*(i+c)/(n.duration/1e3),e++,10<e)break}}if(0<e)return t/e/1e6}return navigator.connection&&(e=navigator.connection.downlink,typeof e=="number")?e:5}var tu=null,lu=null;function Gi(e){return e.nodeType===9?e:e.ownerDocument}function n0(e){switch(e){case"http://www.w3.org/2000/svg":return 1;case"http://www.w3.org/1998/Math/MathML":return 2;default:return 0}}function i0(e,t){if(e===0)switch(t){case"svg":return 1;case"math":return 2;default:return 0}return e===1&&t==="foreignObject"?0:e}function au(e,t){return e==="textarea"||e==="noscript"||typeof t.children=="string"||typeof t.children=="number"||typeof t.children=="bigint"||typeof t.dangerouslySetInnerHTML=="object"&&t.dangerouslySetInnerHTML!==null&&t.dangerouslySetInnerHTML.__html!=null}var nu=null;function Pm(){var e=window.event;return e&&e.type==="popstate"?e===nu?!1:(nu=e,!0):(nu=null,!1)}var s0=typeof setTimeout=="function"?setTimeout:void 0,eh=typeof clearTimeout=="function"?clearTimeout:void 0,c0=typeof Promise=="function"?Promise:void 0,th=typeof queueMicrotask=="function"?queueMicrotask:typeof c0<"u"?function(e){return c0.resolve(null).then(e).catch(lh)}:s0;function lh(e){setTimeout(function(){throw e})}function Ml(e){return e==="head"}function u0(e,t){var l=t,a=0;do{var n=l.nextSibling;if(e.removeChild(l),n&&n.nodeType===8)if(l=n.data,l==="/$"||l==="/&"){if(a===0){e.removeChild(n),Va(t);return}a--}else if(l==="$"||l==="$?"||l==="$~"||l==="$!"||l==="&")a++;else if(l==="html")Nn(e.ownerDocument.documentElement);else if(l==="head"){l=e.ownerDocument.head,Nn(l);for(var i=l.firstChild;i;){var c=i.nextSibling,d=i.nodeName;i[Fa]||d==="SCRIPT"||d==="STYLE"||d==="LINK"&&i.rel.toLowerCase()==="stylesheet"||l.removeChild(i),i=c}}else l==="body"&&Nn(e.ownerDocument.body);l=n}while(l);Va(t)}function r0(e,t){var l=e;e=0;do{var a=l.nextSibling;if(l.nodeType===1?t?(l._stashedDisplay=l.style.display,l.style.display="none"):(l.style.display=l._stashedDisplay||"",l.getAttribute("style")===""&&l.removeAttribute("style")):l.nodeType===3&&(t?(l._stashedText=l.nodeValue,l.nodeValue=""):l.nodeValue=l._stashedText||""),a&&a.nodeType===8)if(l=a.data,l==="/$"){if(e===0)break;e--}else l!=="$"&&l!=="$?"&&l!=="$~"&&l!=="$!"||e++;l=a}while(l)}function iu(e){var t=e.firstChild;for(t&&t.nodeType===10&&(t=t.nextSibling);t;){var l=t;switch(t=t.nextSibling,l.nodeName){case"HTML":case"HEAD":case"BODY":iu(l),os(l);continue;case"SCRIPT":case"STYLE":continue;case"LINK":if(l.rel.toLowerCase()==="stylesheet")continue}e.removeChild(l)}}function ah(e,t,l,a){for(;e.nodeType===1;){var n=l;if(e.nodeName.toLowerCase()!==t.toLowerCase()){if(!a&&(e.nodeName!=="INPUT"||e.type!=="hidden"))break}else if(a){if(!e[Fa])switch(t){case"meta":if(!e.hasAttribute("itemprop"))break;return e;case"link":if(i=e.getAttribute("rel"),i==="stylesheet"&&e.hasAttribute("data-precedence"))break;if(i!==n.rel||e.getAttribute("href")!==(n.href==null||n.href===""?null:n.href)||e.getAttribute("crossorigin")!==(n.crossOrigin==null?null:n.crossOrigin)||e.getAttribute("title")!==(n.title==null?null:n.title))break;return e;case"style":if(e.hasAttribute("data-precedence"))break;return e;case"script":if(i=e.getAttribute("src"),(i!==(n.src==null?null:n.src)||e.getAttribute("type")!==(n.type==null?null:n.type)||e.getAttribute("crossorigin")!==(n.crossOrigin==null?null:n.crossOrigin))&&i&&e.hasAttribute("async")&&!e.hasAttribute("itemprop"))break;return e;default:return e}}else if(t==="input"&&e.type==="hidden"){var i=n.name==null?null:""+n.name;if(n.type==="hidden"&&e.getAttribute("name")===i)return e}else return e;if(e=_t(e.nextSibling),e===null)break}return null}function nh(e,t,l){if(t==="")return null;for(;e.nodeType!==3;)if((e.nodeType!==1||e.nodeName!=="INPUT"||e.type!=="hidden")&&!l||(e=_t(e.nextSibling),e===null))return null;return e}function o0(e,t){for(;e.nodeType!==8;)if((e.nodeType!==1||e.nodeName!=="INPUT"||e.type!=="hidden")&&!t||(e=_t(e.nextSibling),e===null))return null;return e}function su(e){return e.data==="$?"||e.data==="$~"}function cu(e){return e.data==="$!"||e.data==="$?"&&e.ownerDocument.readyState!=="loading"}function ih(e,t){var l=e.ownerDocument;if(e.data==="$~")e._reactRetry=t;else if(e.data!=="$?"||l.readyState!=="loading")t();else{var a=function(){t(),l.removeEventListener("DOMContentLoaded",a)};l.addEventListener("DOMContentLoaded",a),e._reactRetry=a}}function _t(e){for(;e!=null;e=e.nextSibling){var t=e.nodeType;if(t===1||t===3)break;if(t===8){if(t=e.data,t==="$"||t==="$!"||t==="$?"||t==="$~"||t==="&"||t==="F!"||t==="F")break;if(t==="/$"||t==="/&")return null}}return e}var uu=null;function f0(e){e=e.nextSibling;for(var t=0;e;){if(e.nodeType===8){var l=e.data;if(l==="/$"||l==="/&"){if(t===0)return _t(e.nextSibling);t--}else l!=="$"&&l!=="$!"&&l!=="$?"&&l!=="$~"&&l!=="&"||t++}e=e.nextSibling}return null}function d0(e){e=e.previousSibling;for(var t=0;e;){if(e.nodeType===8){var l=e.data;if(l==="$"||l==="$!"||l==="$?"||l==="$~"||l==="&"){if(t===0)return e;t--}else l!=="/$"&&l!=="/&"||t++}e=e.previousSibling}return null}function m0(e,t,l){switch(t=Gi(l),e){case"html":if(e=t.documentElement,!e)throw Error(u(452));return e;case"head":if(e=t.head,!e)throw Error(u(453));return e;case"body":if(e=t.body,!e)throw Error(u(454));return e;default:throw Error(u(451))}}function Nn(e){for(var t=e.attributes;t.length;)e.removeAttributeNode(t[0]);os(e)}var wt=new Map,h0=new Set;function qi(e){return typeof e.getRootNode=="function"?e.getRootNode():e.nodeType===9?e:e.ownerDocument}var cl=H.d;H.d={f:sh,r:ch,D:uh,C:rh,L:oh,m:fh,X:mh,S:dh,M:hh};function sh(){var e=cl.f(),t=Di();return e||t}function ch(e){var t=aa(e);t!==null&&t.tag===5&&t.type==="form"?Ro(t):cl.r(e)}var Ba=typeof document>"u"?null:document;function y0(e,t,l){var a=Ba;if(a&&typeof t=="string"&&t){var n=Nt(t);n='link[rel="'+e+'"][href="'+n+'"]',typeof l=="string"&&(n+='[crossorigin="'+l+'"]'),h0.has(n)||(h0.add(n),e={rel:e,crossOrigin:l,href:t},a.querySelector(n)===null&&(t=a.createElement("link"),et(t,"link",e),Ze(t),a.head.appendChild(t)))}}function uh(e){cl.D(e),y0("dns-prefetch",e,null)}function rh(e,t){cl.C(e,t),y0("preconnect",e,t)}function oh(e,t,l){cl.L(e,t,l);var a=Ba;if(a&&e&&t){var n='link[rel="preload"][as="'+Nt(t)+'"]';t==="image"&&l&&l.imageSrcSet?(n+='[imagesrcset="'+Nt(l.imageSrcSet)+'"]',typeof l.imageSizes=="string"&&(n+='[imagesizes="'+Nt(l.imageSizes)+'"]')):n+='[href="'+Nt(e)+'"]';var i=n;switch(t){case"style":i=Ua(e);break;case"script":i=Ha(e)}wt.has(i)||(e=L({rel:"preload",href:t==="image"&&l&&l.imageSrcSet?void 0:e,as:t},l),wt.set(i,e),a.querySelector(n)!==null||t==="style"&&a.querySelector(Cn(i))||t==="script"&&a.querySelector(zn(i))||(t=a.createElement("link"),et(t,"link",e),Ze(t),a.head.appendChild(t)))}}function fh(e,t){cl.m(e,t);var l=Ba;if(l&&e){var a=t&&typeof t.as=="string"?t.as:"script",n='link[rel="modulepreload"][as="'+Nt(a)+'"][href="'+Nt(e)+'"]',i=n;switch(a){case"audioworklet":case"paintworklet":case"serviceworker":case"sharedworker":case"worker":case"script":i=Ha(e)}if(!wt.has(i)&&(e=L({rel:"modulepreload",href:e},t),wt.set(i,e),l.querySelector(n)===null)){switch(a){case"audioworklet":case"paintworklet":case"serviceworker":case"sharedworker":case"worker":case"script":if(l.querySelector(zn(i)))return}a=l.createElement("link"),et(a,"link",e),Ze(a),l.head.appendChild(a)}}}function dh(e,t,l){cl.S(e,t,l);var a=Ba;if(a&&e){var n=na(a).hoistableStyles,i=Ua(e);t=t||"default";var c=n.get(i);if(!c){var d={loading:0,preload:null};if(c=a.querySelector(Cn(i)))d.loading=5;else{e=L({rel:"stylesheet",href:e,"data-precedence":t},l),(l=wt.get(i))&&ru(e,l);var y=c=a.createElement("link");Ze(y),et(y,"link",e),y._p=new Promise(function(S,N){y.onload=S,y.onerror=N}),y.addEventListener("load",function(){d.loading|=1}),y.addEventListener("error",function(){d.loading|=2}),d.loading|=4,Yi(c,t,a)}c={type:"stylesheet",instance:c,count:1,state:d},n.set(i,c)}}}function mh(e,t){cl.X(e,t);var l=Ba;if(l&&e){var a=na(l).hoistableScripts,n=Ha(e),i=a.get(n);i||(i=l.querySelector(zn(n)),i||(e=L({src:e,async:!0},t),(t=wt.get(n))&&ou(e,t),i=l.createElement("script"),Ze(i),et(i,"link",e),l.head.appendChild(i)),i={type:"script",instance:i,count:1,state:null},a.set(n,i))}}function hh(e,t){cl.M(e,t);var l=Ba;if(l&&e){var a=na(l).hoistableScripts,n=Ha(e),i=a.get(n);i||(i=l.querySelector(zn(n)),i||(e=L({src:e,async:!0,type:"module"},t),(t=wt.get(n))&&ou(e,t),i=l.createElement("script"),Ze(i),et(i,"link",e),l.head.appendChild(i)),i={type:"script",instance:i,count:1,state:null},a.set(n,i))}}function x0(e,t,l,a){var n=(n=_.current)?qi(n):null;if(!n)throw Error(u(446));switch(e){case"meta":case"title":return null;case"style":return typeof l.precedence=="string"&&typeof l.href=="string"?(t=Ua(l.href),l=na(n).hoistableStyles,a=l.get(t),a||(a={type:"style",instance:null,count:0,state:null},l.set(t,a)),a):{type:"void",instance:null,count:0,state:null};case"link":if(l.rel==="stylesheet"&&typeof l.href=="string"&&typeof l.precedence=="string"){e=Ua(l.href);var i=na(n).hoistableStyles,c=i.get(e);if(c||(n=n.ownerDocument||n,c={type:"stylesheet",instance:null,count:0,state:{loading:0,preload:null}},i.set(e,c),(i=n.querySelector(Cn(e)))&&!i._p&&(c.instance=i,c.state.loading=5),wt.has(e)||(l={rel:"preload",as:"style",href:l.href,crossOrigin:l.crossOrigin,integrity:l.integrity,media:l.media,hrefLang:l.hrefLang,referrerPolicy:l.referrerPolicy},wt.set(e,l),i||yh(n,e,l,c.state))),t&&a===null)throw Error(u(528,""));return c}if(t&&a!==null)throw Error(u(529,""));return null;case"script":return t=l.async,l=l.src,typeof l=="string"&&t&&typeof t!="function"&&typeof t!="symbol"?(t=Ha(l),l=na(n).hoistableScripts,a=l.get(t),a||(a={type:"script",instance:null,count:0,state:null},l.set(t,a)),a):{type:"void",instance:null,count:0,state:null};default:throw Error(u(444,e))}}function Ua(e){return'href="'+Nt(e)+'"'}function Cn(e){return'link[rel="stylesheet"]['+e+"]"}function p0(e){return L({},e,{"data-precedence":e.precedence,precedence:null})}function yh(e,t,l,a){e.querySelector('link[rel="preload"][as="style"]['+t+"]")?a.loading=1:(t=e.createElement("link"),a.preload=t,t.addEventListener("load",function(){return a.loading|=1}),t.addEventListener("error",function(){return a.loading|=2}),et(t,"link",l),Ze(t),e.head.appendChild(t))}function Ha(e){return'[src="'+Nt(e)+'"]'}function zn(e){return"script[async]"+e}function g0(e,t,l){if(t.count++,t.instance===null)switch(t.type){case"style":var a=e.querySelector('style[data-href~="'+Nt(l.href)+'"]');if(a)return t.instance=a,Ze(a),a;var n=L({},l,{"data-href":l.href,"data-precedence":l.precedence,href:null,precedence:null});return a=(e.ownerDocument||e).createElement("style"),Ze(a),et(a,"style",n),Yi(a,l.precedence,e),t.instance=a;case"stylesheet":n=Ua(l.href);var i=e.querySelector(Cn(n));if(i)return t.state.loading|=4,t.instance=i,Ze(i),i;a=p0(l),(n=wt.get(n))&&ru(a,n),i=(e.ownerDocument||e).createElement("link"),Ze(i);var c=i;return c._p=new Promise(function(d,y){c.onload=d,c.onerror=y}),et(i,"link",a),t.state.loading|=4,Yi(i,l.precedence,e),t.instance=i;case"script":return i=Ha(l.src),(n=e.querySelector(zn(i)))?(t.instance=n,Ze(n),n):(a=l,(n=wt.get(i))&&(a=L({},l),ou(a,n)),e=e.ownerDocument||e,n=e.createElement("script"),Ze(n),et(n,"link",a),e.head.appendChild(n),t.instance=n);case"void":return null;default:throw Error(u(443,t.type))}else t.type==="stylesheet"&&(t.state.loading&4)===0&&(a=t.instance,t.state.loading|=4,Yi(a,l.precedence,e));return t.instance}function Yi(e,t,l){for(var a=l.querySelectorAll('link[rel="stylesheet"][data-precedence],style[data-precedence]'),n=a.length?a[a.length-1]:null,i=n,c=0;c<a.length;c++){var d=a[c];if(d.dataset.precedence===t)i=d;else if(i!==n)break}i?i.parentNode.insertBefore(e,i.nextSibling):(t=l.nodeType===9?l.head:l,t.insertBefore(e,t.firstChild))}function ru(e,t){e.crossOrigin==null&&(e.crossOrigin=t.crossOrigin),e.referrerPolicy==null&&(e.referrerPolicy=t.referrerPolicy),e.title==null&&(e.title=t.title)}function ou(e,t){e.crossOrigin==null&&(e.crossOrigin=t.crossOrigin),e.referrerPolicy==null&&(e.referrerPolicy=t.referrerPolicy),e.integrity==null&&(e.integrity=t.integrity)}var Fi=null;function v0(e,t,l){if(Fi===null){var a=new Map,n=Fi=new Map;n.set(l,a)}else n=Fi,a=n.get(l),a||(a=new Map,n.set(l,a));if(a.has(e))return a;for(a.set(e,null),l=l.getElementsByTagName(e),n=0;n<l.length;n++){var i=l[n];if(!(i[Fa]||i[We]||e==="link"&&i.getAttribute("rel")==="stylesheet")&&i.namespaceURI!=="http://www.w3.org/2000/svg"){var c=i.getAttribute(t)||"";c=e+c;var d=a.get(c);d?d.push(i):a.set(c,[i])}}return a}function b0(e,t,l){e=e.ownerDocument||e,e.head.insertBefore(l,t==="title"?e.querySelector("head > title"):null)}function xh(e,t,l){if(l===1||t.itemProp!=null)return!1;switch(e){case"meta":case"title":return!0;case"style":if(typeof t.precedence!="string"||typeof t.href!="string"||t.href==="")break;return!0;case"link":if(typeof t.rel!="string"||typeof t.href!="string"||t.href===""||t.onLoad||t.onError)break;switch(t.rel){case"stylesheet":return e=t.disabled,typeof t.precedence=="string"&&e==null;default:return!0}case"script":if(t.async&&typeof t.async!="function"&&typeof t.async!="symbol"&&!t.onLoad&&!t.onError&&t.src&&typeof t.src=="string")return!0}return!1}function j0(e){return!(e.type==="stylesheet"&&(e.state.loading&3)===0)}function ph(e,t,l,a){if(l.type==="stylesheet"&&(typeof a.media!="string"||matchMedia(a.media).matches!==!1)&&(l.state.loading&4)===0){if(l.instance===null){var n=Ua(a.href),i=t.querySelector(Cn(n));if(i){t=i._p,t!==null&&typeof t=="object"&&typeof t.then=="function"&&(e.count++,e=Qi.bind(e),t.then(e,e)),l.state.loading|=4,l.instance=i,Ze(i);return}i=t.ownerDocument||t,a=p0(a),(n=wt.get(n))&&ru(a,n),i=i.createElement("link"),Ze(i);var c=i;c._p=new Promise(function(d,y){c.onload=d,c.onerror=y}),et(i,"link",a),l.instance=i}e.stylesheets===null&&(e.stylesheets=new Map),e.stylesheets.set(l,t),(t=l.state.preload)&&(l.state.loading&3)===0&&(e.count++,l=Qi.bind(e),t.addEventListener("load",l),t.addEventListener("error",l))}}var fu=0;function gh(e,t){return e.stylesheets&&e.count===0&&ki(e,e.stylesheets),0<e.count||0<e.imgCount?function(l){var a=setTimeout(function(){if(e.stylesheets&&ki(e,e.stylesheets),e.unsuspend){var i=e.unsuspend;e.unsuspend=null,i()}},6e4+t);0<e.imgBytes&&fu===0&&(fu=62500*Im());var n=setTimeout(function(){if(e.waitingForImages=!1,e.count===0&&(e.stylesheets&&ki(e,e.stylesheets),e.unsuspend)){var i=e.unsuspend;e.unsuspend=null,i()}},(e.imgBytes>fu?50:800)+t);return e.unsuspend=l,function(){e.unsuspend=null,clearTimeout(a),clearTimeout(n)}}:null}function Qi(){if(this.count--,this.count===0&&(this.imgCount===0||!this.waitingForImages)){if(this.stylesheets)ki(this,this.stylesheets);else if(this.unsuspend){var e=this.unsuspend;this.unsuspend=null,e()}}}var Xi=null;function ki(e,t){e.stylesheets=null,e.unsuspend!==null&&(e.count++,Xi=new Map,t.forEach(vh,e),Xi=null,Qi.call(e))}function vh(e,t){if(!(t.state.loading&4)){var l=Xi.get(e);if(l)var a=l.get(null);else{l=new Map,Xi.set(e,l);for(var n=e.querySelectorAll("link[data-precedence],style[data-precedence]"),i=0;i<n.length;i++){var c=n[i];(c.nodeName==="LINK"||c.getAttribute("media")!=="not all")&&(l.set(c.dataset.precedence,c),a=c)}a&&l.set(null,a)}n=t.instance,c=n.getAttribute("data-precedence"),i=l.get(c)||a,i===a&&l.set(null,n),l.set(c,n),this.count++,a=Qi.bind(this),n.addEventListener("load",a),n.addEventListener("error",a),i?i.parentNode.insertBefore(n,i.nextSibling):(e=e.nodeType===9?e.head:e,e.insertBefore(n,e.firstChild)),t.state.loading|=4}}var Mn={$$typeof:G,Provider:null,Consumer:null,_currentValue:Z,_currentValue2:Z,_threadCount:0};function bh(e,t,l,a,n,i,c,d,y){this.tag=1,this.containerInfo=e,this.pingCache=this.current=this.pendingChildren=null,this.timeoutHandle=-1,this.callbackNode=this.next=this.pendingContext=this.context=this.cancelPendingCommit=null,this.callbackPriority=0,this.expirationTimes=ss(-1),this.entangledLanes=this.shellSuspendCounter=this.errorRecoveryDisabledLanes=this.expiredLanes=this.warmLanes=this.pingedLanes=this.suspendedLanes=this.pendingLanes=0,this.entanglements=ss(0),this.hiddenUpdates=ss(null),this.identifierPrefix=a,this.onUncaughtError=n,this.onCaughtError=i,this.onRecoverableError=c,this.pooledCache=null,this.pooledCacheLanes=0,this.formState=y,this.incompleteTransitions=new Map}function S0(e,t,l,a,n,i,c,d,y,S,N,R){return e=new bh(e,t,l,c,y,S,N,R,d),t=1,i===!0&&(t|=24),i=pt(3,null,null,t),e.current=i,i.stateNode=e,t=Qs(),t.refCount++,e.pooledCache=t,t.refCount++,i.memoizedState={element:a,isDehydrated:l,cache:t},Ks(i),e}function E0(e){return e?(e=ha,e):ha}function T0(e,t,l,a,n,i){n=E0(n),a.context===null?a.context=n:a.pendingContext=n,a=gl(t),a.payload={element:l},i=i===void 0?null:i,i!==null&&(a.callback=i),l=vl(e,a,t),l!==null&&(dt(l,e,t),cn(l,e,t))}function A0(e,t){if(e=e.memoizedState,e!==null&&e.dehydrated!==null){var l=e.retryLane;e.retryLane=l!==0&&l<t?l:t}}function du(e,t){A0(e,t),(e=e.alternate)&&A0(e,t)}function N0(e){if(e.tag===13||e.tag===31){var t=Yl(e,67108864);t!==null&&dt(t,e,67108864),du(e,67108864)}}function C0(e){if(e.tag===13||e.tag===31){var t=St();t=cs(t);var l=Yl(e,t);l!==null&&dt(l,e,t),du(e,t)}}var Zi=!0;function jh(e,t,l,a){var n=A.T;A.T=null;var i=H.p;try{H.p=2,mu(e,t,l,a)}finally{H.p=i,A.T=n}}function Sh(e,t,l,a){var n=A.T;A.T=null;var i=H.p;try{H.p=8,mu(e,t,l,a)}finally{H.p=i,A.T=n}}function mu(e,t,l,a){if(Zi){var n=hu(a);if(n===null)Pc(e,t,a,Ki,l),M0(e,a);else if(Th(n,e,t,l,a))a.stopPropagation();else if(M0(e,a),t&4&&-1<Eh.indexOf(e)){for(;n!==null;){var i=aa(n);if(i!==null)switch(i.tag){case 3:if(i=i.stateNode,i.current.memoizedState.isDehydrated){var c=Hl(i.pendingLanes);if(c!==0){var d=i;for(d.pendingLanes|=2,d.entangledLanes|=2;c;){var y=1<<31-yt(c);d.entanglements[1]|=y,c&=~y}Yt(i),(ve&6)===0&&(Ri=mt()+500,En(0))}}break;case 31:case 13:d=Yl(i,2),d!==null&&dt(d,i,2),Di(),du(i,2)}if(i=hu(a),i===null&&Pc(e,t,a,Ki,l),i===n)break;n=i}n!==null&&a.stopPropagation()}else Pc(e,t,a,null,l)}}function hu(e){return e=xs(e),yu(e)}var Ki=null;function yu(e){if(Ki=null,e=la(e),e!==null){var t=x(e);if(t===null)e=null;else{var l=t.tag;if(l===13){if(e=b(t),e!==null)return e;e=null}else if(l===31){if(e=B(t),e!==null)return e;e=null}else if(l===3){if(t.stateNode.current.memoizedState.isDehydrated)return t.tag===3?t.stateNode.containerInfo:null;e=null}else t!==e&&(e=null)}}return Ki=e,null}function z0(e){switch(e){case"beforetoggle":case"cancel":case"click":case"close":case"contextmenu":case"copy":case"cut":case"auxclick":case"dblclick":case"dragend":case"dragstart":case"drop":case"focusin":case"focusout":case"input":case"invalid":case"keydown":case"keypress":case"keyup":case"mousedown":case"mouseup":case"paste":case"pause":case"play":case"pointercancel":case"pointerdown":case"pointerup":case"ratechange":case"reset":case"resize":case"seeked":case"submit":case"toggle":case"touchcancel":case"touchend":case"touchstart":case"volumechange":case"change":case"selectionchange":case"textInput":case"compositionstart":case"compositionend":case"compositionupdate":case"beforeblur":case"afterblur":case"beforeinput":case"blur":case"fullscreenchange":case"focus":case"hashchange":case"popstate":case"select":case"selectstart":return 2;case"drag":case"dragenter":case"dragexit":case"dragleave":case"dragover":case"mousemove":case"mouseout":case"mouseover":case"pointermove":case"pointerout":case"pointerover":case"scroll":case"touchmove":case"wheel":case"mouseenter":case"mouseleave":case"pointerenter":case"pointerleave":return 8;case"message":switch(ud()){case wu:return 2;case Bu:return 8;case Hn:case rd:return 32;case Uu:return 268435456;default:return 32}default:return 32}}var xu=!1,Rl=null,Ol=null,Dl=null,Rn=new Map,On=new Map,_l=[],Eh="mousedown mouseup touchcancel touchend touchstart auxclick dblclick pointercancel pointerdown pointerup dragend dragstart drop compositionend compositionstart keydown keypress keyup input textInput copy cut paste click change contextmenu reset".split(" ");function M0(e,t){switch(e){case"focusin":case"focusout":Rl=null;break;case"dragenter":case"dragleave":Ol=null;break;case"mouseover":case"mouseout":Dl=null;break;case"pointerover":case"pointerout":Rn.delete(t.pointerId);break;case"gotpointercapture":case"lostpointercapture":On.delete(t.pointerId)}}function Dn(e,t,l,a,n,i){return e===null||e.nativeEvent!==i?(e={blockedOn:t,domEventName:l,eventSystemFlags:a,nativeEvent:i,targetContainers:[n]},t!==null&&(t=aa(t),t!==null&&N0(t)),e):(e.eventSystemFlags|=a,t=e.targetContainers,n!==null&&t.indexOf(n)===-1&&t.push(n),e)}function Th(e,t,l,a,n){switch(t){case"focusin":return Rl=Dn(Rl,e,t,l,a,n),!0;case"dragenter":return Ol=Dn(Ol,e,t,l,a,n),!0;case"mouseover":return Dl=Dn(Dl,e,t,l,a,n),!0;case"pointerover":var i=n.pointerId;return Rn.set(i,Dn(Rn.get(i)||null,e,t,l,a,n)),!0;case"gotpointercapture":return i=n.pointerId,On.set(i,Dn(On.get(i)||null,e,t,l,a,n)),!0}return!1}function R0(e){var t=la(e.target);if(t!==null){var l=x(t);if(l!==null){if(t=l.tag,t===13){if(t=b(l),t!==null){e.blockedOn=t,Yu(e.priority,function(){C0(l)});return}}else if(t===31){if(t=B(l),t!==null){e.blockedOn=t,Yu(e.priority,function(){C0(l)});return}}else if(t===3&&l.stateNode.current.memoizedState.isDehydrated){e.blockedOn=l.tag===3?l.stateNode.containerInfo:null;return}}}e.blockedOn=null}function Ji(e){if(e.blockedOn!==null)return!1;for(var t=e.targetContainers;0<t.length;){var l=hu(e.nativeEvent);if(l===null){l=e.nativeEvent;var a=new l.constructor(l.type,l);ys=a,l.target.dispatchEvent(a),ys=null}else return t=aa(l),t!==null&&N0(t),e.blockedOn=l,!1;t.shift()}return!0}function O0(e,t,l){Ji(e)&&l.delete(t)}function Ah(){xu=!1,Rl!==null&&Ji(Rl)&&(Rl=null),Ol!==null&&Ji(Ol)&&(Ol=null),Dl!==null&&Ji(Dl)&&(Dl=null),Rn.forEach(O0),On.forEach(O0)}function Wi(e,t){e.blockedOn===t&&(e.blockedOn=null,xu||(xu=!0,o.unstable_scheduleCallback(o.unstable_NormalPriority,Ah)))}var $i=null;function D0(e){$i!==e&&($i=e,o.unstable_scheduleCallback(o.unstable_NormalPriority,function(){$i===e&&($i=null);for(var t=0;t<e.length;t+=3){var l=e[t],a=e[t+1],n=e[t+2];if(typeof a!="function"){if(yu(a||l)===null)continue;break}var i=aa(l);i!==null&&(e.splice(t,3),t-=3,hc(i,{pending:!0,data:n,method:l.method,action:a},a,n))}}))}function Va(e){function t(y){return Wi(y,e)}Rl!==null&&Wi(Rl,e),Ol!==null&&Wi(Ol,e),Dl!==null&&Wi(Dl,e),Rn.forEach(t),On.forEach(t);for(var l=0;l<_l.length;l++){var a=_l[l];a.blockedOn===e&&(a.blockedOn=null)}for(;0<_l.length&&(l=_l[0],l.blockedOn===null);)R0(l),l.blockedOn===null&&_l.shift();if(l=(e.ownerDocument||e).$$reactFormReplay,l!=null)for(a=0;a<l.length;a+=3){var n=l[a],i=l[a+1],c=n[st]||null;if(typeof i=="function")c||D0(l);else if(c){var d=null;if(i&&i.hasAttribute("formAction")){if(n=i,c=i[st]||null)d=c.formAction;else if(yu(n)!==null)continue}else d=c.action;typeof d=="function"?l[a+1]=d:(l.splice(a,3),a-=3),D0(l)}}}function _0(){function e(i){i.canIntercept&&i.info==="react-transition"&&i.intercept({handler:function(){return new Promise(function(c){return n=c})},focusReset:"manual",scroll:"manual"})}function t(){n!==null&&(n(),n=null),a||setTimeout(l,20)}function l(){if(!a&&!navigation.transition){var i=navigation.currentEntry;i&&i.url!=null&&navigation.navigate(i.url,{state:i.getState(),info:"react-transition",history:"replace"})}}if(typeof navigation=="object"){var a=!1,n=null;return navigation.addEventListener("navigate",e),navigation.addEventListener("navigatesuccess",t),navigation.addEventListener("navigateerror",t),setTimeout(l,100),function(){a=!0,navigation.removeEventListener("navigate",e),navigation.removeEventListener("navigatesuccess",t),navigation.removeEventListener("navigateerror",t),n!==null&&(n(),n=null)}}}function pu(e){this._internalRoot=e}Ii.prototype.render=pu.prototype.render=function(e){var t=this._internalRoot;if(t===null)throw Error(u(409));var l=t.current,a=St();T0(l,a,e,t,null,null)},Ii.prototype.unmount=pu.prototype.unmount=function(){var e=this._internalRoot;if(e!==null){this._internalRoot=null;var t=e.containerInfo;T0(e.current,2,null,e,null,null),Di(),t[ta]=null}};function Ii(e){this._internalRoot=e}Ii.prototype.unstable_scheduleHydration=function(e){if(e){var t=qu();e={blockedOn:null,target:e,priority:t};for(var l=0;l<_l.length&&t!==0&&t<_l[l].priority;l++);_l.splice(l,0,e),l===0&&R0(e)}};var w0=f.version;if(w0!=="19.2.4")throw Error(u(527,w0,"19.2.4"));H.findDOMNode=function(e){var t=e._reactInternals;if(t===void 0)throw typeof e.render=="function"?Error(u(188)):(e=Object.keys(e).join(","),Error(u(268,e)));return e=M(t),e=e!==null?P(e):null,e=e===null?null:e.stateNode,e};var Nh={bundleType:0,version:"19.2.4",rendererPackageName:"react-dom",currentDispatcherRef:A,reconcilerVersion:"19.2.4"};if(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__<"u"){var Pi=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(!Pi.isDisabled&&Pi.supportsFiber)try{Ga=Pi.inject(Nh),ht=Pi}catch{}}return wn.createRoot=function(e,t){if(!m(e))throw Error(u(299));var l=!1,a="",n=Go,i=qo,c=Yo;return t!=null&&(t.unstable_strictMode===!0&&(l=!0),t.identifierPrefix!==void 0&&(a=t.identifierPrefix),t.onUncaughtError!==void 0&&(n=t.onUncaughtError),t.onCaughtError!==void 0&&(i=t.onCaughtError),t.onRecoverableError!==void 0&&(c=t.onRecoverableError)),t=S0(e,1,!1,null,null,l,a,null,n,i,c,_0),e[ta]=t.current,Ic(e),new pu(t)},wn.hydrateRoot=function(e,t,l){if(!m(e))throw Error(u(299));var a=!1,n="",i=Go,c=qo,d=Yo,y=null;return l!=null&&(l.unstable_strictMode===!0&&(a=!0),l.identifierPrefix!==void 0&&(n=l.identifierPrefix),l.onUncaughtError!==void 0&&(i=l.onUncaughtError),l.onCaughtError!==void 0&&(c=l.onCaughtError),l.onRecoverableError!==void 0&&(d=l.onRecoverableError),l.formState!==void 0&&(y=l.formState)),t=S0(e,1,!0,t,l??null,a,n,y,i,c,d,_0),t.context=E0(null),l=t.current,a=St(),a=cs(a),n=gl(a),n.callback=null,vl(l,n,a),l=a,t.current.lanes=l,Ya(t,l),Yt(t),e[ta]=t.current,Ic(e),new Ii(t)},wn.version="19.2.4",wn}var Q0;function Hh(){if(Q0)return bu.exports;Q0=1;function o(){if(!(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__>"u"||typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE!="function"))try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(o)}catch(f){console.error(f)}}return o(),bu.exports=Uh(),bu.exports}var Vh=Hh();const Lh=P0(Vh);var q=(o=>(o.LOGIN="LOGIN",o.LOBBY="LOBBY",o.ROLE_REVEAL="ROLE_REVEAL",o.WORD_SELECTION="WORD_SELECTION",o.DAY_PHASE="DAY_PHASE",o.VOTING="VOTING",o.WEREWOLF_GUESS="WEREWOLF_GUESS",o.GAME_OVER="GAME_OVER",o))(q||{}),_e=(o=>(o.VILLAGER="VILLAGER",o.WEREWOLF="WEREWOLF",o.SEER="SEER",o.MAYOR="MAYOR",o))(_e||{}),Me=(o=>(o.YES="YES",o.NO="NO",o.MAYBE="MAYBE",o.SO_CLOSE="SO_CLOSE",o.WAY_OFF="WAY_OFF",o.CORRECT="CORRECT",o))(Me||{});const Gh=async()=>{const o=["Moon","Silver","Forest","Castle","Mirror","Wolf","Village","Puppy","Garden","Ocean"];return o[Math.floor(Math.random()*o.length)]};class qh{constructor(){this.ctx=null,this.isMuted=!1}getContext(){return this.ctx||(this.ctx=new(window.AudioContext||window.webkitAudioContext)),this.ctx}get muted(){return this.isMuted}toggleMute(){return this.isMuted=!this.isMuted,this.isMuted}playTone(f,r,u,m=.1){if(this.isMuted)return;const x=this.getContext(),b=x.createOscillator(),B=x.createGain();b.type=r,b.frequency.setValueAtTime(f,x.currentTime),B.gain.setValueAtTime(m,x.currentTime),B.gain.exponentialRampToValueAtTime(.001,x.currentTime+u),b.connect(B),B.connect(x.destination),b.start(),b.stop(x.currentTime+u)}playPop(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[880,1100].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(u,r+m*.06),x.frequency.exponentialRampToValueAtTime(u*1.2,r+m*.06+.04),b.gain.setValueAtTime(.08,r+m*.06),b.gain.exponentialRampToValueAtTime(.001,r+m*.06+.08),x.connect(b),b.connect(f.destination),x.start(r+m*.06),x.stop(r+m*.06+.08)})}playError(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(500,r),u.frequency.exponentialRampToValueAtTime(250,r+.3),m.gain.setValueAtTime(.08,r),m.gain.exponentialRampToValueAtTime(.001,r+.35),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.35)}playSuccess(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[660,880,1100,1320,1760].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(u,r+m*.08),x.frequency.exponentialRampToValueAtTime(u*1.1,r+m*.08+.05),b.gain.setValueAtTime(.07,r+m*.08),b.gain.exponentialRampToValueAtTime(.001,r+m*.08+.15),x.connect(b),b.connect(f.destination),x.start(r+m*.08),x.stop(r+m*.08+.15)})}playTick(){this.playTone(1e3,"sine",.025,.02)}playWarning(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sawtooth",u.frequency.setValueAtTime(120,r),u.frequency.linearRampToValueAtTime(100,r+.3),m.gain.setValueAtTime(.04,r),m.gain.exponentialRampToValueAtTime(.001,r+.4),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.4)}playHowl(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(220,r),u.frequency.exponentialRampToValueAtTime(380,r+.4),u.frequency.exponentialRampToValueAtTime(200,r+.8),m.gain.setValueAtTime(.06,r),m.gain.setValueAtTime(.06,r+.3),m.gain.exponentialRampToValueAtTime(.001,r+.9),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.9);const x=f.createOscillator(),b=f.createGain();x.type="triangle",x.frequency.setValueAtTime(440,r+.1),x.frequency.exponentialRampToValueAtTime(760,r+.5),x.frequency.exponentialRampToValueAtTime(400,r+.9),b.gain.setValueAtTime(.025,r+.1),b.gain.exponentialRampToValueAtTime(.001,r+.9),x.connect(b),b.connect(f.destination),x.start(r+.1),x.stop(r+.9)}playReveal(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[523,659,784,1047,1319].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.value=u,b.gain.setValueAtTime(.06,r+m*.12),b.gain.exponentialRampToValueAtTime(.001,r+m*.12+.35),x.connect(b),b.connect(f.destination),x.start(r+m*.12),x.stop(r+m*.12+.35)})}playDefeat(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[500,420,340,280].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(u,r+m*.18),x.frequency.exponentialRampToValueAtTime(u*.85,r+m*.18+.15),b.gain.setValueAtTime(.07,r+m*.18),b.gain.exponentialRampToValueAtTime(.001,r+m*.18+.3),x.connect(b),b.connect(f.destination),x.start(r+m*.18),x.stop(r+m*.18+.3)})}playClick(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(1400,r),u.frequency.exponentialRampToValueAtTime(900,r+.04),m.gain.setValueAtTime(.06,r),m.gain.exponentialRampToValueAtTime(.001,r+.06),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.06)}playVote(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(200,r),m.gain.setValueAtTime(.1,r),m.gain.exponentialRampToValueAtTime(.001,r+.08),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.08);const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(800,r+.06),x.frequency.exponentialRampToValueAtTime(1200,r+.12),b.gain.setValueAtTime(.06,r+.06),b.gain.exponentialRampToValueAtTime(.001,r+.18),x.connect(b),b.connect(f.destination),x.start(r+.06),x.stop(r+.18)}playTokenGiven(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[700,1050].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(u,r+m*.08),x.frequency.exponentialRampToValueAtTime(u*.9,r+m*.08+.1),b.gain.setValueAtTime(.09,r+m*.08),b.gain.exponentialRampToValueAtTime(.001,r+m*.08+.15),x.connect(b),b.connect(f.destination),x.start(r+m*.08),x.stop(r+m*.08+.15)})}playTokenReceived(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(600,r),u.frequency.exponentialRampToValueAtTime(900,r+.05),u.frequency.exponentialRampToValueAtTime(750,r+.12),m.gain.setValueAtTime(.08,r),m.gain.exponentialRampToValueAtTime(.001,r+.15),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.15)}playGuess(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(500,r),u.frequency.exponentialRampToValueAtTime(800,r+.04),u.frequency.exponentialRampToValueAtTime(600,r+.08),m.gain.setValueAtTime(.07,r),m.gain.exponentialRampToValueAtTime(.001,r+.1),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.1)}playNewGuess(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(1200,r),u.frequency.exponentialRampToValueAtTime(800,r+.06),m.gain.setValueAtTime(.03,r),m.gain.exponentialRampToValueAtTime(.001,r+.08),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.08)}playHoverSqueak(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(1800,r),u.frequency.exponentialRampToValueAtTime(1400,r+.03),m.gain.setValueAtTime(.02,r),m.gain.exponentialRampToValueAtTime(.001,r+.04),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.04)}playBreedSelect(f,r){if(this.isMuted)return;const u=this.getContext(),m=u.currentTime;if(f==="floppy"){const x=u.createOscillator(),b=u.createGain();x.type="sine",x.frequency.setValueAtTime(280,m),x.frequency.exponentialRampToValueAtTime(400,m+.05),x.frequency.exponentialRampToValueAtTime(320,m+.12),b.gain.setValueAtTime(.1,m),b.gain.exponentialRampToValueAtTime(.001,m+.18),x.connect(b),b.connect(u.destination),x.start(m),x.stop(m+.18);const B=u.createOscillator(),z=u.createGain();B.type="sine",B.frequency.setValueAtTime(560,m+.06),B.frequency.exponentialRampToValueAtTime(700,m+.1),z.gain.setValueAtTime(.04,m+.06),z.gain.exponentialRampToValueAtTime(.001,m+.16),B.connect(z),z.connect(u.destination),B.start(m+.06),B.stop(m+.16)}else if(f==="pointy")[900,1200].forEach((x,b)=>{const B=u.createOscillator(),z=u.createGain();B.type="sine",B.frequency.setValueAtTime(x,m+b*.07),B.frequency.exponentialRampToValueAtTime(x*1.3,m+b*.07+.03),B.frequency.exponentialRampToValueAtTime(x*.9,m+b*.07+.07),z.gain.setValueAtTime(.08,m+b*.07),z.gain.exponentialRampToValueAtTime(.001,m+b*.07+.09),B.connect(z),z.connect(u.destination),B.start(m+b*.07),B.stop(m+b*.07+.09)});else if(f==="bat"){const x=u.createOscillator(),b=u.createGain();x.type="sawtooth",x.frequency.setValueAtTime(150,m),x.frequency.exponentialRampToValueAtTime(100,m+.06),b.gain.setValueAtTime(.04,m),b.gain.exponentialRampToValueAtTime(.001,m+.08),x.connect(b),b.connect(u.destination),x.start(m),x.stop(m+.08);const B=u.createOscillator(),z=u.createGain();B.type="sine",B.frequency.setValueAtTime(1400,m+.07),B.frequency.exponentialRampToValueAtTime(1800,m+.1),B.frequency.exponentialRampToValueAtTime(1200,m+.15),z.gain.setValueAtTime(.06,m+.07),z.gain.exponentialRampToValueAtTime(.001,m+.17),B.connect(z),z.connect(u.destination),B.start(m+.07),B.stop(m+.17)}else[600,800,1e3].forEach((x,b)=>{const B=u.createOscillator(),z=u.createGain();B.type="triangle",B.frequency.setValueAtTime(x,m+b*.06),B.frequency.exponentialRampToValueAtTime(x*.7,m+b*.06+.08),z.gain.setValueAtTime(.07,m+b*.06),z.gain.exponentialRampToValueAtTime(.001,m+b*.06+.12),B.connect(z),z.connect(u.destination),B.start(m+b*.06),B.stop(m+b*.06+.12)});if(r){const x=u.createOscillator(),b=u.createGain();x.type="sine",x.frequency.setValueAtTime(2200,m+.18),x.frequency.exponentialRampToValueAtTime(1800,m+.22),b.gain.setValueAtTime(.02,m+.18),b.gain.exponentialRampToValueAtTime(.001,m+.24),x.connect(b),b.connect(u.destination),x.start(m+.18),x.stop(m+.24)}}playDramaticDrum(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(80,r),u.frequency.exponentialRampToValueAtTime(40,r+.15),m.gain.setValueAtTime(.12,r),m.gain.exponentialRampToValueAtTime(.001,r+.2),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.2);const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(60,r+.3),x.frequency.exponentialRampToValueAtTime(35,r+.4),b.gain.setValueAtTime(.06,r+.3),b.gain.exponentialRampToValueAtTime(.001,r+.45),x.connect(b),b.connect(f.destination),x.start(r+.3),x.stop(r+.45)}playBark(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sawtooth",u.frequency.setValueAtTime(180,r),u.frequency.exponentialRampToValueAtTime(350,r+.04),u.frequency.exponentialRampToValueAtTime(200,r+.12),m.gain.setValueAtTime(.12,r),m.gain.exponentialRampToValueAtTime(.001,r+.18),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.18);const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.setValueAtTime(600,r+.02),x.frequency.exponentialRampToValueAtTime(900,r+.06),x.frequency.exponentialRampToValueAtTime(500,r+.14),b.gain.setValueAtTime(.06,r+.02),b.gain.exponentialRampToValueAtTime(.001,r+.16),x.connect(b),b.connect(f.destination),x.start(r+.02),x.stop(r+.16)}playVictoryDance(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[440,554,659,880,1047,1319,1568,1760].forEach((m,x)=>{const b=f.createOscillator(),B=f.createGain();b.type=x<6?"sine":"triangle",b.frequency.setValueAtTime(m,r+x*.09),b.frequency.exponentialRampToValueAtTime(m*1.15,r+x*.09+.04),B.gain.setValueAtTime(.06,r+x*.09),B.gain.exponentialRampToValueAtTime(.001,r+x*.09+.18),b.connect(B),B.connect(f.destination),b.start(r+x*.09),b.stop(r+x*.09+.18)}),[880,1100,1320].forEach(m=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.value=m,b.gain.setValueAtTime(.04,r+.8),b.gain.exponentialRampToValueAtTime(.001,r+1.5),x.connect(b),b.connect(f.destination),x.start(r+.8),x.stop(r+1.5)})}playDefeatSlouch(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[600,500,400,300,220,180].forEach((m,x)=>{const b=f.createOscillator(),B=f.createGain();b.type="sine",b.frequency.setValueAtTime(m,r+x*.15),b.frequency.exponentialRampToValueAtTime(m*.8,r+x*.15+.12),B.gain.setValueAtTime(.05,r+x*.15),B.gain.exponentialRampToValueAtTime(.001,r+x*.15+.25),b.connect(B),B.connect(f.destination),b.start(r+x*.15),b.stop(r+x*.15+.25)})}playHintReveal(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[1200,1500,1800].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.value=u,b.gain.setValueAtTime(.04,r+m*.08),b.gain.exponentialRampToValueAtTime(.001,r+m*.08+.2),x.connect(b),b.connect(f.destination),x.start(r+m*.08),x.stop(r+m*.08+.2)})}playReactionPop(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(1600,r),u.frequency.exponentialRampToValueAtTime(1200,r+.05),m.gain.setValueAtTime(.04,r),m.gain.exponentialRampToValueAtTime(.001,r+.07),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+.07)}playNightTransition(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime,u=f.createOscillator(),m=f.createGain();u.type="sine",u.frequency.setValueAtTime(100,r),u.frequency.exponentialRampToValueAtTime(60,r+.8),m.gain.setValueAtTime(.06,r),m.gain.exponentialRampToValueAtTime(.001,r+1),u.connect(m),m.connect(f.destination),u.start(r),u.stop(r+1)}playWordChosen(){if(this.isMuted)return;const f=this.getContext(),r=f.currentTime;[880,1175,1397,1760].forEach((u,m)=>{const x=f.createOscillator(),b=f.createGain();x.type="sine",x.frequency.value=u,b.gain.setValueAtTime(.05,r+m*.1),b.gain.exponentialRampToValueAtTime(.001,r+m*.1+.25),x.connect(b),b.connect(f.destination),x.start(r+m*.1),x.stop(r+m*.1+.25)})}}const le=new qh,Tu=()=>typeof crypto<"u"&&crypto.randomUUID?crypto.randomUUID():"xxxxxxxx-xxxx-4xxx-yxxx-xxxxxxxxxxxx".replace(/[xy]/g,function(o){var f=Math.random()*16|0,r=o=="x"?f:f&3|8;return r.toString(16)});class Yh{constructor(){this.listeners=new Set,this.intervalId=null,this.botIntervalId=null,this.state={phase:q.LOGIN,roomCode:"",players:[],secretWord:"",timeRemaining:0,tokensUsed:0,tokenHistory:[],guesses:[],myPlayerId:null}}subscribe(f){return this.listeners.add(f),f(this.state),()=>this.listeners.delete(f)}notify(){this.listeners.forEach(f=>f({...this.state}))}listRooms(){}addBot(){}submitGuess(f){}chooseWord(f){}toggleWantsMayor(){}onRoomList(f){return()=>{}}sendReaction(f){}revealHint(){}setDifficulty(f){}onReaction(f){return()=>{}}joinGame(f,r,u){const m=Tu();this.state.myPlayerId=m,this.state.players=[{id:m,name:f,role:_e.VILLAGER,isMayor:!1,isReady:!1,avatarUrl:`https://api.dicebear.com/7.x/adventurer/svg?seed=${m}&backgroundColor=b6e3f4,c0aede,d1d4f9,ffd5dc,ffdfbf`,votesReceived:0}],this.state.roomCode="WOLF-"+Math.floor(1e3+Math.random()*9e3),this.state.phase=q.LOBBY,setTimeout(()=>this.addSimBot("Alex"),1e3),setTimeout(()=>this.addSimBot("Sam"),2e3),setTimeout(()=>this.addSimBot("Jordan"),3e3),this.notify()}toggleReady(){const f=this.state.players.find(r=>r.id===this.state.myPlayerId);f&&(f.isReady=!f.isReady,le.playPop(),this.notify())}async startGame(){le.playSuccess();const f=[...this.state.players],r=[_e.WEREWOLF,_e.SEER,_e.VILLAGER,_e.VILLAGER];for(let m=r.length-1;m>0;m--){const x=Math.floor(Math.random()*(m+1));[r[m],r[x]]=[r[x],r[m]]}let u=f.findIndex(m=>m.id===this.state.myPlayerId);u===-1&&(u=0),f.forEach((m,x)=>{m.role=r[x%r.length],m.isMayor=x===u,m.votesReceived=0}),this.state.players=f,this.state.secretWord=await Gh(),this.state.timeRemaining=240,this.state.tokensUsed=0,this.state.tokenHistory=[],this.state.phase=q.ROLE_REVEAL,this.notify(),setTimeout(()=>{if(this.state.phase===q.ROLE_REVEAL){this.state.phase=q.DAY_PHASE,le.playSuccess(),this.startTimer();const m=this.state.players.find(x=>x.id===this.state.myPlayerId);m!=null&&m.isMayor||this.startBotMayor(),this.notify()}},8e3)}submitToken(f,r){f===Me.CORRECT?le.playSuccess():le.playPop();const u=this.state.players.filter(b=>!b.isMayor),m=u.length>0?u[Math.floor(Math.random()*u.length)]:null,x={id:Tu(),type:f,timestamp:Date.now(),targetPlayerId:m==null?void 0:m.id};this.state.tokenHistory.unshift(x),this.state.tokensUsed++,f===Me.CORRECT?this.endGame("VILLAGE"):this.notify()}vote(f){this.state.myPlayerId&&this.handleInternalVote(this.state.myPlayerId,f)}resetGame(){this.state.phase=q.LOBBY,this.state.players.forEach(f=>{f.isReady=!1,f.votesReceived=0}),this.state.secretWord="",this.state.tokenHistory=[],this.notify()}addSimBot(f){const r=Tu();this.state.players.push({id:r,name:f,role:_e.VILLAGER,isMayor:!1,isReady:!0,avatarUrl:`https://api.dicebear.com/7.x/adventurer/svg?seed=${r}&backgroundColor=b6e3f4,c0aede,d1d4f9,ffd5dc,ffdfbf`,votesReceived:0}),this.notify()}startTimer(){this.intervalId&&clearInterval(this.intervalId),this.intervalId=setInterval(()=>{if(this.state.phase!==q.DAY_PHASE){clearInterval(this.intervalId),this.botIntervalId&&clearInterval(this.botIntervalId);return}this.state.timeRemaining>0?(this.state.timeRemaining--,this.state.timeRemaining<=10&&le.playTick(),this.notify()):this.startVotingPhase()},1e3)}startBotMayor(){this.botIntervalId&&clearInterval(this.botIntervalId),this.botIntervalId=setInterval(()=>{if(this.state.phase!==q.DAY_PHASE){clearInterval(this.botIntervalId);return}const f=[Me.YES,Me.NO,Me.MAYBE,Me.SO_CLOSE,Me.WAY_OFF],r=f[Math.floor(Math.random()*f.length)];this.submitToken(r)},3e3)}startVotingPhase(){clearInterval(this.intervalId),this.botIntervalId&&clearInterval(this.botIntervalId),this.state.phase=q.VOTING,le.playWarning(),this.notify(),this.state.players.filter(f=>f.id!==this.state.myPlayerId).forEach(f=>{setTimeout(()=>{const r=this.state.players.filter(m=>m.id!==f.id),u=r[Math.floor(Math.random()*r.length)];this.handleInternalVote(f.id,u.id)},Math.random()*5e3+2e3)})}handleInternalVote(f,r){if(this.state.phase!==q.VOTING)return;const u=this.state.players.find(m=>m.id===r);u&&(u.votesReceived=(u.votesReceived||0)+1,le.playPop(),this.state.players.reduce((x,b)=>x+(b.votesReceived||0),0)>=this.state.players.length?this.resolveVoting():this.notify())}resolveVoting(){[...this.state.players].sort((u,m)=>(m.votesReceived||0)-(u.votesReceived||0))[0].role===_e.WEREWOLF?this.endGame("VILLAGE"):this.endGame("WEREWOLF")}endGame(f){clearInterval(this.intervalId),this.botIntervalId&&clearInterval(this.botIntervalId),f==="VILLAGE"?le.playSuccess():le.playError(),this.state.winner=f,this.state.phase=q.GAME_OVER,this.notify()}}new Yh;const Fh=()=>typeof window<"u"?`${window.location.protocol==="https:"?"wss:":"ws:"}//${window.location.host}/ws`:"ws://localhost:8080/ws",X0=Fh();class Qh{constructor(){this.socket=null,this.listeners=new Set,this.roomListListeners=new Set,this.reactionListeners=new Set,this.onConnectCallbacks=[],this.state={phase:q.LOGIN,roomCode:"",players:[],secretWord:"",timeRemaining:0,tokensUsed:0,tokenHistory:[],guesses:[],myPlayerId:null,difficulty:"MEDIUM",hintsRevealed:0,numWerewolves:1}}connect(){this.socket&&(this.socket.readyState===WebSocket.OPEN||this.socket.readyState===WebSocket.CONNECTING)||(console.log(`Connecting to WebSocket at ${X0}...`),this.socket=new WebSocket(X0),this.socket.onopen=()=>{console.log("Connected to Game Server"),this.onConnectCallbacks.forEach(f=>f()),this.onConnectCallbacks=[]},this.socket.onmessage=f=>{try{const r=JSON.parse(f.data);this.handleServerMessage(r)}catch(r){console.error("Failed to parse server message",r)}},this.socket.onclose=()=>{console.log("Disconnected from Game Server")},this.socket.onerror=f=>{console.error("WebSocket Error",f)})}sendMessage(f){this.socket&&this.socket.readyState===WebSocket.OPEN?this.socket.send(JSON.stringify(f)):(console.warn("Socket not connected. Attempting to connect..."),this.connect(),this.onConnectCallbacks.push(()=>{var r;((r=this.socket)==null?void 0:r.readyState)===WebSocket.OPEN&&this.socket.send(JSON.stringify(f))}))}handleServerMessage(f){if(f.type==="STATE_UPDATE")this.state=f.payload,this.notify();else if(f.type==="ERROR")console.error("Server Error:",f.payload.message),alert(f.payload.message);else if(f.type==="ROOM_LIST"){const r=f.payload.rooms;this.roomListListeners.forEach(u=>u(r))}else if(f.type==="REACTION"){const r=f.payload;this.reactionListeners.forEach(u=>u(r))}}subscribe(f){return this.listeners.add(f),f(this.state),this.connect(),()=>this.listeners.delete(f)}notify(){this.listeners.forEach(f=>f(this.state))}joinGame(f,r,u){this.sendMessage({type:"JOIN_GAME",payload:{name:f,roomCode:r,avatarUrl:u}})}toggleReady(){this.sendMessage({type:"TOGGLE_READY"})}toggleWantsMayor(){this.sendMessage({type:"TOGGLE_WANTS_MAYOR"})}startGame(){this.sendMessage({type:"START_GAME"})}submitToken(f,r){this.sendMessage({type:"SUBMIT_TOKEN",payload:{tokenType:f,targetPlayerId:r}})}submitGuess(f){this.sendMessage({type:"SUBMIT_GUESS",payload:{text:f}})}chooseWord(f){this.sendMessage({type:"CHOOSE_WORD",payload:{word:f}})}vote(f){this.sendMessage({type:"VOTE",payload:{targetId:f}})}resetGame(){this.sendMessage({type:"RESET_GAME"})}listRooms(){this.sendMessage({type:"LIST_ROOMS"})}addBot(){this.sendMessage({type:"ADD_BOT"})}sendReaction(f){this.sendMessage({type:"SEND_REACTION",payload:{emoji:f}})}revealHint(){this.sendMessage({type:"REVEAL_HINT"})}setDifficulty(f){this.sendMessage({type:"SET_DIFFICULTY",payload:{difficulty:f}})}onRoomList(f){return this.roomListListeners.add(f),()=>this.roomListListeners.delete(f)}onReaction(f){return this.reactionListeners.add(f),()=>this.reactionListeners.delete(f)}}const Xh=new Qh,Fe=Xh;console.log("[GameService] Using Live WebSocket Service");const Mu={[Me.YES]:{label:"Yes",color:"text-green-500",bgColor:"bg-green-900/30",icon:"CheckCircle"},[Me.NO]:{label:"No",color:"text-red-500",bgColor:"bg-red-900/30",icon:"XCircle"},[Me.MAYBE]:{label:"Maybe",color:"text-orange-400",bgColor:"bg-orange-900/30",icon:"HelpCircle"},[Me.SO_CLOSE]:{label:"So Close",color:"text-yellow-400",bgColor:"bg-yellow-900/30",icon:"AlertCircle"},[Me.WAY_OFF]:{label:"Way Off",color:"text-gray-400",bgColor:"bg-gray-800",icon:"ThumbsDown"},[Me.CORRECT]:{label:"Correct!",color:"text-emerald-400",bgColor:"bg-emerald-900",icon:"Star"}},kh={VILLAGER:"You're a Good Pup! Sniff out the Magic Word, or find the Werewolf Pup!",WEREWOLF:"You're a sneaky Werewolf Pup! You know the word — mislead the pack without getting caught!",SEER:"You're the Psychic Pup! You know the word — guide your pack with subtle hints!"},Bl=({size:o=24,className:f="",strokeWidth:r=2,fill:u="none",d:m,d2:x,d3:b,d4:B,style:z})=>s.jsxs("svg",{xmlns:"http://www.w3.org/2000/svg",width:o,height:o,viewBox:"0 0 24 24",fill:u,stroke:"currentColor",strokeWidth:r,strokeLinecap:"round",strokeLinejoin:"round",className:f,style:z,children:[s.jsx("path",{d:m}),x&&s.jsx("path",{d:x}),b&&s.jsx("path",{d:b}),B&&s.jsx("path",{d:B})]}),Je=(o,f,r,u,m)=>s.jsx("svg",{xmlns:"http://www.w3.org/2000/svg",width:o,height:o,viewBox:"0 0 24 24",fill:u,stroke:"currentColor",strokeWidth:f,strokeLinecap:"round",strokeLinejoin:"round",className:r,children:m}),Zh=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M22 11.08V12a10 10 0 1 1-5.93-9.14"}),s.jsx("path",{d:"m9 11 3 3L22 4"})]}))},Kh=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"12",cy:"12",r:"10"}),s.jsx("path",{d:"m15 9-6 6"}),s.jsx("path",{d:"m9 9 6 6"})]}))},ed=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"12",cy:"12",r:"10"}),s.jsx("path",{d:"M9.09 9a3 3 0 0 1 5.83 1c0 2-3 3-3 3"}),s.jsx("line",{x1:"12",y1:"17",x2:"12.01",y2:"17"})]}))},Jh=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"12",cy:"12",r:"10"}),s.jsx("line",{x1:"12",y1:"8",x2:"12",y2:"12"}),s.jsx("line",{x1:"12",y1:"16",x2:"12.01",y2:"16"})]}))},Wh=o=>s.jsx(Bl,{...o,d:"M17 14V2",d2:"M9 18.12 10 14H4.17a2 2 0 0 1-1.92-2.56l2.33-8A2 2 0 0 1 6.5 2H20a2 2 0 0 1 2 2v8a2 2 0 0 1-2 2h-2.76a2 2 0 0 0-1.79 1.11L12 22a3.13 3.13 0 0 1-3-3.88Z"}),ul=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M11.562 3.266a.5.5 0 0 1 .876 0L15.39 8.87a1 1 0 0 0 1.516.294L21.183 5.5a.5.5 0 0 1 .798.519l-2.834 10.246a1 1 0 0 1-.956.734H5.81a1 1 0 0 1-.957-.734L2.02 6.02a.5.5 0 0 1 .798-.519l4.276 3.664a1 1 0 0 0 1.516-.294z"}),s.jsx("path",{d:"M5 21h14"})]}))},$h=o=>s.jsx(Bl,{...o,d:"M5 12h14",d2:"m12 5 7 7-7 7"}),Ih=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M3 12a9 9 0 0 1 9-9 9.75 9.75 0 0 1 6.74 2.74L21 8"}),s.jsx("path",{d:"M21 3v5h-5"}),s.jsx("path",{d:"M21 12a9 9 0 0 1-9 9 9.75 9.75 0 0 1-6.74-2.74L3 16"}),s.jsx("path",{d:"M8 16H3v5"})]}))},td=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M6 9H4.5a2.5 2.5 0 0 1 0-5H6"}),s.jsx("path",{d:"M18 9h1.5a2.5 2.5 0 0 0 0-5H18"}),s.jsx("path",{d:"M4 22h16"}),s.jsx("path",{d:"M10 14.66V17c0 .55-.47.98-.97 1.21C7.85 18.75 7 20.24 7 22"}),s.jsx("path",{d:"M14 14.66V17c0 .55.47.98.97 1.21C16.15 18.75 17 20.24 17 22"}),s.jsx("path",{d:"M18 2H6v7a6 6 0 0 0 12 0V2Z"})]}))},Ru=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M2.062 12.348a1 1 0 0 1 0-.696 10.75 10.75 0 0 1 19.876 0 1 1 0 0 1 0 .696 10.75 10.75 0 0 1-19.876 0"}),s.jsx("circle",{cx:"12",cy:"12",r:"3"})]}))},Ph=o=>s.jsx(Bl,{...o,d:"M 11 3 a 8 8 0 1 0 0 16 8 8 0 0 0 0-16z",d2:"m21 21-4.3-4.3"}),e1=o=>s.jsx(Bl,{...o,d:"M12 3a6 6 0 0 0 9 9 9 9 0 1 1-9-9Z"}),Au=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M9.937 15.5A2 2 0 0 0 8.5 14.063l-6.135-1.582a.5.5 0 0 1 0-.962L8.5 9.936A2 2 0 0 0 9.937 8.5l1.582-6.135a.5.5 0 0 1 .963 0L14.063 8.5A2 2 0 0 0 15.5 9.937l6.135 1.581a.5.5 0 0 1 0 .964L15.5 14.063a2 2 0 0 0-1.437 1.437l-1.582 6.135a.5.5 0 0 1-.963 0z"}),s.jsx("path",{d:"M20 3v4"}),s.jsx("path",{d:"M22 5h-4"})]}))},t1=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M11 4.702a.705.705 0 0 0-1.203-.498L6.413 7.587A1.4 1.4 0 0 1 5.416 8H3a1 1 0 0 0-1 1v6a1 1 0 0 0 1 1h2.416a1.4 1.4 0 0 1 .997.413l3.383 3.384A.705.705 0 0 0 11 19.298z"}),s.jsx("path",{d:"M16 9a5 5 0 0 1 0 6"}),s.jsx("path",{d:"M19.364 18.364a9 9 0 0 0 0-12.728"})]}))},l1=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M11 4.702a.705.705 0 0 0-1.203-.498L6.413 7.587A1.4 1.4 0 0 1 5.416 8H3a1 1 0 0 0-1 1v6a1 1 0 0 0 1 1h2.416a1.4 1.4 0 0 1 .997.413l3.383 3.384A.705.705 0 0 0 11 19.298z"}),s.jsx("line",{x1:"22",y1:"9",x2:"16",y2:"15"}),s.jsx("line",{x1:"16",y1:"9",x2:"22",y2:"15"})]}))},k0=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M16 21v-2a4 4 0 0 0-4-4H6a4 4 0 0 0-4 4v2"}),s.jsx("circle",{cx:"9",cy:"7",r:"4"}),s.jsx("path",{d:"M22 21v-2a4 4 0 0 0-3-3.87"}),s.jsx("path",{d:"M16 3.13a4 4 0 0 1 0 7.75"})]}))},Ou=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M12 8V4H8"}),s.jsx("rect",{width:"16",height:"12",x:"4",y:"8",rx:"2"}),s.jsx("path",{d:"M2 14h2"}),s.jsx("path",{d:"M20 14h2"}),s.jsx("path",{d:"M15 13v2"}),s.jsx("path",{d:"M9 13v2"})]}))},a1=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("path",{d:"M7.21 15 2.66 7.14a2 2 0 0 1 .13-2.2L4.4 2.8A2 2 0 0 1 6 2h12a2 2 0 0 1 1.6.8l1.6 2.14a2 2 0 0 1 .14 2.2L16.79 15"}),s.jsx("path",{d:"M11 12 5.12 2.2"}),s.jsx("path",{d:"m13 12 5.88-9.8"}),s.jsx("path",{d:"M8 7h8"}),s.jsx("circle",{cx:"12",cy:"17",r:"5"}),s.jsx("path",{d:"M12 18v-2h-.5"})]}))},es=o=>s.jsx(Bl,{...o,d:"M11.525 2.295a.53.53 0 0 1 .95 0l2.31 4.679a2.123 2.123 0 0 0 1.595 1.16l5.166.756a.53.53 0 0 1 .294.904l-3.736 3.638a2.123 2.123 0 0 0-.611 1.878l.882 5.14a.53.53 0 0 1-.771.56l-4.618-2.428a2.122 2.122 0 0 0-1.973 0L6.396 21.01a.53.53 0 0 1-.77-.56l.881-5.139a2.122 2.122 0 0 0-.611-1.879L2.16 9.795a.53.53 0 0 1 .294-.906l5.165-.755a2.122 2.122 0 0 0 1.597-1.16z"}),La=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"11",cy:"4",r:"2"}),s.jsx("circle",{cx:"18",cy:"8",r:"2"}),s.jsx("circle",{cx:"20",cy:"16",r:"2"}),s.jsx("path",{d:"M9 10a5 5 0 0 1 5 5v3.5a3.5 3.5 0 0 1-6.84 1.045Q6.52 17.48 4.46 16.84A3.5 3.5 0 0 1 5.5 10Z"})]}))},ld=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"12",cy:"12",r:"10"}),s.jsx("polyline",{points:"12 6 12 12 16 14"})]}))},n1=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"12",cy:"12",r:"4"}),s.jsx("path",{d:"M12 2v2"}),s.jsx("path",{d:"M12 20v2"}),s.jsx("path",{d:"m4.93 4.93 1.41 1.41"}),s.jsx("path",{d:"m17.66 17.66 1.41 1.41"}),s.jsx("path",{d:"M2 12h2"}),s.jsx("path",{d:"M20 12h2"}),s.jsx("path",{d:"m6.34 17.66-1.41 1.41"}),s.jsx("path",{d:"m19.07 4.93-1.41 1.41"})]}))},i1=o=>s.jsx(Bl,{...o,d:"M14.536 21.686a.5.5 0 0 0 .937-.024l6.5-19a.496.496 0 0 0-.635-.635l-19 6.5a.5.5 0 0 0-.024.937l7.93 3.18a2 2 0 0 1 1.112 1.11z",d2:"m21.854 2.147-10.94 10.939"}),s1=o=>s.jsx(Bl,{...o,d:"M7.9 20A9 9 0 1 0 4 16.1L2 22Z"}),c1=o=>s.jsx(Bl,{...o,d:"M20 13c0 5-3.5 7.5-7.66 8.95a1 1 0 0 1-.67-.01C7.5 20.5 4 18 4 13V6a1 1 0 0 1 1-1c2 0 4.5-1.2 6.24-2.72a1.17 1.17 0 0 1 1.52 0C14.51 3.81 17 5 19 5a1 1 0 0 1 1 1z"}),u1=o=>{const{size:f=24,className:r="",strokeWidth:u=2,fill:m="none"}=o;return Je(f,u,r,m,s.jsxs(s.Fragment,{children:[s.jsx("circle",{cx:"9",cy:"12",r:"1"}),s.jsx("circle",{cx:"15",cy:"12",r:"1"}),s.jsx("path",{d:"M8 20v2h8v-2"}),s.jsx("path",{d:"m12.5 17-.5-1-.5 1h1z"}),s.jsx("path",{d:"M16 20a2 2 0 0 0 1.56-3.25 8 8 0 1 0-11.12 0A2 2 0 0 0 8 20"})]}))},r1=({role:o,isMayor:f,secretWord:r,revealed:u,onReveal:m})=>{const[x,b]=Q.useState(!1),B=()=>{x||(b(!0),m())},z=W=>{switch(W){case _e.WEREWOLF:return"🐺";case _e.SEER:return"🔮";default:return"🐶"}},M=W=>{switch(W){case _e.WEREWOLF:return"Were-Pup";case _e.SEER:return"Psychic Pup";default:return"Good Pup"}},P=W=>{switch(W){case _e.WEREWOLF:return"border-red-500/50 bg-gradient-to-br from-red-950/80 to-slate-900";case _e.SEER:return"border-blue-500/50 bg-gradient-to-br from-blue-950/80 to-slate-900";default:return"border-emerald-500/50 bg-gradient-to-br from-emerald-950/80 to-slate-900"}},L=W=>{switch(W){case _e.WEREWOLF:return"shadow-[0_0_40px_rgba(239,68,68,0.15)]";case _e.SEER:return"shadow-[0_0_40px_rgba(59,130,246,0.15)]";default:return"shadow-[0_0_40px_rgba(34,197,94,0.15)]"}},k=o===_e.WEREWOLF||o===_e.SEER||f;return s.jsx("div",{className:"perspective-1000 w-full max-w-xs mx-auto h-[380px] cursor-pointer",onClick:B,children:s.jsxs("div",{className:`relative w-full h-full transition-transform duration-700 transform-style-3d ${x?"rotate-y-180":""}`,children:[s.jsxs("div",{className:"absolute w-full h-full backface-hidden rounded-2xl border-[3px] border-amber-600/30 bg-gradient-to-br from-slate-800 to-slate-900 shadow-2xl flex flex-col items-center justify-center p-6",children:[s.jsx("div",{className:"w-24 h-24 rounded-full bg-amber-500/10 border-2 border-amber-500/20 flex items-center justify-center mb-4",children:s.jsx("span",{className:"text-5xl",children:"🐾"})}),s.jsx("h2",{className:"text-2xl font-serif text-amber-400 tracking-widest uppercase",children:"Werepups"}),s.jsx("p",{className:"mt-4 text-slate-500 text-xs",children:"Tap to reveal your breed"}),s.jsx("div",{className:"mt-6 w-16 h-0.5 bg-amber-600/20 rounded"})]}),s.jsxs("div",{className:`absolute w-full h-full backface-hidden rotate-y-180 rounded-2xl border-[3px] ${P(o)} ${L(o)} flex flex-col items-center justify-between p-7`,children:[f&&s.jsx("div",{className:"absolute top-3 right-3",children:s.jsx("div",{className:"bg-amber-400 text-slate-900 rounded-full p-1.5 shadow-lg",children:s.jsx(ul,{size:18,fill:"currentColor"})})}),s.jsxs("div",{className:"mt-6 flex flex-col items-center animate-fade-in-up",children:[s.jsx("div",{className:"w-20 h-20 rounded-full bg-white/5 border border-white/10 flex items-center justify-center mb-3",children:s.jsx("span",{className:"text-4xl",children:z(o)})}),s.jsx("h2",{className:"text-2xl font-serif font-bold text-slate-100 tracking-wider",children:M(o)}),f&&s.jsx("span",{className:"text-amber-400 text-xs font-semibold mt-1",children:"+ Pack Leader"}),s.jsx("p",{className:"text-slate-400 text-center text-xs mt-2 italic px-2 leading-relaxed",children:kh[o]})]}),s.jsxs("div",{className:"w-full bg-black/30 rounded-xl p-4 backdrop-blur-sm border border-white/5 text-center",children:[s.jsx("p",{className:"text-[10px] text-slate-500 uppercase tracking-widest mb-1",children:"Secret Word"}),k?s.jsx("p",{className:"text-xl font-bold text-amber-400",children:r}):s.jsx("p",{className:"text-lg text-slate-600",children:"? ? ?"})]})]})]})})},o1={CheckCircle:Zh,XCircle:Kh,HelpCircle:ed,AlertCircle:Jh,ThumbsDown:Wh,Star:es},Bn=({type:o,onClick:f,disabled:r,size:u="md"})=>{const m=Mu[o],x=o1[m.icon]||ed,b={sm:"w-10 h-10",md:"w-16 h-16",lg:"w-24 h-24"},B={sm:16,md:24,lg:32};return s.jsx("button",{onClick:f,disabled:r,className:`
-        ${b[u]} 
-        ${m.bgColor} 
-        ${m.color}
-        rounded-full flex items-center justify-center 
-        border-2 border-current shadow-[0_0_15px_rgba(0,0,0,0.3)]
-        transition-all duration-200
-        ${r?"opacity-50 cursor-not-allowed grayscale":"hover:scale-110 active:scale-95 hover:shadow-[0_0_20px_currentColor]"}
-      `,title:m.label,children:s.jsx(x,{size:B[u],strokeWidth:3})})},f1=({text:o,isNew:f})=>s.jsx("div",{className:`absolute bottom-full left-1/2 -translate-x-1/2 mb-1 z-30 pointer-events-none
-    ${f?"animate-bubble-pop":""}`,children:s.jsxs("div",{className:`relative bg-white/95 text-slate-800 rounded-xl px-2.5 py-1 text-[10px] sm:text-xs font-medium
-      shadow-lg shadow-black/20 border border-white/50 whitespace-nowrap max-w-[140px] truncate`,children:[o,s.jsx("div",{className:`absolute top-full left-1/2 -translate-x-1/2 w-0 h-0
-        border-l-[5px] border-l-transparent
-        border-r-[5px] border-r-transparent
-        border-t-[5px] border-t-white/95`})]})}),d1=(o,f)=>{if(f)return{icon:s.jsx(ul,{size:14,className:"text-amber-400"}),color:"border-amber-500/30 bg-amber-950/30 text-amber-300",text:"You're the Pack Leader! You know the word. Use YES/NO tokens to guide your pack. Don't make it too obvious!"};switch(o){case"WEREWOLF":return{icon:s.jsx(u1,{size:14,className:"text-red-400"}),color:"border-red-500/30 bg-red-950/30 text-red-300",text:"You're a Werewolf Pup! You know the word — try to mislead the pack without getting caught. Fellow wolves are marked with 🐺."};case"SEER":return{icon:s.jsx(Ru,{size:14,className:"text-blue-400"}),color:"border-blue-500/30 bg-blue-950/30 text-blue-300",text:"You're the Psychic Pup! You know the word — guide your pack with subtle guesses. Don't reveal yourself to the wolves!"};default:return{icon:s.jsx(c1,{size:14,className:"text-emerald-400"}),color:"border-emerald-500/30 bg-emerald-950/30 text-emerald-300",text:"You're a Good Pup! Ask yes/no questions to figure out the secret word. Watch out — a Werewolf is hiding among you!"}}},m1=({state:o,onTokenClick:f,onSubmitGuess:r})=>{const u=o.players.find(O=>O.id===o.myPlayerId),m=u==null?void 0:u.isMayor,x=u==null?void 0:u.role,b=x===_e.WEREWOLF,B=o.players.find(O=>O.isMayor),z=o.players.filter(O=>!O.isMayor),M=d1(x,!!m),P=o.secretWord?Math.floor(o.secretWord.length/2):0,[L,k]=Q.useState(null),[W,I]=Q.useState(""),[se,be]=Q.useState(!1),[pe,V]=Q.useState(new Set),[G,ce]=Q.useState([]),Ne=Q.useRef(0),ze=Q.useRef(null),ee=Q.useRef(new Set),ge=Q.useMemo(()=>{const O=new Set((o.guesses||[]).map(g=>g.playerId)),h=new Set;return O.forEach(g=>{ee.current.has(g)||h.add(g)}),h},[o.guesses]);Q.useEffect(()=>{ge.size>0&&Array.from(ge).some(h=>h!==o.myPlayerId)&&le.playNewGuess(),ee.current=new Set((o.guesses||[]).map(O=>O.playerId))},[o.guesses]);const Ve=Q.useRef(o.tokenHistory.length);Q.useEffect(()=>{if(o.tokenHistory.length>Ve.current){const O=o.tokenHistory.slice(0,o.tokenHistory.length-Ve.current);le.playTokenReceived();const h=new Set(O.map(g=>g.id));V(h),setTimeout(()=>V(new Set),600),O.forEach(g=>{if(g.type==="YES"||g.type==="CORRECT"||g.type==="SO_CLOSE"){const D=[];for(let U=0;U<3;U++)D.push({id:Ne.current++,x:30+Math.random()*40,y:30+Math.random()*30});ce(U=>[...U,...D]),setTimeout(()=>{ce(U=>U.filter(X=>!D.find(_=>_.id===X.id)))},1500)}})}Ve.current=o.tokenHistory.length},[o.tokenHistory]);const Vt=Q.useMemo(()=>{const O=new Map;return(o.guesses||[]).forEach(h=>{const g=O.get(h.playerId);(!g||h.timestamp>g.timestamp)&&O.set(h.playerId,h)}),O},[o.guesses]),lt=Q.useMemo(()=>{const O=new Map;return o.tokenHistory.forEach(h=>{if(h.targetPlayerId&&!O.has(h.targetPlayerId))switch(h.type){case"YES":case"CORRECT":O.set(h.targetPlayerId,"happy");break;case"SO_CLOSE":O.set(h.targetPlayerId,"excited");break;case"WAY_OFF":O.set(h.targetPlayerId,"confused");break;case"NO":O.set(h.targetPlayerId,"sad");break}}),O},[o.tokenHistory]),ke=O=>{L===O?k(null):(k(O),le.playClick())},ae=O=>{!m||!L||(f(L,O),le.playTokenGiven(),k(null))},it=()=>{var h;const O=W.trim();O&&(r(O),le.playGuess(),I(""),be(!0),setTimeout(()=>be(!1),300),(h=ze.current)==null||h.focus())},at=O=>{O.key==="Enter"&&(O.preventDefault(),it())},A=O=>{const h=Math.floor(O/60),g=O%60;return`${h}:${g.toString().padStart(2,"0")}`},H=o.timeRemaining<30,Z=o.timeRemaining<10,he=z.length<=3?30:z.length<=5?34:38;return s.jsxs("div",{className:`flex flex-col h-full max-w-2xl mx-auto px-3 pt-3 pb-2 overflow-hidden ${Z?"animate-[screen-shake_0.3s_ease-in-out_infinite]":""}`,children:[G.map(O=>s.jsx("div",{className:"animate-heart-float",style:{left:`${O.x}%`,top:`${O.y}%`,"--hx":`${(Math.random()-.5)*30}px`,fontSize:16+Math.random()*10},children:["💖","💛","✨","🌟"][O.id%4]},O.id)),s.jsxs("div",{className:"flex items-center justify-between bg-slate-900/50 backdrop-blur-md px-3 py-2 rounded-xl border border-slate-700/40 shrink-0",children:[s.jsxs("div",{className:"flex items-center gap-2",children:[s.jsx(ld,{size:15,className:Z?"text-red-400 animate-pulse":H?"text-orange-400":"text-slate-400"}),s.jsx("span",{className:`text-base font-mono font-bold ${Z?"text-red-400 animate-pulse":H?"text-orange-400":"text-slate-100"}`,children:A(o.timeRemaining)})]}),s.jsx("div",{className:"text-center flex-1 mx-3",children:m||(u==null?void 0:u.role)==="WEREWOLF"||(u==null?void 0:u.role)==="SEER"?s.jsxs("div",{className:"flex items-center justify-center gap-2",children:[s.jsx("p",{className:"text-base sm:text-lg font-serif font-bold text-amber-400 truncate",children:o.secretWord}),m&&o.hintsRevealed<P&&s.jsxs("button",{onClick:()=>{Fe.revealHint(),le.playClick()},className:"text-[9px] px-2 py-0.5 bg-indigo-500/20 text-indigo-300 rounded-full border border-indigo-500/30 hover:bg-indigo-500/30 transition-all shrink-0",title:`Reveal a letter hint (${o.hintsRevealed}/${P} used, costs 1 score)`,children:["💡 Hint (",P-o.hintsRevealed,")"]})]}):o.secretWordHints?s.jsx("p",{className:"text-base sm:text-lg font-mono font-bold text-indigo-300 tracking-wider",children:o.secretWordHints}):s.jsx("p",{className:"text-base sm:text-lg font-serif font-bold text-slate-600",children:"? ? ? ? ?"})}),s.jsxs("div",{className:"flex items-center gap-1.5",children:[s.jsx(n1,{size:14,className:"text-amber-400/60"}),s.jsx("span",{className:"text-[10px] text-slate-500 hidden sm:block",children:"Day"})]})]}),s.jsxs("div",{className:`mt-1.5 px-2.5 py-1.5 rounded-lg border text-[11px] flex items-center gap-1.5 shrink-0 ${M.color}`,children:[M.icon,s.jsx("p",{className:"leading-snug truncate",children:M.text})]}),s.jsx("div",{className:"flex-1 flex items-center justify-center min-h-0 py-3",children:s.jsxs("div",{className:"relative",style:{width:"min(100%, 380px)",aspectRatio:"1 / 1"},children:[s.jsx("div",{className:"absolute inset-[14%] rounded-full border border-dashed border-slate-700/25 pointer-events-none"}),B&&(()=>{const O=b&&B.id!==o.myPlayerId&&B.role===_e.WEREWOLF;return s.jsx("div",{className:"absolute top-1/2 left-1/2 -translate-x-1/2 -translate-y-1/2 z-10",children:s.jsxs("div",{className:"flex flex-col items-center",children:[s.jsxs("div",{className:"relative rounded-full",children:[s.jsx("div",{className:`w-16 h-16 sm:w-20 sm:h-20 rounded-full border-[3px] overflow-hidden bg-slate-800 hover-wiggle cursor-pointer
-                      ${O?"border-red-500 shadow-red-500/30 shadow-lg":"border-amber-400"}`,onMouseEnter:()=>le.playHoverSqueak(),children:s.jsx("img",{src:B.avatarUrl,alt:B.name,className:"w-full h-full"})}),s.jsx("div",{className:"absolute -top-0.5 -right-0.5 bg-amber-400 text-slate-900 rounded-full p-0.5 shadow-lg z-20",children:s.jsx(ul,{size:12,fill:"currentColor"})}),O&&s.jsx("div",{className:"absolute -top-0.5 -left-0.5 z-20 bg-red-500 text-white rounded-full w-4 h-4 flex items-center justify-center text-[9px] shadow-lg border border-red-400",children:"🐺"})]}),s.jsx("span",{className:`text-[10px] font-bold mt-0.5 ${O?"text-red-400":"text-amber-300"}`,children:B.name}),s.jsx("span",{className:"text-amber-500/40 text-[8px] uppercase tracking-widest",children:"Pack Leader"})]})})})(),z.map((O,h)=>{const g=2*Math.PI*h/z.length-Math.PI/2,D=50+Math.cos(g)*he,U=50+Math.sin(g)*he,X=O.id===o.myPlayerId,_=b&&!X&&O.role===_e.WEREWOLF,w=o.tokenHistory.find(Un=>Un.targetPlayerId===O.id),K=Vt.get(O.id),ue=ge.has(O.id),Qe=m&&L!==null&&!X,Tt=lt.get(O.id),Ft=Tt==="happy"?"animate-mood-happy":Tt==="excited"?"animate-mood-excited":Tt==="confused"?"animate-mood-confused":Tt==="sad"?"animate-mood-sad":"";return s.jsx("div",{className:`absolute animate-fade-in-up ${Qe?"cursor-pointer":""}`,style:{left:`${D}%`,top:`${U}%`,transform:"translate(-50%, -50%)",animationDelay:`${h*60}ms`},onClick:()=>Qe&&ae(O.id),children:s.jsxs("div",{className:`flex flex-col items-center relative ${Ft}`,children:[K&&s.jsx(f1,{text:K.text,isNew:ue}),Qe&&s.jsx("div",{className:"absolute inset-0 flex items-center justify-center z-0 pointer-events-none",children:s.jsx("div",{className:"w-[58px] h-[58px] sm:w-[68px] sm:h-[68px] rounded-full border-2 border-amber-400/60 animate-ping-slow"})}),s.jsxs("div",{className:`relative w-12 h-12 sm:w-14 sm:h-14 rounded-full overflow-hidden border-[2.5px] shadow-lg transition-all hover-wiggle cursor-pointer
-                    ${Qe?"border-amber-400 shadow-amber-400/30 scale-110":_?"border-red-500 shadow-red-500/30":X?"border-indigo-400 shadow-indigo-400/20":K?"border-sky-400 shadow-sky-400/20":"border-slate-600/60"}
-                  `,onMouseEnter:()=>{Qe||le.playHoverSqueak()},children:[s.jsx("img",{src:O.avatarUrl,alt:O.name,className:"w-full h-full"}),Qe&&s.jsx("div",{className:"absolute inset-0 bg-amber-400/10 flex items-center justify-center",children:s.jsx("span",{className:"text-amber-300 text-[9px] font-bold uppercase drop-shadow-lg",children:"Tap"})})]}),_&&s.jsx("div",{className:"absolute -top-0.5 -left-0.5 z-20 bg-red-500 text-white rounded-full w-4 h-4 flex items-center justify-center text-[9px] shadow-lg border border-red-400",children:"🐺"}),w&&s.jsx("div",{className:`absolute -bottom-1 -right-1 z-20 ${pe.has(w.id)?"animate-token-flash":""}`,style:{transform:"scale(0.5)"},children:s.jsx(Bn,{type:w.type,size:"sm",disabled:!0})}),s.jsx("span",{className:`text-[9px] font-medium mt-0.5 text-center max-w-[56px] truncate
-                    ${Qe?"text-amber-300 font-semibold":_?"text-red-400 font-semibold":X?"text-indigo-300 font-semibold":"text-slate-400"}
-                  `,children:O.name}),X&&s.jsx("span",{className:"text-[7px] text-indigo-400/50",children:"You"}),_&&s.jsx("span",{className:"text-[7px] text-red-400/60",children:"Ally"})]})},O.id)})]})}),o.tokenHistory.length>0&&s.jsx("div",{className:"shrink-0 mb-1.5",children:s.jsx("div",{className:"bg-slate-900/40 backdrop-blur-md rounded-lg border border-slate-700/30 px-2 py-1.5 overflow-x-auto",children:s.jsx("div",{className:"flex gap-2 items-center",children:o.tokenHistory.slice(0,12).map(O=>{const h=Mu[O.type],g=o.players.find(D=>D.id===O.targetPlayerId);return s.jsxs("div",{className:`flex items-center gap-1 shrink-0 ${pe.has(O.id)?"animate-token-flash":"animate-fade-in-up"}`,children:[s.jsx(Bn,{type:O.type,size:"sm",disabled:!0}),s.jsxs("div",{className:"flex flex-col leading-none",children:[s.jsx("span",{className:`text-[9px] font-bold ${h.color}`,children:h.label}),g&&s.jsx("span",{className:"text-[8px] text-slate-500",children:g.name})]})]},O.id)})})})}),s.jsx("div",{className:"shrink-0",children:m?s.jsxs("div",{className:"px-3 py-2.5 bg-slate-900/50 backdrop-blur-md rounded-xl border border-amber-500/30 shadow-lg",children:[L?s.jsxs("div",{className:"mb-2 flex items-center justify-center gap-2 px-2 py-1 bg-amber-500/10 border border-amber-500/30 rounded-lg",children:[s.jsx(Bn,{type:L,size:"sm",disabled:!0}),s.jsxs("span",{className:"text-amber-300 text-[11px] font-bold",children:[Mu[L].label," — tap a pup above!"]}),s.jsx("button",{onClick:()=>k(null),className:"ml-auto text-slate-400 hover:text-slate-200 text-[10px] px-1 py-0.5 rounded bg-slate-700/50 hover:bg-slate-600/50 transition-colors",children:"✕"})]}):s.jsx("p",{className:"text-center text-slate-500 text-[10px] mb-2",children:"Pick a token, then tap a player"}),s.jsx("div",{className:"flex justify-center items-center gap-2 sm:gap-3",children:[Me.YES,Me.NO,Me.MAYBE,Me.SO_CLOSE,Me.WAY_OFF,Me.CORRECT].map(O=>{const h=L===O,g=O===Me.CORRECT,D=O===Me.YES?"YES":O===Me.NO?"NO":O===Me.MAYBE?"MAYBE":O===Me.SO_CLOSE?"CLOSE":O===Me.WAY_OFF?"FAR":"FOUND!";return s.jsxs("div",{className:"flex flex-col items-center gap-0.5",children:[s.jsx("div",{className:`rounded-full transition-all ${h?g?"ring-2 ring-emerald-400 ring-offset-1 ring-offset-slate-900 scale-110":"ring-2 ring-amber-400 ring-offset-1 ring-offset-slate-900 scale-110":""}`,children:s.jsx(Bn,{type:O,onClick:()=>ke(O)})}),s.jsx("span",{className:`text-[8px] ${h?g?"text-emerald-300 font-bold":"text-amber-400 font-bold":g?"text-emerald-400 font-bold":"text-slate-500"}`,children:D})]},O)})})]}):s.jsx("div",{className:`px-3 py-2 bg-slate-900/50 backdrop-blur-md rounded-xl border shadow-lg transition-all
-            ${se?"border-sky-400/50 animate-guess-whoosh":"border-slate-700/40"}`,children:s.jsxs("div",{className:"flex gap-2 items-center",children:[s.jsx(s1,{size:14,className:`shrink-0 transition-colors ${se?"text-sky-300":"text-sky-400"}`}),s.jsx("input",{ref:ze,type:"text",value:W,onChange:O=>I(O.target.value),onKeyDown:at,placeholder:["What's your guess, pup?","Sniff sniff... is it...?","I think the word is...","My puppy senses say...","*wags tail* Could it be..."][Math.floor(Date.now()/8e3)%5],maxLength:80,className:`flex-1 bg-slate-800/80 border border-slate-600/50 rounded-lg px-2.5 py-2 text-sm text-slate-100
-                  placeholder-slate-500 focus:outline-none focus:border-sky-500/50 focus:ring-1 focus:ring-sky-500/20
-                  transition-all min-w-0`}),s.jsx("button",{onClick:it,disabled:!W.trim(),className:`bg-sky-500/80 hover:bg-sky-500 disabled:bg-slate-700 disabled:text-slate-500
-                  text-white rounded-lg px-2.5 py-2 transition-all active:scale-95 disabled:active:scale-100 shrink-0`,children:s.jsx(i1,{size:15})})]})})})]})},Z0={amber:{hover:"group-hover:border-amber-400 group-hover:shadow-[0_0_15px_rgba(251,191,36,0.3)]",badge:"bg-amber-500",text:"text-amber-400"},red:{hover:"group-hover:border-red-400 group-hover:shadow-[0_0_15px_rgba(239,68,68,0.3)]",badge:"bg-red-500",text:"text-red-400"},purple:{hover:"group-hover:border-purple-400 group-hover:shadow-[0_0_15px_rgba(168,85,247,0.3)]",badge:"bg-purple-500",text:"text-purple-400"}},Nu=({players:o,myPlayerId:f,centerPlayerId:r,centerContent:u,onPlayerClick:m,canClick:x,hoverLabel:b="SELECT",showVotes:B=!1,accentColor:z="amber",tokenHistory:M=[]})=>{const P=r?o.find(I=>I.id===r):null,L=r?o.filter(I=>I.id!==r):o,k=Z0[z]||Z0.amber,W=L.length<=3?30:L.length<=5?34:38;return s.jsxs("div",{className:"relative w-full max-w-[460px] aspect-square mx-auto",children:[s.jsx("div",{className:"absolute inset-[14%] rounded-full border border-dashed border-slate-700/40 pointer-events-none"}),s.jsx("div",{className:"absolute top-1/2 left-1/2 -translate-x-1/2 -translate-y-1/2 z-10",children:P?s.jsxs("div",{className:"flex flex-col items-center animate-fade-in-up",children:[s.jsxs("div",{className:"relative animate-glow rounded-full",children:[s.jsx("div",{className:"w-20 h-20 sm:w-24 sm:h-24 rounded-full border-[3px] border-amber-400 overflow-hidden bg-slate-800",children:s.jsx("img",{src:P.avatarUrl,alt:P.name,className:"w-full h-full"})}),s.jsx("div",{className:"absolute -top-1 -right-1 bg-amber-400 text-slate-900 rounded-full p-1 shadow-lg z-20",children:s.jsx(ul,{size:14,fill:"currentColor"})})]}),s.jsx("span",{className:"text-amber-300 text-xs font-bold mt-1.5",children:P.name}),s.jsx("span",{className:"text-amber-500/50 text-[10px] uppercase tracking-widest font-semibold",children:"Pack Leader"})]}):u}),L.map((I,se)=>{const be=2*Math.PI*se/L.length-Math.PI/2,pe=50+Math.cos(be)*W,V=50+Math.sin(be)*W,G=I.id===f,ce=m!=null&&(x?x(I.id):!0),Ne=M.find(ze=>ze.targetPlayerId===I.id);return s.jsx("div",{className:"absolute group",style:{left:`${pe}%`,top:`${V}%`,transform:"translate(-50%, -50%)",animationDelay:`${se*80}ms`},children:s.jsxs("button",{onClick:()=>ce&&(m==null?void 0:m(I.id)),disabled:!ce,className:`flex flex-col items-center transition-all duration-200 animate-fade-in-up ${ce?"cursor-pointer":"cursor-default"}`,style:{animationDelay:`${se*80}ms`},children:[s.jsxs("div",{className:`relative w-14 h-14 sm:w-[68px] sm:h-[68px] rounded-full overflow-hidden border-[3px] transition-all duration-200 shadow-lg
-                ${G?"border-indigo-400 shadow-indigo-400/20":"border-slate-600/70"}
-                ${ce?`${k.hover} group-hover:scale-110`:""}
-              `,children:[s.jsx("img",{src:I.avatarUrl,alt:I.name,className:"w-full h-full"}),I.isBot&&s.jsx("div",{className:"absolute bottom-0 right-0 bg-slate-800 border border-slate-600 rounded-full p-0.5 z-20",children:s.jsx(Ou,{size:8,className:"text-slate-400"})}),ce&&s.jsx("div",{className:"absolute inset-0 bg-black/60 opacity-0 group-hover:opacity-100 flex items-center justify-center transition-opacity rounded-full",children:s.jsx("span",{className:"text-white text-[10px] font-bold uppercase tracking-wider",children:b})})]}),B&&(I.votesReceived||0)>0&&s.jsx("div",{className:`absolute -top-0.5 -right-0.5 ${k.badge} text-white text-[10px] w-5 h-5 rounded-full flex items-center justify-center font-bold shadow-lg animate-bounce z-20`,children:I.votesReceived}),I.isMayor&&!r&&s.jsx("div",{className:"absolute -top-1 -right-1 bg-amber-400 text-slate-900 rounded-full p-0.5 z-20",children:s.jsx(ul,{size:10,fill:"currentColor"})}),Ne&&s.jsx("div",{className:"absolute -bottom-1.5 -right-1.5 z-20",style:{transform:"scale(0.55)"},children:s.jsx(Bn,{type:Ne.type,size:"sm",disabled:!0})}),s.jsx("span",{className:`text-[11px] font-medium mt-1 text-center max-w-[72px] truncate leading-tight
-                ${G?"text-indigo-300 font-semibold":"text-slate-400"}
-              `,children:I.name}),G&&s.jsx("span",{className:"text-[9px] text-indigo-400/60",children:"You"})]})},I.id)})]})},K0=["🐾","🎾","🦋","⭐","🌸","🧸","💫","🌙","🎀","🌈"],J0=o=>({id:o,emoji:K0[o%K0.length],x:5+(o*67+13)%90,y:8+(o*43+29)%75,speed:15+o%8*4,drift:8+o%5*3,size:18+o%4*4,popped:!1}),h1=()=>s.jsxs("svg",{viewBox:"0 0 100 100",className:"w-20 h-20 sm:w-28 sm:h-28 animate-float drop-shadow-[0_0_25px_rgba(254,243,199,0.3)]",children:[s.jsx("defs",{children:s.jsxs("radialGradient",{id:"mg",cx:"45%",cy:"45%",r:"50%",children:[s.jsx("stop",{offset:"0%",stopColor:"#FEF9C3"}),s.jsx("stop",{offset:"100%",stopColor:"#FDE68A"})]})}),s.jsx("circle",{cx:"50",cy:"50",r:"40",fill:"url(#mg)"}),s.jsx("circle",{cx:"33",cy:"38",r:"7",fill:"#FDE68A",opacity:"0.4"}),s.jsx("circle",{cx:"60",cy:"28",r:"4.5",fill:"#FDE68A",opacity:"0.35"}),s.jsx("circle",{cx:"56",cy:"58",r:"8",fill:"#FDE68A",opacity:"0.25"}),s.jsx("circle",{cx:"38",cy:"47",r:"3.5",fill:"#78350F"}),s.jsx("circle",{cx:"56",cy:"45",r:"3.5",fill:"#78350F"}),s.jsx("circle",{cx:"39.5",cy:"45.5",r:"1.5",fill:"white"}),s.jsx("circle",{cx:"57.5",cy:"43.5",r:"1.5",fill:"white"}),s.jsx("circle",{cx:"29",cy:"54",r:"5",fill:"#FCA5A5",opacity:"0.25"}),s.jsx("circle",{cx:"65",cy:"52",r:"5",fill:"#FCA5A5",opacity:"0.25"}),s.jsx("path",{d:"M 40 55 Q 47 62 54 55",stroke:"#78350F",fill:"none",strokeWidth:"2",strokeLinecap:"round"})]}),Et=({x:o,y:f,h:r,fill:u="#0a1628"})=>{const m=r*.45;return s.jsxs("g",{children:[s.jsx("polygon",{points:`${o},${f-r} ${o-m*.4},${f-r*.5} ${o+m*.4},${f-r*.5}`,fill:u}),s.jsx("polygon",{points:`${o},${f-r*.72} ${o-m*.55},${f-r*.18} ${o+m*.55},${f-r*.18}`,fill:u}),s.jsx("polygon",{points:`${o},${f-r*.48} ${o-m*.65},${f+2} ${o+m*.65},${f+2}`,fill:u}),s.jsx("rect",{x:o-2,y:f-2,width:4,height:5,fill:u})]})},y1=()=>s.jsxs("svg",{viewBox:"0 0 200 70",className:"w-full h-full",children:[s.jsx("ellipse",{cx:"90",cy:"48",rx:"55",ry:"18",fill:"currentColor"}),s.jsx("ellipse",{cx:"130",cy:"42",rx:"38",ry:"14",fill:"currentColor"}),s.jsx("ellipse",{cx:"55",cy:"44",rx:"30",ry:"12",fill:"currentColor"}),s.jsx("circle",{cx:"100",cy:"34",r:"22",fill:"currentColor"}),s.jsx("circle",{cx:"128",cy:"37",r:"16",fill:"currentColor"}),s.jsx("circle",{cx:"68",cy:"38",r:"14",fill:"currentColor"})]}),x1=({variant:o="night"})=>{const[f,r]=Q.useState([]),[u,m]=Q.useState(()=>Array.from({length:8},(V,G)=>J0(G))),x=Q.useRef(8),b=Q.useRef(0),B=Q.useCallback(V=>{m(G=>G.map(ce=>ce.id===V?{...ce,popped:!0}:ce)),setTimeout(()=>{const G=x.current++;m(ce=>ce.map(Ne=>Ne.id===V?J0(G):Ne))},800)},[]),z=Q.useMemo(()=>{switch(o){case"red":return"#f87171";case"purple":return"#c084fc";case"green":return"#4ade80";default:return"#fbbf24"}},[o]),M=Q.useMemo(()=>{switch(o){case"dusk":return"from-indigo-950 via-slate-900 to-amber-950/30";case"red":return"from-red-950/80 via-[#1a0808] to-[#120505]";case"purple":return"from-purple-950/80 via-[#150a25] to-[#100515]";case"green":return"from-emerald-950/50 via-[#051a10] to-[#0a1a15]";default:return"from-indigo-950 via-[#0f1729] to-slate-900"}},[o]),P="#111827",L="#0a0e1a",k="#0a1628",W="#151530",I="#1e1845",se="#0a0a1a",be=Q.useMemo(()=>Array.from({length:55},(V,G)=>({left:(G*73+17)%100,top:(G*41+7)%60,size:G%3*.7+.8,delay:G*.37%5,duration:2+G%4})),[]),pe=Q.useMemo(()=>Array.from({length:10},(V,G)=>({left:15+G*67%70,top:58+G*31%32,delay:G*1.3%8,duration:4+G%5,size:2+G%3})),[]);return s.jsxs(s.Fragment,{children:[s.jsxs("div",{className:"fixed inset-0 pointer-events-none z-0 overflow-hidden",children:[s.jsx("div",{className:`absolute inset-0 bg-gradient-to-b ${M} transition-all duration-[1500ms]`}),be.map((V,G)=>s.jsx("div",{className:"absolute rounded-full bg-white",style:{left:`${V.left}%`,top:`${V.top}%`,width:V.size,height:V.size,animation:`twinkle ${V.duration}s ${V.delay}s ease-in-out infinite`}},`s${G}`)),s.jsx("div",{className:"absolute top-6 sm:top-10 right-[8%] sm:right-[12%] z-[1]",children:s.jsx(h1,{})}),[{top:6,w:180,h:50,dur:50,delay:0,op:.06},{top:16,w:140,h:40,dur:65,delay:12,op:.04},{top:4,w:160,h:45,dur:55,delay:28,op:.055},{top:22,w:100,h:30,dur:75,delay:40,op:.035}].map((V,G)=>s.jsx("div",{className:"absolute text-slate-300",style:{top:`${V.top}%`,left:-V.w,width:V.w,height:V.h,opacity:V.op,animation:`cloud-drift ${V.dur}s ${V.delay}s linear infinite`},children:s.jsx(y1,{})},`cl${G}`)),s.jsx("div",{className:"absolute bottom-0 left-0 right-0 h-[42%] sm:h-[38%]",children:s.jsxs("svg",{viewBox:"0 0 1440 300",preserveAspectRatio:"xMidYMax slice",className:"w-full h-full",xmlns:"http://www.w3.org/2000/svg",children:[s.jsx("path",{d:"M0,190 Q200,155 400,165 Q600,128 720,122 Q900,100 1080,92 Q1280,76 1440,88 L1440,300 L0,300 Z",fill:P,opacity:"0.7"}),s.jsxs("g",{children:[s.jsx("rect",{x:"130",y:"145",width:"44",height:"32",rx:"2",fill:W}),s.jsx("polygon",{points:"125,147 152,116 179,147",fill:I}),s.jsx("rect",{x:"138",y:"153",width:"9",height:"9",rx:"1.5",fill:z,opacity:"0.7",children:s.jsx("animate",{attributeName:"opacity",values:"0.5;0.85;0.5",dur:"3s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"155",y:"153",width:"9",height:"9",rx:"1.5",fill:z,opacity:"0.55",children:s.jsx("animate",{attributeName:"opacity",values:"0.75;0.4;0.75",dur:"4.2s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"148",y:"163",width:"10",height:"14",rx:"1.5",fill:se}),s.jsx("rect",{x:"137",y:"162",width:"11",height:"2",rx:"0.5",fill:"#7c3aed",opacity:"0.25"}),s.jsx("circle",{cx:"139",cy:"161",r:"1.5",fill:"#f472b6",opacity:"0.3"}),s.jsx("circle",{cx:"143",cy:"161",r:"1.5",fill:"#fb923c",opacity:"0.3"}),s.jsx("circle",{cx:"147",cy:"161",r:"1.5",fill:"#f472b6",opacity:"0.3"})]}),s.jsxs("g",{children:[s.jsx("rect",{x:"345",y:"118",width:"55",height:"45",rx:"2",fill:W}),s.jsx("polygon",{points:"338,120 372,80 407,120",fill:I}),s.jsx("rect",{x:"392",y:"83",width:"8",height:"37",fill:W}),s.jsxs("circle",{r:"4",fill:"white",opacity:"0",children:[s.jsx("animate",{attributeName:"cx",values:"396;398",dur:"5s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"cy",values:"76;38",dur:"5s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"r",values:"3;9",dur:"5s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"opacity",values:"0.1;0",dur:"5s",repeatCount:"indefinite"})]}),s.jsxs("circle",{r:"3",fill:"white",opacity:"0",children:[s.jsx("animate",{attributeName:"cx",values:"396;393",dur:"6s",begin:"2s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"cy",values:"73;28",dur:"6s",begin:"2s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"r",values:"2.5;7",dur:"6s",begin:"2s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"opacity",values:"0.07;0",dur:"6s",begin:"2s",repeatCount:"indefinite"})]}),s.jsx("rect",{x:"355",y:"130",width:"10",height:"10",rx:"1.5",fill:z,opacity:"0.7",children:s.jsx("animate",{attributeName:"opacity",values:"0.6;0.9;0.6",dur:"3.5s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"380",y:"130",width:"10",height:"10",rx:"1.5",fill:z,opacity:"0.55",children:s.jsx("animate",{attributeName:"opacity",values:"0.4;0.7;0.4",dur:"4.5s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"363",y:"122",width:"8",height:"6",rx:"1",fill:z,opacity:"0.3",children:s.jsx("animate",{attributeName:"opacity",values:"0.2;0.4;0.2",dur:"5s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"364",y:"145",width:"13",height:"18",rx:"2",fill:se}),s.jsx("circle",{cx:"374",cy:"155",r:"1",fill:z,opacity:"0.4"})]}),s.jsxs("g",{children:[s.jsx("rect",{x:"640",y:"52",width:"50",height:"78",rx:"2",fill:W}),s.jsx("polygon",{points:"650,52 665,12 680,52",fill:I}),s.jsx("line",{x1:"665",y1:"5",x2:"665",y2:"16",stroke:z,strokeWidth:"2.5",opacity:"0.45",children:s.jsx("animate",{attributeName:"opacity",values:"0.3;0.6;0.3",dur:"4s",repeatCount:"indefinite"})}),s.jsx("line",{x1:"660",y1:"10",x2:"670",y2:"10",stroke:z,strokeWidth:"2.5",opacity:"0.45",children:s.jsx("animate",{attributeName:"opacity",values:"0.3;0.6;0.3",dur:"4s",repeatCount:"indefinite"})}),s.jsx("circle",{cx:"665",cy:"75",r:"11",fill:z,opacity:"0.4",children:s.jsx("animate",{attributeName:"opacity",values:"0.3;0.55;0.3",dur:"5s",repeatCount:"indefinite"})}),s.jsx("circle",{cx:"665",cy:"75",r:"8",fill:"none",stroke:W,strokeWidth:"2"}),s.jsx("rect",{x:"648",y:"93",width:"8",height:"12",rx:"1",fill:z,opacity:"0.35",children:s.jsx("animate",{attributeName:"opacity",values:"0.25;0.45;0.25",dur:"4s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"674",y:"93",width:"8",height:"12",rx:"1",fill:z,opacity:"0.35",children:s.jsx("animate",{attributeName:"opacity",values:"0.4;0.25;0.4",dur:"3.5s",repeatCount:"indefinite"})}),s.jsx("path",{d:"M658,130 L658,112 Q665,104 672,112 L672,130 Z",fill:se}),s.jsxs("g",{children:[s.jsx("ellipse",{cx:"677",cy:"42",rx:"5",ry:"6",fill:I}),s.jsx("circle",{cx:"675",cy:"40",r:"2.2",fill:z,opacity:"0.7"}),s.jsx("circle",{cx:"679",cy:"40",r:"2.2",fill:z,opacity:"0.7"}),s.jsx("circle",{cx:"675",cy:"40",r:"1",fill:W}),s.jsx("circle",{cx:"679",cy:"40",r:"1",fill:W}),s.jsx("polygon",{points:"676,42.5 677,44 678,42.5",fill:"#F59E0B"}),s.jsx("polygon",{points:"672,36 674,39.5 675.5,36.5",fill:I}),s.jsx("polygon",{points:"682,36 680,39.5 678.5,36.5",fill:I})]})]}),s.jsxs("g",{children:[s.jsx("rect",{x:"890",y:"78",width:"42",height:"30",rx:"3",fill:W}),s.jsx("path",{d:"M886,80 Q911,50 936,80",fill:I}),s.jsx("rect",{x:"898",y:"86",width:"10",height:"10",rx:"2",fill:z,opacity:"0.6",children:s.jsx("animate",{attributeName:"opacity",values:"0.5;0.8;0.5",dur:"3.8s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"916",y:"86",width:"10",height:"10",rx:"2",fill:z,opacity:"0.45",children:s.jsx("animate",{attributeName:"opacity",values:"0.65;0.35;0.65",dur:"4.3s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"905",y:"96",width:"10",height:"12",rx:"1.5",fill:se}),s.jsx("path",{d:"M922,93 Q922,90 924,88.5 L924.5,87 L925.5,88.5 L926,87 L926,88.5 Q928,90 928,93 Z",fill:se}),s.jsx("rect",{x:"897",y:"96",width:"12",height:"1.5",rx:"0.5",fill:"#7c3aed",opacity:"0.2"})]}),s.jsxs("g",{children:[s.jsx("rect",{x:"1130",y:"52",width:"50",height:"40",rx:"2",fill:W}),s.jsx("polygon",{points:"1124,54 1155,22 1186,54",fill:I}),s.jsx("rect",{x:"1168",y:"26",width:"7",height:"28",fill:W}),s.jsxs("circle",{r:"3",fill:"white",opacity:"0",children:[s.jsx("animate",{attributeName:"cx",values:"1171;1173",dur:"5.5s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"cy",values:"20;-10",dur:"5.5s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"r",values:"2;7",dur:"5.5s",repeatCount:"indefinite"}),s.jsx("animate",{attributeName:"opacity",values:"0.08;0",dur:"5.5s",repeatCount:"indefinite"})]}),s.jsx("rect",{x:"1140",y:"62",width:"9",height:"9",rx:"1.5",fill:z,opacity:"0.6",children:s.jsx("animate",{attributeName:"opacity",values:"0.4;0.75;0.4",dur:"3.2s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"1162",y:"62",width:"9",height:"9",rx:"1.5",fill:z,opacity:"0.5",children:s.jsx("animate",{attributeName:"opacity",values:"0.6;0.35;0.6",dur:"4.8s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"1148",y:"75",width:"12",height:"17",rx:"2",fill:se})]}),s.jsxs("g",{children:[s.jsx("rect",{x:"-5",y:"172",width:"35",height:"24",rx:"2",fill:W}),s.jsx("polygon",{points:"-10,174 12.5,152 35,174",fill:I}),s.jsx("rect",{x:"3",y:"178",width:"7",height:"7",rx:"1",fill:z,opacity:"0.4",children:s.jsx("animate",{attributeName:"opacity",values:"0.3;0.5;0.3",dur:"5s",repeatCount:"indefinite"})})]}),s.jsxs("g",{children:[s.jsx("rect",{x:"1370",y:"80",width:"38",height:"26",rx:"2",fill:W}),s.jsx("polygon",{points:"1365,82 1389,60 1413,82",fill:I}),s.jsx("rect",{x:"1378",y:"88",width:"8",height:"8",rx:"1",fill:z,opacity:"0.5",children:s.jsx("animate",{attributeName:"opacity",values:"0.4;0.6;0.4",dur:"3.6s",repeatCount:"indefinite"})}),s.jsx("rect",{x:"1394",y:"88",width:"8",height:"8",rx:"1",fill:z,opacity:"0.35",children:s.jsx("animate",{attributeName:"opacity",values:"0.5;0.3;0.5",dur:"4.1s",repeatCount:"indefinite"})})]}),s.jsx(Et,{x:55,y:192,h:38,fill:k}),s.jsx(Et,{x:82,y:186,h:30,fill:k}),s.jsx(Et,{x:260,y:168,h:35,fill:k}),s.jsx(Et,{x:290,y:173,h:26,fill:k}),s.jsx(Et,{x:520,y:135,h:42,fill:k}),s.jsx(Et,{x:555,y:140,h:30,fill:k}),s.jsx(Et,{x:790,y:106,h:30,fill:k}),s.jsx(Et,{x:820,y:102,h:24,fill:k}),s.jsx(Et,{x:1040,y:95,h:38,fill:k}),s.jsx(Et,{x:1068,y:99,h:28,fill:k}),s.jsx(Et,{x:1290,y:80,h:34,fill:k}),s.jsx(Et,{x:1316,y:84,h:26,fill:k}),s.jsx(Et,{x:1430,y:86,h:32,fill:k}),s.jsx("path",{d:"M300,178 C400,175 500,160 600,148 C700,136 750,132 800,130 C900,122 1000,118 1100,105",fill:"none",stroke:"#1a1a3a",strokeWidth:"6",strokeLinecap:"round",opacity:"0.15"}),[182,192,202,212,435,445,455,950,960,970].map((V,G)=>{const ce=V<300?170:V<500?157:103;return s.jsxs("g",{opacity:"0.2",children:[s.jsx("rect",{x:V,y:ce,width:"1.5",height:"7",fill:W}),s.jsx("rect",{x:V-2,y:ce+2,width:"6",height:"1",fill:W})]},`fn${G}`)}),s.jsx("path",{d:"M0,228 Q180,215 360,222 Q540,210 720,218 Q900,205 1080,212 Q1260,198 1440,206 L1440,300 L0,300 Z",fill:L}),[50,160,280,370,490,580,670,800,890,1020,1120,1260,1380].map((V,G)=>s.jsxs("g",{opacity:"0.12",children:[s.jsx("line",{x1:V,y1:224+G*7%12,x2:V-2,y2:218+G*7%12,stroke:"#1a3a1a",strokeWidth:"1"}),s.jsx("line",{x1:V+2,y1:224+G*7%12,x2:V+4,y2:217+G*7%12,stroke:"#1a3a1a",strokeWidth:"1"}),s.jsx("line",{x1:V+5,y1:225+G*5%10,x2:V+6,y2:219+G*5%10,stroke:"#1a3a1a",strokeWidth:"1"})]},`gr${G}`))]})}),pe.map((V,G)=>s.jsx("div",{className:"absolute rounded-full",style:{left:`${V.left}%`,top:`${V.top}%`,width:V.size,height:V.size,background:`radial-gradient(circle, ${z} 0%, transparent 70%)`,boxShadow:`0 0 ${V.size*3}px ${z}40`,animation:`firefly-float ${V.duration}s ${V.delay}s ease-in-out infinite`}},`ff${G}`))]}),s.jsxs("div",{className:"fixed inset-0 z-[15] overflow-hidden pointer-events-none",children:[f.map(V=>s.jsx("div",{className:"absolute pointer-events-none animate-paw-stamp",style:{left:V.x,top:V.y,transform:`translate(-50%, -50%) rotate(${V.rotation}deg) scale(${V.scale})`},children:s.jsx("span",{className:"text-2xl opacity-40 drop-shadow-[0_0_6px_rgba(251,191,36,0.3)]",children:"🐾"})},V.id)),u.map(V=>s.jsx("div",{className:`absolute cursor-pointer select-none
-            ${V.popped?"animate-toy-pop":"animate-toy-float hover:scale-125"}`,style:{left:`${V.x}%`,top:`${V.y}%`,fontSize:V.size,pointerEvents:V.popped?"none":"auto",animationDuration:`${V.speed}s`,"--drift":`${V.drift}px`,transition:"transform 0.15s ease"},onClick:G=>{G.stopPropagation();const ce=G.currentTarget.parentElement.getBoundingClientRect(),Ne=G.clientX-ce.left,ze=G.clientY-ce.top,ee=b.current++;r(ge=>[...ge.slice(-12),{id:ee,x:Ne,y:ze,rotation:-30+Math.random()*60,scale:.6+Math.random()*.5}]),setTimeout(()=>r(ge=>ge.filter(Ve=>Ve.id!==ee)),2500),B(V.id)},children:s.jsx("span",{className:"drop-shadow-[0_0_8px_rgba(255,255,255,0.15)]",children:V.emoji})},V.id))]})]})},rl={golden:{fur:"#F5C16C",light:"#FDE8B3",earType:"floppy",earInner:"#E8A844",eyeColor:"#5C3317",eyeStyle:"sparkle",nose:"#3D2614",collar:"#E74C3C",collarTag:"#FFD700",bg:"#FFF5E6",tongue:!0,blush:"#FFADBC",accessory:null},husky:{fur:"#8E99A4",light:"#F0F0F0",earType:"pointy",earInner:"#D4C5B8",eyeColor:"#4FA8D6",eyeStyle:"sparkle",nose:"#4A4A4A",collar:"#3498DB",collarTag:"#C0C0C0",bg:"#E8EDF2",tongue:!1,blush:"#D4A0B0",headPatch:"#F0F0F0",accessory:"star",accColor:"#5DADE2"},corgi:{fur:"#E8A055",light:"#FFF8E7",earType:"pointy",earInner:"#F5C78A",eyeColor:"#4A3728",eyeStyle:"round",nose:"#3D2614",collar:"#2ECC71",collarTag:"#FFD700",bg:"#FFF0DB",tongue:!0,blush:"#FFB3C1",accessory:"heart",accColor:"#FF6B9D"},dalmatian:{fur:"#FFFFFF",light:"#F5F5F5",earType:"floppy",earInner:"#E0D0D0",eyeColor:"#4A3728",eyeStyle:"round",nose:"#1A1A1A",collar:"#E74C3C",collarTag:"#FFD700",bg:"#F0F0F0",tongue:!1,blush:"#E8B0B0",spots:"#333333",accessory:null},poodle:{fur:"#F5F0EB",light:"#E8D5C4",earType:"round",earInner:"#E0CDB8",eyeColor:"#5C3317",eyeStyle:"sparkle",nose:"#D4838F",collar:"#FF69B4",collarTag:"#FFD700",bg:"#FFF0F5",tongue:!1,blush:"#FFB3D0",accessory:"bow",accColor:"#FF69B4"},shiba:{fur:"#D4843E",light:"#FFF5E6",earType:"pointy",earInner:"#F5D0A0",eyeColor:"#3D2614",eyeStyle:"sleepy",nose:"#1A1A1A",collar:"#FF6B35",collarTag:"#FFD700",bg:"#FFF0DB",tongue:!0,blush:"#FFB088",accessory:null},beagle:{fur:"#C4813D",light:"#FFFFFF",earType:"floppy",earInner:"#A86828",eyeColor:"#5C3317",eyeStyle:"round",nose:"#3D2614",collar:"#9B59B6",collarTag:"#C0C0C0",bg:"#FEF0DB",tongue:!1,blush:"#D4A090",headPatch:"#3D2614",accessory:"bandana",accColor:"#9B59B6"},labrador:{fur:"#6B4226",light:"#8B6340",earType:"floppy",earInner:"#5A3520",eyeColor:"#8B7355",eyeStyle:"sparkle",nose:"#2A1A0A",collar:"#E67E22",collarTag:"#FFD700",bg:"#E8D5C4",tongue:!0,blush:"#C49078",accessory:null},samoyed:{fur:"#FFFEF5",light:"#F0EDE4",earType:"pointy",earInner:"#E8E0D4",eyeColor:"#3D2614",eyeStyle:"sparkle",nose:"#1A1A1A",collar:"#1ABC9C",collarTag:"#FFD700",bg:"#F8F8F2",tongue:!0,blush:"#FFBCC8",accessory:"flower",accColor:"#FF9ECF"},collie:{fur:"#2C2C2C",light:"#FFFFFF",earType:"pointy",earInner:"#D4A070",eyeColor:"#5B9BD5",eyeStyle:"sparkle",nose:"#1A1A1A",collar:"#3498DB",collarTag:"#C0C0C0",bg:"#E0E5EA",tongue:!1,blush:"#B8A0B0",headPatch:"#FFFFFF",accessory:"star",accColor:"#3498DB"},frenchie:{fur:"#8E8E8E",light:"#C4C4C4",earType:"bat",earInner:"#B0A0A0",eyeColor:"#5C3317",eyeStyle:"round",nose:"#1A1A1A",collar:"#E91E63",collarTag:"#FFD700",bg:"#E8E8E8",tongue:!0,blush:"#D4A0B0",accessory:"bow",accColor:"#E91E63"},pomeranian:{fur:"#E8A055",light:"#FCD5A0",earType:"pointy",earInner:"#D48840",eyeColor:"#3D2614",eyeStyle:"sparkle",nose:"#3D2614",collar:"#FF69B4",collarTag:"#FFD700",bg:"#FFF0DB",tongue:!1,blush:"#FFB8C8",accessory:"flower",accColor:"#FFB6D9"},dachshund:{fur:"#8B4513",light:"#C68642",earType:"floppy",earInner:"#6B3510",eyeColor:"#5C3317",eyeStyle:"round",nose:"#3D2614",collar:"#2ECC71",collarTag:"#FFD700",bg:"#EBD8C4",tongue:!1,blush:"#C4907A",accessory:"bandana",accColor:"#27AE60"},aussie:{fur:"#6B7B8D",light:"#C4B19A",earType:"pointy",earInner:"#8B9BAA",eyeColor:"#5B9BD5",eyeStyle:"sparkle",nose:"#3D2614",collar:"#9B59B6",collarTag:"#C0C0C0",bg:"#DDE3EA",tongue:!1,blush:"#B0A0B4",spots:"#4A5568",accessory:"heart",accColor:"#AF7AC5"},bernese:{fur:"#1A1A1A",light:"#D4843E",earType:"floppy",earInner:"#3A2A2A",eyeColor:"#5C3317",eyeStyle:"sparkle",nose:"#1A1A1A",collar:"#E74C3C",collarTag:"#FFD700",bg:"#E0D5CC",tongue:!0,blush:"#C49070",headPatch:"#FFFFFF",accessory:null},chihuahua:{fur:"#E8CBA5",light:"#F5E6D3",earType:"bat",earInner:"#D4B090",eyeColor:"#3D2614",eyeStyle:"round",nose:"#D4838F",collar:"#FF69B4",collarTag:"#FFD700",bg:"#FFF5EB",tongue:!1,blush:"#FFBCC8",accessory:"bow",accColor:"#FF69B4"},blackLab:{fur:"#222222",light:"#555555",earType:"floppy",earInner:"#333333",eyeColor:"#C6975B",eyeStyle:"sparkle",nose:"#111111",collar:"#FFD700",collarTag:"#FFD700",bg:"#F0EBE5",tongue:!0,blush:"#8B6060",accessory:null},rottweiler:{fur:"#2A2A2A",light:"#C87533",earType:"floppy",earInner:"#3A2A1A",eyeColor:"#8B6840",eyeStyle:"round",nose:"#111111",collar:"#C0392B",collarTag:"#C0C0C0",bg:"#EDE5DB",tongue:!1,blush:"#8B6050",headPatch:"#C87533",accessory:"star",accColor:"#C0392B"},germanShep:{fur:"#8B6914",light:"#D4A44A",earType:"pointy",earInner:"#705010",eyeColor:"#3D2614",eyeStyle:"sparkle",nose:"#1A1A1A",collar:"#2C3E50",collarTag:"#C0C0C0",bg:"#EDE0C8",tongue:!0,blush:"#B09060",headPatch:"#1A1A1A",accessory:null},pug:{fur:"#D4B896",light:"#E8D5BA",earType:"floppy",earInner:"#5C3317",eyeColor:"#1A1A1A",eyeStyle:"round",nose:"#1A1A1A",collar:"#FF69B4",collarTag:"#FFD700",bg:"#F5EDE0",tongue:!0,blush:"#D4A090",accessory:"bow",accColor:"#FF69B4"},boxer:{fur:"#B87333",light:"#FFFFFF",earType:"floppy",earInner:"#8B5A2B",eyeColor:"#3D2614",eyeStyle:"sparkle",nose:"#1A1A1A",collar:"#E74C3C",collarTag:"#FFD700",bg:"#F0DCC8",tongue:!0,blush:"#C49070",headPatch:"#FFFFFF",accessory:null},yorkie:{fur:"#4A3728",light:"#B8956A",earType:"pointy",earInner:"#6B4F38",eyeColor:"#1A1A1A",eyeStyle:"sparkle",nose:"#3D2614",collar:"#E91E63",collarTag:"#FFD700",bg:"#E8D5C0",tongue:!1,blush:"#A08070",accessory:"bow",accColor:"#E91E63"},maltese:{fur:"#FFFFFF",light:"#F8F4F0",earType:"round",earInner:"#F0E8E0",eyeColor:"#1A1A1A",eyeStyle:"sparkle",nose:"#3D2614",collar:"#FF69B4",collarTag:"#FFD700",bg:"#FFF8F5",tongue:!1,blush:"#FFB8D0",accessory:"flower",accColor:"#FFB6D9"},doberman:{fur:"#252525",light:"#B8763D",earType:"pointy",earInner:"#3A2A1A",eyeColor:"#8B6840",eyeStyle:"sparkle",nose:"#111111",collar:"#2C3E50",collarTag:"#C0C0C0",bg:"#E8E0D8",tongue:!1,blush:"#7A5545",headPatch:"#B8763D",accessory:"star",accColor:"#2C3E50"},scottieTerrier:{fur:"#282828",light:"#4A4A4A",earType:"pointy",earInner:"#383838",eyeColor:"#8B7355",eyeStyle:"round",nose:"#111111",collar:"#E74C3C",collarTag:"#FFD700",bg:"#EAE4DE",tongue:!1,blush:"#7A5555",accessory:"bandana",accColor:"#E74C3C"},cockerSpaniel:{fur:"#C6883D",light:"#E8C48A",earType:"floppy",earInner:"#A06820",eyeColor:"#3D2614",eyeStyle:"sparkle",nose:"#3D2614",collar:"#9B59B6",collarTag:"#FFD700",bg:"#F5E8D0",tongue:!0,blush:"#D4A878",accessory:"flower",accColor:"#AF7AC5"},papillon:{fur:"#FFFFFF",light:"#F5F0EB",earType:"bat",earInner:"#D4843E",eyeColor:"#3D2614",eyeStyle:"sparkle",nose:"#3D2614",collar:"#FF69B4",collarTag:"#FFD700",bg:"#FFF5F0",tongue:!1,blush:"#FFB8C8",spots:"#D4843E",accessory:"heart",accColor:"#FF6B9D"},stBernard:{fur:"#A0522D",light:"#FFFFFF",earType:"floppy",earInner:"#7B3F1A",eyeColor:"#5C3317",eyeStyle:"sleepy",nose:"#3D2614",collar:"#E74C3C",collarTag:"#FFD700",bg:"#EAD8C4",tongue:!0,blush:"#C49070",headPatch:"#FFFFFF",accessory:"bandana",accColor:"#E74C3C"},shihtzu:{fur:"#F5E6D3",light:"#FFFFFF",earType:"round",earInner:"#E0D0C0",eyeColor:"#1A1A1A",eyeStyle:"round",nose:"#3D2614",collar:"#FF69B4",collarTag:"#FFD700",bg:"#FFF8F2",tongue:!1,blush:"#FFB0C0",accessory:"bow",accColor:"#FF69B4"},greatDane:{fur:"#5C6B7A",light:"#8899AA",earType:"floppy",earInner:"#4A5A6A",eyeColor:"#5C3317",eyeStyle:"sparkle",nose:"#1A1A1A",collar:"#3498DB",collarTag:"#C0C0C0",bg:"#DDE3EA",tongue:!1,blush:"#8090A0",accessory:null},blackPug:{fur:"#2A2A2A",light:"#4A4A4A",earType:"floppy",earInner:"#1A1A1A",eyeColor:"#C6975B",eyeStyle:"round",nose:"#111111",collar:"#FF69B4",collarTag:"#FFD700",bg:"#EDE8E3",tongue:!0,blush:"#6B5050",accessory:"bow",accColor:"#FF69B4"},akita:{fur:"#D48840",light:"#FFF5E6",earType:"pointy",earInner:"#C07030",eyeColor:"#3D2614",eyeStyle:"sleepy",nose:"#1A1A1A",collar:"#E74C3C",collarTag:"#FFD700",bg:"#FFF0DB",tongue:!1,blush:"#D4A070",headPatch:"#FFFFFF",accessory:"bandana",accColor:"#E74C3C"}};function ad(o){const{fur:f,light:r,earType:u,earInner:m,eyeColor:x,eyeStyle:b,nose:B,collar:z,collarTag:M,bg:P,tongue:L,blush:k,spots:W,headPatch:I,accessory:se,accColor:be}=o;let pe="";u==="floppy"?pe=`
-      <g>
-        <animateTransform attributeName="transform" type="rotate" values="0 20 35;-14 20 35;0 20 35;12 20 35;0 20 35;-8 20 35;0 20 35" dur="1.2s" repeatCount="indefinite" />
-        <ellipse cx="20" cy="40" rx="14" ry="22" fill="${f}" transform="rotate(-20 20 40)" />
-        <ellipse cx="20" cy="42" rx="9" ry="16" fill="${m}" transform="rotate(-20 20 42)" opacity="0.5" />
-      </g>
-      <g>
-        <animateTransform attributeName="transform" type="rotate" values="0 80 35;12 80 35;0 80 35;-14 80 35;0 80 35;8 80 35;0 80 35" dur="1.3s" repeatCount="indefinite" />
-        <ellipse cx="80" cy="40" rx="14" ry="22" fill="${f}" transform="rotate(20 80 40)" />
-        <ellipse cx="80" cy="42" rx="9" ry="16" fill="${m}" transform="rotate(20 80 42)" opacity="0.5" />
-      </g>`:u==="pointy"?pe=`
-      <g>
-        <animateTransform attributeName="transform" type="rotate" values="0 26 44;-10 26 44;0 26 44;0 26 44;8 26 44;0 26 44;0 26 44" dur="1.5s" repeatCount="indefinite" />
-        <polygon points="26,14 10,44 42,44" fill="${f}" />
-        <polygon points="26,22 16,41 36,41" fill="${m}" opacity="0.5" />
-      </g>
-      <g>
-        <animateTransform attributeName="transform" type="rotate" values="0 74 44;8 74 44;0 74 44;0 74 44;-10 74 44;0 74 44;0 74 44" dur="1.6s" repeatCount="indefinite" />
-        <polygon points="74,14 58,44 90,44" fill="${f}" />
-        <polygon points="74,22 64,41 84,41" fill="${m}" opacity="0.5" />
-      </g>`:u==="bat"?pe=`
-      <g>
-        <animateTransform attributeName="transform" type="rotate" values="0 22 44;-12 22 44;2 22 44;-8 22 44;0 22 44" dur="1s" repeatCount="indefinite" />
-        <polygon points="22,10 2,42 42,44" fill="${f}" />
-        <polygon points="22,20 10,40 34,42" fill="${m}" opacity="0.5" />
-      </g>
-      <g>
-        <animateTransform attributeName="transform" type="rotate" values="0 78 44;12 78 44;-2 78 44;8 78 44;0 78 44" dur="1.1s" repeatCount="indefinite" />
-        <polygon points="78,10 98,42 58,44" fill="${f}" />
-        <polygon points="78,20 90,40 66,42" fill="${m}" opacity="0.5" />
-      </g>`:pe=`
-      <g>
-        <animateTransform attributeName="transform" type="translate" values="0,0;-4,-3;0,0;3,2;0,0;-2,-1;0,0" dur="1.4s" repeatCount="indefinite" />
-        <circle cx="18" cy="42" r="16" fill="${f}" />
-        <circle cx="18" cy="42" r="10" fill="${m}" opacity="0.35" />
-        <circle cx="14" cy="36" r="5" fill="${f}" opacity="0.6" />
-        <circle cx="24" cy="36" r="4" fill="${f}" opacity="0.5" />
-      </g>
-      <g>
-        <animateTransform attributeName="transform" type="translate" values="0,0;4,-3;0,0;-3,2;0,0;2,-1;0,0" dur="1.5s" repeatCount="indefinite" />
-        <circle cx="82" cy="42" r="16" fill="${f}" />
-        <circle cx="82" cy="42" r="10" fill="${m}" opacity="0.35" />
-        <circle cx="86" cy="36" r="5" fill="${f}" opacity="0.6" />
-        <circle cx="76" cy="36" r="4" fill="${f}" opacity="0.5" />
-      </g>`;let V="";W&&(V=`
-      <circle cx="34" cy="47" r="4.5" fill="${W}" opacity="0.55" />
-      <circle cx="68" cy="55" r="3.5" fill="${W}" opacity="0.55" />
-      <circle cx="57" cy="43" r="2.5" fill="${W}" opacity="0.4" />
-      <circle cx="41" cy="62" r="2" fill="${W}" opacity="0.35" />`);let G="";I&&(G=`<path d="M 42 38 Q 50 30 58 38" fill="${I}" opacity="0.7" />`);const ce=L?`
-      <ellipse cx="50" cy="74" rx="4.5" ry="7" fill="#FF8FAA">
-        <animate attributeName="ry" values="7;6;7" dur="2s" repeatCount="indefinite" />
-      </ellipse>
-      <ellipse cx="50" cy="72" rx="3.5" ry="3.5" fill="#FFa0B8" />
-      <line x1="50" y1="69" x2="50" y2="78" stroke="#F07090" stroke-width="0.6" opacity="0.3" />`:"";let Ne="";b==="sparkle"?Ne=`
-      <!-- Big sparkly eyes -->
-      <ellipse cx="37" cy="52" rx="8.5" ry="9" fill="white" />
-      <ellipse cx="63" cy="52" rx="8.5" ry="9" fill="white" />
-      <circle cx="38" cy="51" r="6" fill="${x}" />
-      <circle cx="64" cy="51" r="6" fill="${x}" />
-      <!-- Big sparkle highlights -->
-      <circle cx="35" cy="48" r="3" fill="white" />
-      <circle cx="61" cy="48" r="3" fill="white" />
-      <!-- Small sparkle -->
-      <circle cx="40" cy="53" r="1.3" fill="white" opacity="0.7" />
-      <circle cx="66" cy="53" r="1.3" fill="white" opacity="0.7" />
-      <!-- Twinkle star in eye -->
-      <path d="M 35 48 L 35.5 46.5 L 36 48 L 37.5 48.5 L 36 49 L 35.5 50.5 L 35 49 L 33.5 48.5 Z" fill="white" opacity="0.5">
-        <animate attributeName="opacity" values="0.5;0.9;0.5" dur="2s" repeatCount="indefinite" />
-      </path>`:b==="sleepy"?Ne=`
-      <!-- Sleepy/happy squinting eyes -->
-      <ellipse cx="37" cy="52" rx="8" ry="7" fill="white" />
-      <ellipse cx="63" cy="52" rx="8" ry="7" fill="white" />
-      <circle cx="38" cy="52" r="5.5" fill="${x}" />
-      <circle cx="64" cy="52" r="5.5" fill="${x}" />
-      <circle cx="36" cy="49" r="2.5" fill="white" />
-      <circle cx="62" cy="49" r="2.5" fill="white" />
-      <!-- Slightly closed upper lids -->
-      <path d="M 29 49 Q 37 46 45 49" fill="${f}" opacity="0.5" />
-      <path d="M 55 49 Q 63 46 71 49" fill="${f}" opacity="0.5" />`:Ne=`
-      <!-- Round cute eyes -->
-      <ellipse cx="37" cy="52" rx="8" ry="8.5" fill="white" />
-      <ellipse cx="63" cy="52" rx="8" ry="8.5" fill="white" />
-      <circle cx="38" cy="51.5" r="5.5" fill="${x}" />
-      <circle cx="64" cy="51.5" r="5.5" fill="${x}" />
-      <circle cx="36" cy="48.5" r="2.8" fill="white" />
-      <circle cx="62" cy="48.5" r="2.8" fill="white" />
-      <circle cx="40" cy="52.5" r="1" fill="white" opacity="0.6" />
-      <circle cx="66" cy="52.5" r="1" fill="white" opacity="0.6" />`;const ze=`
-    <path d="M 29 44 Q 37 40 45 44" fill="none" stroke="${f}" stroke-width="1.5" stroke-linecap="round" opacity="0.35" />
-    <path d="M 55 44 Q 63 40 71 44" fill="none" stroke="${f}" stroke-width="1.5" stroke-linecap="round" opacity="0.35" />`,ee=L?`<path d="M 43 66 Q 46 70 50 68 Q 54 70 57 66" fill="none" stroke="${B}" stroke-width="1.3" stroke-linecap="round" />`:`<path d="M 43 66 Q 50 73 57 66" fill="none" stroke="${B}" stroke-width="1.3" stroke-linecap="round" />`;let ge="";se==="bow"?ge=`
-      <g transform="translate(76, 28) rotate(15)">
-        <path d="M -6,-3 Q -3,-8 0,-3 Q 3,-8 6,-3 Q 3,2 0,-0.5 Q -3,2 -6,-3" fill="${be}" />
-        <circle cx="0" cy="-3" r="1.5" fill="white" opacity="0.4" />
-      </g>`:se==="flower"?ge=`
-      <g transform="translate(78, 28)">
-        <circle cx="0" cy="-3" r="3" fill="${be}" opacity="0.8" />
-        <circle cx="3" cy="0" r="3" fill="${be}" opacity="0.7" />
-        <circle cx="0" cy="3" r="3" fill="${be}" opacity="0.8" />
-        <circle cx="-3" cy="0" r="3" fill="${be}" opacity="0.7" />
-        <circle cx="0" cy="0" r="2" fill="#FFE66D" />
-      </g>`:se==="bandana"?ge=`
-      <path d="M 30 78 L 50 88 L 70 78" fill="${be}" opacity="0.7" />
-      <path d="M 42 82 L 50 92 L 58 82" fill="${be}" opacity="0.5" />`:se==="star"?ge=`
-      <g transform="translate(78, 26)">
-        <path d="M 0,-5 L 1.5,-1.5 L 5.5,-1.5 L 2.5,1 L 3.5,5 L 0,2.5 L -3.5,5 L -2.5,1 L -5.5,-1.5 L -1.5,-1.5 Z" fill="${be}" opacity="0.8">
-          <animate attributeName="opacity" values="0.8;1;0.8" dur="2s" repeatCount="indefinite" />
-        </path>
-      </g>`:se==="heart"&&(ge=`
-      <g transform="translate(78, 26) scale(0.6)">
-        <path d="M 0,3 C -5,-5 -12,-2 -8,4 L 0,12 L 8,4 C 12,-2 5,-5 0,3" fill="${be}" opacity="0.8">
-          <animate attributeName="transform" type="scale" values="1;1.1;1" dur="1.5s" repeatCount="indefinite" />
-        </path>
-      </g>`);const Ve=`
-    <line x1="20" y1="62" x2="32" y2="60" stroke="${f}" stroke-width="0.5" opacity="0.2" />
-    <line x1="20" y1="65" x2="32" y2="64" stroke="${f}" stroke-width="0.5" opacity="0.15" />
-    <line x1="80" y1="62" x2="68" y2="60" stroke="${f}" stroke-width="0.5" opacity="0.2" />
-    <line x1="80" y1="65" x2="68" y2="64" stroke="${f}" stroke-width="0.5" opacity="0.15" />`;return`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
-    <circle cx="50" cy="50" r="49" fill="${P}" />
-    ${pe}
-    <!-- Head -->
-    <ellipse cx="50" cy="56" rx="32" ry="30" fill="${f}" />
-    ${V}
-    ${G}
-    <!-- Muzzle -->
-    <ellipse cx="50" cy="63" rx="19" ry="16" fill="${r}" />
-    ${Ne}
-    ${ze}
-    <!-- Nose -->
-    <ellipse cx="50" cy="61" rx="6" ry="4.5" fill="${B}" />
-    <ellipse cx="48" cy="59.5" rx="2.2" ry="1.3" fill="white" opacity="0.25" />
-    <!-- Mouth -->
-    ${ee}
-    ${ce}
-    ${Ve}
-    <!-- Blush -->
-    <circle cx="27" cy="62" r="6" fill="${k}" opacity="0.3" />
-    <circle cx="73" cy="62" r="6" fill="${k}" opacity="0.3" />
-    <!-- Collar -->
-    <path d="M 24 82 Q 50 91 76 82" fill="none" stroke="${z}" stroke-width="5.5" stroke-linecap="round" />
-    <circle cx="50" cy="87" r="3.8" fill="${M}" stroke="#DAA520" stroke-width="0.5">
-      <animate attributeName="r" values="3.8;4.2;3.8" dur="3s" repeatCount="indefinite" />
-    </circle>
-    <circle cx="49" cy="86" r="1.2" fill="white" opacity="0.4" />
-    ${ge}
-  </svg>`}function p1(o){const f=rl[o]||rl.golden,r=ad(f);return`data:image/svg+xml;charset=utf-8,${encodeURIComponent(r)}`}function g1(o){const f=rl[o]||rl.golden;return ad(f)}const v1=Object.keys(rl);function b1(o){return(rl[o]||rl.golden).earType}function j1(o){return(rl[o]||rl.golden).tongue}const Cu={golden:"Golden",husky:"Husky",corgi:"Corgi",dalmatian:"Dalmatian",poodle:"Poodle",shiba:"Shiba",beagle:"Beagle",labrador:"Labrador",samoyed:"Samoyed",collie:"Collie",frenchie:"Frenchie",pomeranian:"Pom",dachshund:"Dachshund",aussie:"Aussie",bernese:"Bernese",chihuahua:"Chihuahua",blackLab:"Black Lab",rottweiler:"Rottweiler",germanShep:"Shepherd",pug:"Pug",boxer:"Boxer",yorkie:"Yorkie",maltese:"Maltese",doberman:"Doberman",scottieTerrier:"Scottie",cockerSpaniel:"Cocker",papillon:"Papillon",stBernard:"St. Bernard",shihtzu:"Shih Tzu",greatDane:"Great Dane",blackPug:"Black Pug",akita:"Akita"},S1=["🤨","😂","😱","🤔","❤️","🐾","🐺","🦴"],W0={"First Blood":{icon:"🎯",label:"First Blood"},Sherlock:{icon:"🔍",label:"Sherlock"},"Master of Disguise":{icon:"🎭",label:"Master of Disguise"},"Pack Leader":{icon:"👑",label:"Pack Leader"},"Eagle Eye":{icon:"🦅",label:"Eagle Eye"},"Howl at the Moon":{icon:"🌙",label:"Howl at the Moon"}},E1={EASY:{label:"Puppy",emoji:"🐶",color:"from-green-500 to-emerald-500"},MEDIUM:{label:"Good Boy",emoji:"🐕",color:"from-amber-500 to-orange-500"},HARD:{label:"Alpha Wolf",emoji:"🐺",color:"from-red-500 to-pink-500"}},$0=v1,zu=o=>p1(o),T1=({variant:o="gold"})=>{const f=Q.useMemo(()=>{const r=o==="gold"?["#fbbf24","#f59e0b","#22c55e","#3b82f6","#a855f7","#ec4899","#fef3c7","#f97316"]:["#94a3b8","#64748b","#475569","#6366f1","#8b5cf6","#a78bfa"];return Array.from({length:50},(u,m)=>({left:(m*31+7)%100,delay:m*.05%2.5,duration:1.5+m%5,color:r[m%r.length],size:3+m%6,shape:m%3}))},[o]);return s.jsx("div",{className:"fixed inset-0 pointer-events-none z-50 overflow-hidden",children:f.map((r,u)=>s.jsx("div",{className:"absolute",style:{left:`${r.left}%`,top:"-10px",width:r.size,height:r.shape===2?r.size*2.5:r.size*1.2,backgroundColor:r.color,borderRadius:r.shape===1?"50%":"1px",animation:`confetti-fall ${r.duration}s ${r.delay}s linear forwards`}},u))})},A1=({color:o="from-white/20"})=>s.jsx("div",{className:`fixed inset-0 z-[55] pointer-events-none bg-gradient-to-b ${o} to-transparent animate-phase-flash`}),N1=()=>{const o=Q.useRef(0);return Q.useEffect(()=>{const f=r=>{const u=Date.now();if(u-o.current<200)return;o.current=u;const m=document.createElement("div");m.className="paw-trail",m.textContent="🐾",m.style.left=`${r.clientX}px`,m.style.top=`${r.clientY}px`,m.style.setProperty("--paw-rot",`${Math.random()*40-20}deg`),document.body.appendChild(m),setTimeout(()=>m.remove(),800)};return window.addEventListener("mousemove",f),()=>window.removeEventListener("mousemove",f)},[]),null},C1=()=>{const o=Q.useMemo(()=>Array.from({length:20},(f,r)=>({left:Math.random()*100,top:Math.random()*100,mx:(Math.random()-.5)*40,my:-(Math.random()*30+10),delay:Math.random()*4,duration:3+Math.random()*3,size:3+Math.random()*5,emoji:["✨","🌟","⭐","💫"][r%4]})),[]);return s.jsx("div",{className:"fixed inset-0 pointer-events-none z-[12] overflow-hidden",children:o.map((f,r)=>s.jsx("div",{className:"absolute text-xs",style:{left:`${f.left}%`,top:`${f.top}%`,"--mx":`${f.mx}px`,"--my":`${f.my}px`,animation:`mystic-float ${f.duration}s ${f.delay}s ease-in-out infinite`},children:f.emoji},r))})},z1=({emoji:o,x:f,y:r,id:u})=>s.jsx("div",{className:"fixed z-[60] pointer-events-none animate-reaction-float text-3xl",style:{left:f,top:r,transform:"translate(-50%, 0)"},children:o==="BARK"?"🔊":o},u),M1=({onReaction:o})=>{const[f,r]=Q.useState(!1);return s.jsxs("div",{className:"fixed bottom-4 left-4 z-50 flex items-end gap-2",children:[s.jsx("button",{onClick:()=>r(!f),className:"w-10 h-10 rounded-full bg-slate-800/80 backdrop-blur-sm border border-slate-700/50 flex items-center justify-center text-lg hover:scale-110 transition-all shadow-lg",title:"Reactions",children:"😄"}),f&&s.jsxs("div",{className:"flex gap-1.5 bg-slate-900/90 backdrop-blur-xl rounded-2xl px-3 py-2 border border-slate-700/50 shadow-xl animate-fade-in-up",children:[S1.map(u=>s.jsx("button",{onClick:()=>{o(u),le.playClick()},className:"text-xl hover:scale-125 transition-transform p-1",children:u},u)),s.jsx("div",{className:"w-px bg-slate-700/50 mx-1"}),s.jsx("button",{onClick:()=>{o("BARK"),le.playBark()},className:"text-sm px-2.5 py-1 bg-amber-500/20 text-amber-400 rounded-full font-bold hover:bg-amber-500/30 transition-all",children:"BARK!"})]})]})},I0=[{text:"In the Puppy Kingdom, every pup was happy...",emoji:"🏰",delay:0},{text:"...playing fetch under the golden sun.",emoji:"☀️",delay:1.5},{text:"But one night, the moon turned red.",emoji:"🌙",delay:4},{text:"Some pups began to howl differently...",emoji:"🐺",delay:7},{text:"...their eyes glowed in the dark.",emoji:"👀",delay:9},{text:"WerePups walked among the good pups!",emoji:"😱",delay:11.5},{text:"Now the pack must sniff out the truth.",emoji:"🐾",delay:14},{text:"Can you tell friend from foe?",emoji:"🔍",delay:16.5},{text:"Trust no tail wag. Question every bark.",emoji:"❓",delay:19},{text:"The Puppy Kingdom depends on you!",emoji:"💛",delay:22}],R1=()=>{const o=Q.useMemo(()=>I0.map((u,m)=>({...u,left:8+(m*37+11)%75,top:10+(m*29+5)%65,fontSize:m===0||m===I0.length-1?15:12,totalDur:26})),[]),f=Q.useMemo(()=>[{x:8,y:72,size:50,content:"💤",delay:1},{x:85,y:18,size:28,content:"🦴",delay:3},{x:15,y:30,size:22,content:"🐾",delay:0},{x:22,y:26,size:20,content:"🐾",delay:.5},{x:29,y:30,size:18,content:"🐾",delay:1},{x:75,y:68,size:36,content:"🏠",delay:2},{x:88,y:8,size:40,content:"🌕",delay:0},{x:45,y:78,size:24,content:"🌸",delay:4},{x:60,y:72,size:32,content:"🌳",delay:1.5},{x:35,y:12,size:18,content:"⭐",delay:2.5},{x:55,y:8,size:14,content:"✨",delay:3.5},{x:70,y:15,size:16,content:"⭐",delay:1}],[]);return s.jsxs("div",{className:"fixed inset-0 z-[8] pointer-events-none overflow-hidden",children:[s.jsx("div",{className:"absolute inset-0 bg-[radial-gradient(ellipse_at_center,rgba(251,191,36,0.03)_0%,transparent_70%)]"}),f.map((r,u)=>s.jsx("div",{className:"absolute animate-float",style:{left:`${r.x}%`,top:`${r.y}%`,fontSize:r.size,opacity:.08,animationDelay:`${r.delay}s`,animationDuration:`${4+u%3}s`},children:r.content},`ill-${u}`)),o.map((r,u)=>s.jsx("div",{className:"absolute",style:{left:`${r.left}%`,top:`${r.top}%`,maxWidth:"260px",animation:`lore-text-cycle ${r.totalDur}s ${r.delay}s ease-in-out infinite`,opacity:0},children:s.jsxs("div",{className:"flex items-center gap-2",children:[s.jsx("span",{style:{fontSize:r.fontSize+4},children:r.emoji}),s.jsx("p",{className:"text-slate-400/30 font-serif italic leading-snug",style:{fontSize:r.fontSize},children:r.text})]})},`lore-${u}`)),s.jsx("div",{className:"absolute bottom-[15%] left-0 w-full",children:s.jsx("div",{className:"flex items-center gap-6",style:{animation:"paw-walk 20s linear infinite"},children:Array.from({length:12},(r,u)=>s.jsx("span",{className:"text-amber-400/5 inline-block",style:{fontSize:16+u%3*4,transform:`rotate(${u%2===0?-15:15}deg)`},children:"🐾"},`pw-${u}`))})})]})},O1=()=>{const[o,f]=Q.useState(le.muted);return s.jsx("button",{onClick:()=>f(le.toggleMute()),className:"fixed top-4 right-4 z-50 p-2.5 bg-slate-800/60 backdrop-blur-sm border border-slate-700/50 rounded-full hover:bg-slate-700/60 transition-all group",title:o?"Unmute":"Mute",children:o?s.jsx(l1,{size:18,className:"text-slate-500 group-hover:text-slate-300"}):s.jsx(t1,{size:18,className:"text-amber-400/80 group-hover:text-amber-300"})})},D1=({players:o,myPlayerId:f})=>{const r=Q.useMemo(()=>[...o].sort((x,b)=>b.score-x.score),[o]),u=r.some(x=>x.score>0),m=["text-amber-400","text-slate-300","text-amber-600"];return s.jsxs("div",{className:"w-full bg-slate-900/50 backdrop-blur-md rounded-2xl border border-slate-700/40 overflow-hidden",children:[s.jsxs("div",{className:"px-4 py-2.5 border-b border-slate-700/40 flex items-center gap-2",children:[s.jsx(td,{size:14,className:"text-amber-400"}),s.jsx("span",{className:"text-xs font-bold text-amber-400 uppercase tracking-widest",children:"Leaderboard"})]}),s.jsx("div",{className:"p-2 space-y-1 max-h-52 overflow-y-auto",children:r.map((x,b)=>{const B=x.id===f;return s.jsxs("div",{className:`flex items-center gap-2.5 px-3 py-2 rounded-xl transition-all ${B?"bg-indigo-500/10 border border-indigo-500/20":"bg-slate-800/30"}`,children:[s.jsx("div",{className:"w-6 text-center shrink-0",children:u&&b<3&&x.score>0?s.jsx(a1,{size:16,className:m[b]}):s.jsx("span",{className:"text-[10px] text-slate-600 font-mono",children:b+1})}),s.jsx("img",{src:x.avatarUrl,alt:x.name,className:"w-8 h-8 rounded-full border-2 border-slate-700 shrink-0"}),s.jsxs("span",{className:`flex-1 text-sm font-medium truncate ${B?"text-indigo-300":"text-slate-300"}`,children:[x.name,x.isBot&&s.jsx("span",{className:"ml-1 text-[9px] text-slate-600",children:"BOT"})]}),s.jsxs("div",{className:"flex items-center gap-1 shrink-0",children:[s.jsx(es,{size:12,className:"text-amber-400/70"}),s.jsx("span",{className:`text-sm font-bold tabular-nums ${x.score>0?"text-amber-400":"text-slate-600"}`,children:x.score})]})]},x.id)})})]})},_1=()=>{const[o,f]=Q.useState({phase:q.LOGIN,roomCode:"",players:[],secretWord:"",timeRemaining:0,tokensUsed:0,tokenHistory:[],guesses:[],myPlayerId:null}),[r,u]=Q.useState(""),[m,x]=Q.useState($0[0]),[b,B]=Q.useState(!1),[z,M]=Q.useState(!1),[P,L]=Q.useState([]),[k,W]=Q.useState(!1),[I,se]=Q.useState([]),be=Q.useRef(0),[pe,V]=Q.useState(!1),G=Q.useRef(o.phase),ce=Q.useRef(0),[Ne,ze]=Q.useState(!1),[ee,ge]=Q.useState("from-white/20");Q.useEffect(()=>{const h=Fe.subscribe(f),g=Fe.onRoomList(L),D=Fe.onReaction(U=>{U.emoji==="BARK"?le.playBark():le.playReactionPop();const X=window.innerWidth*.3+Math.random()*window.innerWidth*.4,_=window.innerHeight*.3+Math.random()*window.innerHeight*.2,w=be.current++;se(K=>[...K.slice(-8),{id:w,emoji:U.emoji,x:X,y:_}]),setTimeout(()=>se(K=>K.filter(ue=>ue.id!==w)),2e3)});return()=>{h(),g(),D()}},[]),Q.useEffect(()=>{if(o.phase===q.LOGIN){Fe.listRooms();const h=setInterval(()=>Fe.listRooms(),3e3);return()=>clearInterval(h)}},[o.phase]),Q.useEffect(()=>{const h=G.current,g=o.phase;if(h!==g){const D={[q.ROLE_REVEAL]:"from-indigo-500/30",[q.WORD_SELECTION]:"from-amber-500/20",[q.DAY_PHASE]:"from-amber-300/25",[q.VOTING]:"from-red-500/30",[q.WEREWOLF_GUESS]:"from-purple-500/30",[q.GAME_OVER]:o.winner==="VILLAGE"?"from-emerald-400/30":"from-red-500/30"};switch(D[g]&&(ge(D[g]),ze(!0),setTimeout(()=>ze(!1),900)),g){case q.LOBBY:le.playPop();break;case q.ROLE_REVEAL:le.playReveal();break;case q.DAY_PHASE:le.playSuccess();break;case q.VOTING:le.playWarning();break;case q.WEREWOLF_GUESS:le.playHowl();break;case q.GAME_OVER:o.winner==="VILLAGE"?le.playVictoryDance():le.playDefeatSlouch();break}G.current=g}},[o.phase,o.winner]),Q.useEffect(()=>{o.tokenHistory.length>ce.current&&le.playPop(),ce.current=o.tokenHistory.length},[o.tokenHistory.length]),Q.useEffect(()=>{o.phase===q.DAY_PHASE&&o.timeRemaining<=10&&o.timeRemaining>0&&le.playTick()},[o.phase,o.timeRemaining]),Q.useEffect(()=>{(o.phase===q.VOTING||o.phase===q.WEREWOLF_GUESS)&&M(!1),o.phase===q.ROLE_REVEAL&&B(!1)},[o.phase]),Q.useEffect(()=>{const h={[q.LOGIN]:"🐾 WerePups Online",[q.LOBBY]:"🏠 Pack Gathering | WerePups",[q.ROLE_REVEAL]:"🌙 Night Falls... | WerePups",[q.WORD_SELECTION]:"📝 Choosing Word... | WerePups",[q.DAY_PHASE]:"☀️ Day Phase | WerePups",[q.VOTING]:"🗳️ Vote Now! | WerePups",[q.WEREWOLF_GUESS]:"🐺 Werewolf Hunt | WerePups",[q.GAME_OVER]:o.winner==="VILLAGE"?"🎉 Village Wins! | WerePups":"🐺 Wolves Win! | WerePups"};document.title=h[o.phase]||"🐾 WerePups Online"},[o.phase,o.winner]);const Ve=Q.useCallback(h=>{h.preventDefault(),r.trim()&&(le.playClick(),Fe.joinGame(r,void 0,zu(m)))},[r,m]),Vt=Q.useCallback(h=>{r.trim()&&(le.playClick(),Fe.joinGame(r,h,zu(m)))},[r,m]),lt=Q.useCallback(h=>{!z&&o.myPlayerId&&(le.playVote(),Fe.vote(h),M(!0))},[z,o.myPlayerId]),ke=Q.useCallback(h=>{Fe.sendReaction(h)},[]),ae=o.players.find(h=>h.id===o.myPlayerId),it=Q.useMemo(()=>{switch(o.phase){case q.DAY_PHASE:return"dusk";case q.VOTING:return"red";case q.WEREWOLF_GUESS:return"purple";case q.GAME_OVER:return o.winner==="VILLAGE"?"green":"red";default:return"night"}},[o.phase,o.winner]),at=o.phase===q.ROLE_REVEAL||o.phase===q.WEREWOLF_GUESS||o.phase===q.WORD_SELECTION,A=o.phase===q.DAY_PHASE,H=o.winner==="VILLAGE",Z=ae?H&&ae.role!==_e.WEREWOLF||!H&&ae.role===_e.WEREWOLF:!1,he=h=>{switch(h){case"WEREWOLF":return"🐺";case"SEER":return"🔮";default:return"🐶"}},O=h=>{switch(h){case"WEREWOLF":return"Were-Pup";case"SEER":return"Psychic Pup";case"VILLAGER":return"Good Pup";default:return h}};return s.jsxs(s.Fragment,{children:[s.jsx(x1,{variant:it}),s.jsx(O1,{}),s.jsx(N1,{}),Ne&&s.jsx(A1,{color:ee}),o.phase===q.GAME_OVER&&s.jsx(T1,{variant:Z?"gold":"silver"}),at&&s.jsx("div",{className:"night-overlay"}),A&&s.jsx("div",{className:"day-overlay"}),I.map(h=>s.jsx(z1,{id:h.id,emoji:h.emoji,x:h.x,y:h.y},h.id)),(o.phase===q.DAY_PHASE||o.phase===q.VOTING||o.phase===q.WEREWOLF_GUESS||o.phase===q.LOBBY)&&s.jsx(M1,{onReaction:ke}),o.phase===q.DAY_PHASE&&o.timeRemaining<=10&&o.timeRemaining>0&&s.jsx("div",{className:"urgency-vignette-critical"}),o.phase===q.DAY_PHASE&&o.timeRemaining>10&&o.timeRemaining<=30&&s.jsx("div",{className:"urgency-vignette"}),(o.phase===q.VOTING||o.phase===q.WEREWOLF_GUESS)&&s.jsx("div",{className:"voting-vignette"}),(o.phase===q.ROLE_REVEAL||o.phase===q.WORD_SELECTION)&&s.jsx(C1,{}),o.phase===q.LOGIN&&(()=>{const h=P.filter(g=>g.phase==="LOBBY");return s.jsx("div",{className:"min-h-screen flex items-center justify-center p-4 relative z-10",children:s.jsx("div",{className:"max-w-lg w-full",children:s.jsxs("div",{className:"bg-slate-900/60 backdrop-blur-xl p-8 rounded-3xl border border-slate-700/50 shadow-[0_0_60px_rgba(0,0,0,0.5)]",children:[s.jsxs("div",{className:"text-center mb-6",children:[s.jsx("div",{className:"inline-block mb-3 animate-float",children:s.jsx("div",{className:"w-20 h-20 mx-auto bg-gradient-to-br from-amber-400/20 to-orange-500/20 rounded-full flex items-center justify-center border-2 border-amber-500/30",children:s.jsx("span",{className:"text-4xl animate-emoji-bounce",children:"🐾"})})}),s.jsx("h1",{className:"text-4xl font-serif text-transparent bg-clip-text bg-gradient-to-r from-amber-300 via-orange-400 to-amber-300 font-bold tracking-tight",children:"WEREPUPS".split("").map((g,D)=>s.jsx("span",{className:"wiggle-letter",style:{animationDelay:`${D*.12}s`},children:g},D))}),s.jsxs("p",{className:"text-slate-400 mt-1.5 text-sm flex items-center justify-center gap-1.5",children:[s.jsx(La,{size:14,className:"text-amber-500/50"}),"A Cozy Puppy Mystery",s.jsx(La,{size:14,className:"text-amber-500/50"})]})]}),s.jsxs("form",{onSubmit:Ve,className:"space-y-5",children:[s.jsxs("div",{children:[s.jsxs("label",{className:"block text-sm font-medium text-slate-300 mb-2 flex items-center gap-1.5",children:[s.jsx("span",{className:"text-base",children:"🐶"})," What's your name, pup?"]}),s.jsx("input",{type:"text",value:r,onChange:g=>u(g.target.value),className:"w-full px-4 py-3 bg-slate-800/80 border border-slate-600/50 rounded-xl focus:ring-2 focus:ring-amber-500/50 focus:border-amber-500/50 outline-none text-white placeholder-slate-500 transition-all",placeholder:["FluffyPup42","Sir Barksalot","WoofMaster","SnugglePaws","Captain Bork"][Math.floor(Date.now()/5e3)%5],autoFocus:!0})]}),s.jsxs("div",{children:[s.jsx("label",{className:"block text-sm font-medium text-slate-300 mb-2",children:"Pick Your Pup"}),s.jsx("div",{className:"flex justify-center mb-3",children:s.jsxs("div",{className:"relative",children:[s.jsx("div",{className:"w-24 h-24 rounded-full overflow-hidden border-[3px] border-amber-400 shadow-lg shadow-amber-400/30 animate-ring-pulse bg-slate-800",children:s.jsx("div",{className:"w-full h-full",dangerouslySetInnerHTML:{__html:g1(m)}})}),s.jsx("div",{className:"absolute -bottom-1 left-1/2 -translate-x-1/2 bg-amber-500/90 text-slate-900 text-[10px] font-bold px-2 py-0.5 rounded-full shadow-md whitespace-nowrap",children:Cu[m]||m})]})}),s.jsx("div",{className:"max-h-[240px] overflow-y-auto rounded-xl pr-1",style:{scrollbarWidth:"thin"},children:s.jsx("div",{className:"grid grid-cols-8 gap-2",children:$0.map(g=>s.jsx("button",{type:"button",onClick:()=>{x(g),le.playBreedSelect(b1(g),j1(g))},title:Cu[g]||g,className:`w-full aspect-square rounded-full overflow-hidden border-[3px] transition-all hover:scale-110 ${m===g?"border-amber-400 shadow-lg shadow-amber-400/30 scale-110":"border-slate-600/50 hover:border-slate-500 opacity-70 hover:opacity-100"}`,children:s.jsx("img",{src:zu(g),alt:Cu[g]||g,className:"w-full h-full"})},g))})}),s.jsx("p",{className:"text-center text-[10px] text-slate-600 mt-1",children:"Scroll for more pups!"})]}),s.jsxs("button",{type:"submit",disabled:!r.trim(),className:"w-full bg-gradient-to-r from-amber-500 to-orange-500 text-slate-900 font-bold py-3.5 rounded-xl hover:from-amber-400 hover:to-orange-400 transition-all transform hover:scale-[1.02] active:scale-[0.98] disabled:opacity-40 disabled:cursor-not-allowed shadow-lg shadow-amber-500/20 text-lg flex items-center justify-center gap-2",children:[s.jsx("span",{className:"text-xl",children:"🐾"})," Start a New Pack"]})]}),s.jsxs("div",{className:"mt-5 pt-4",children:[s.jsx("div",{className:"paw-divider mb-4",children:"🐾"}),s.jsxs("button",{onClick:()=>{W(!k),Fe.listRooms()},className:"w-full flex items-center justify-between text-sm text-slate-400 hover:text-slate-200 transition-colors",children:[s.jsxs("span",{className:"flex items-center gap-2",children:[s.jsx(k0,{size:16}),"Join Existing Room",h.length>0&&s.jsx("span",{className:"bg-amber-500/20 text-amber-400 text-xs px-2 py-0.5 rounded-full font-bold",children:h.length})]}),s.jsx("span",{className:`transition-transform ${k?"rotate-180":""}`,children:"▼"})]}),k&&s.jsx("div",{className:"mt-3 space-y-2 max-h-48 overflow-y-auto",children:h.length===0?s.jsxs("div",{className:"text-center py-6",children:[s.jsx("span",{className:"text-2xl block mb-1.5",children:"🐾"}),s.jsx("p",{className:"text-slate-500 text-xs",children:"No packs gathering yet..."}),s.jsx("p",{className:"text-slate-600 text-[10px] mt-0.5",children:"Be the first to create a room!"})]}):h.map(g=>s.jsxs("div",{className:"flex items-center justify-between p-3 bg-slate-800/50 rounded-xl border border-slate-700/40 hover:border-slate-600/60 transition-all animate-slide-in-right",children:[s.jsxs("div",{children:[s.jsx("span",{className:"text-amber-400 font-mono text-sm font-bold",children:g.code}),s.jsxs("div",{className:"flex items-center gap-1 mt-0.5",children:[s.jsx(k0,{size:12,className:"text-slate-500"}),s.jsxs("span",{className:"text-slate-500 text-xs",children:[g.playerCount,"/",g.maxPlayers]}),s.jsxs("span",{className:"text-slate-600 text-xs ml-1",children:[g.playerNames.slice(0,3).join(", "),g.playerNames.length>3&&"..."]})]})]}),s.jsx("button",{onClick:()=>Vt(g.code),disabled:!r.trim(),className:"px-4 py-1.5 bg-amber-500/20 text-amber-400 rounded-lg text-xs font-bold hover:bg-amber-500/30 transition-all disabled:opacity-30",children:"JOIN"})]},g.code))})]})]})})})})(),o.phase===q.LOBBY&&(()=>{const h=o.players.length>1&&o.players.every(_=>_.isReady),g=o.players,D=g.filter(_=>_.isReady).length,U=["🐶 Puppies dream about playing fetch!","🐾 A wagging tail means a happy pup!","🧠 Puppies can learn up to 250 words!","🐕 A group of puppies is called a litter!","💤 Puppies sleep 18-20 hours a day!","🐺 Wolves can hear sounds up to 10 miles away!","🌙 WerePups only reveal themselves at night...","🔮 The Psychic Pup always knows the truth!"],X=Math.floor(Date.now()/8e3)%U.length;return s.jsxs(s.Fragment,{children:[s.jsx(R1,{}),s.jsx("div",{className:"min-h-screen flex flex-col items-center justify-center px-4 py-8 relative z-10",children:s.jsxs("div",{className:"w-full max-w-2xl flex flex-col items-center gap-6",children:[s.jsxs("div",{className:"w-full bg-slate-900/50 backdrop-blur-xl rounded-2xl border border-slate-700/40 p-5 shadow-[0_0_40px_rgba(0,0,0,0.3)]",children:[s.jsxs("div",{className:"flex items-center justify-between",children:[s.jsxs("div",{className:"flex items-center gap-3",children:[s.jsx("div",{className:"w-11 h-11 rounded-full bg-gradient-to-br from-amber-400/20 to-orange-500/20 border border-amber-500/30 flex items-center justify-center animate-breathe",children:s.jsx("span",{className:"text-xl",children:"🐾"})}),s.jsxs("div",{children:[s.jsx("h2",{className:"text-xl font-serif text-slate-100 leading-tight",children:"Pack Gathering"}),s.jsxs("button",{onClick:()=>{navigator.clipboard.writeText(o.roomCode),le.playClick()},className:"flex items-center gap-1.5 mt-0.5 group",title:"Click to copy room code",children:[s.jsx("span",{className:"text-amber-400/80 font-mono text-sm tracking-widest group-hover:text-amber-300 transition-colors",children:o.roomCode}),s.jsx("span",{className:"text-[9px] text-slate-600 group-hover:text-slate-400 transition-colors",children:"(tap to copy)"})]})]})]}),s.jsxs("div",{className:"flex flex-col items-end gap-1",children:[s.jsxs("div",{className:"px-3 py-1 rounded-full bg-slate-800/70 border border-slate-700/50 text-sm text-slate-300 flex items-center gap-1.5",children:[s.jsx(La,{size:13,className:"text-amber-400/70"}),s.jsx("span",{className:"font-bold",children:g.length}),s.jsxs("span",{className:"text-slate-500",children:["pup",g.length!==1?"s":""]})]}),s.jsxs("div",{className:"flex items-center gap-1 text-[10px]",children:[s.jsx("div",{className:`w-1.5 h-1.5 rounded-full ${D===g.length&&g.length>1?"bg-green-400 animate-pulse":"bg-slate-600"}`}),s.jsxs("span",{className:"text-slate-500",children:[D,"/",g.length," ready"]})]})]})]}),s.jsxs("div",{className:"mt-3 pt-3 border-t border-slate-700/30 flex items-center gap-2",children:[s.jsx(Au,{size:12,className:"text-amber-400/40 shrink-0"}),s.jsx("p",{className:"text-[11px] text-slate-500 italic truncate",children:U[X]})]})]}),s.jsx("div",{className:"w-full",children:s.jsxs("div",{className:`grid gap-3 ${g.length<=4?"grid-cols-2 sm:grid-cols-4":g.length<=6?"grid-cols-3 sm:grid-cols-6":"grid-cols-4 sm:grid-cols-8"}`,children:[g.map((_,w)=>{const K=_.id===o.myPlayerId;return s.jsxs("div",{className:`animate-card-appear hover-tilt flex flex-col items-center p-3 rounded-2xl border transition-all duration-300
-                          ${_.isReady?"bg-green-500/5 border-green-500/25 shadow-[0_0_12px_rgba(34,197,94,0.08)]":K?"bg-indigo-500/5 border-indigo-500/20":"bg-slate-800/30 border-slate-700/30"}
-                          ${_.wantsMayor?"ring-2 ring-amber-400/20 ring-offset-1 ring-offset-transparent":""}
-                        `,style:{animationDelay:`${w*80}ms`},children:[s.jsxs("div",{className:"relative mb-2",children:[s.jsx("div",{className:`w-16 h-16 sm:w-[72px] sm:h-[72px] rounded-full overflow-hidden border-[3px] transition-all duration-300 shadow-lg hover-wiggle
-                            ${_.wantsMayor?"border-amber-400 shadow-amber-400/20":_.isReady?"border-green-400 shadow-green-400/15":K?"border-indigo-400 shadow-indigo-400/15":"border-slate-600/50"}
-                          `,children:s.jsx("img",{src:_.avatarUrl,alt:_.name,className:"w-full h-full"})}),_.wantsMayor&&s.jsx("div",{className:"absolute -top-2 -right-1 bg-amber-400 text-slate-900 rounded-full p-1 shadow-lg z-20 animate-bounce-in",children:s.jsx(ul,{size:11,fill:"currentColor"})}),_.score>0&&s.jsx("div",{className:"absolute -top-1 -left-1 bg-amber-500 text-slate-900 rounded-full w-5 h-5 flex items-center justify-center text-[10px] font-black shadow-md z-20 border border-amber-300",children:_.score}),_.isBot&&s.jsx("div",{className:"absolute -bottom-0.5 right-0 bg-slate-800 border border-slate-600 rounded-full p-0.5 z-10",children:s.jsx(Ou,{size:10,className:"text-slate-400"})}),_.isReady&&s.jsx("div",{className:"absolute inset-0 flex items-end justify-center",children:s.jsx("div",{className:"bg-green-500 text-white rounded-full w-5 h-5 flex items-center justify-center shadow-lg -mb-0.5 animate-check-pop text-[11px] font-bold",children:"✓"})})]}),s.jsx("p",{className:`text-xs font-semibold text-center truncate max-w-full leading-tight ${K?"text-indigo-300":"text-slate-300"}`,children:_.name}),s.jsxs("div",{className:"flex items-center gap-1 mt-0.5",children:[K&&s.jsx("span",{className:"text-[9px] text-indigo-400/60 font-medium",children:"you"}),_.wantsMayor&&!K&&s.jsx("span",{className:"text-[9px] text-amber-400/70",children:"👑"}),!_.isReady&&!K&&s.jsx("span",{className:"text-[9px] text-slate-600",children:s.jsx("span",{className:"inline-block animate-pulse",children:"..."})})]}),_.achievements&&_.achievements.length>0&&s.jsx("div",{className:"flex gap-0.5 mt-0.5 flex-wrap justify-center",children:_.achievements.slice(0,3).map(ue=>{const Qe=W0[ue];return Qe?s.jsx("span",{className:"text-[9px] animate-badge-glow rounded-full bg-slate-800/80 px-1 py-0.5 border border-amber-500/20",title:Qe.label,children:Qe.icon},ue):null})})]},_.id)}),g.length<3&&Array.from({length:3-g.length}).map((_,w)=>s.jsxs("div",{className:"flex flex-col items-center p-3 rounded-2xl border border-dashed border-slate-700/30 animate-card-appear",style:{animationDelay:`${(g.length+w)*80}ms`},children:[s.jsx("div",{className:"w-16 h-16 sm:w-[72px] sm:h-[72px] rounded-full border-2 border-dashed border-slate-700/30 flex items-center justify-center mb-2",children:s.jsx("span",{className:"text-slate-700 text-xl animate-pulse",children:"?"})}),s.jsx("p",{className:"text-[10px] text-slate-700 font-medium",children:"Waiting..."})]},`empty-${w}`))]})}),o.players.some(_=>_.wantsMayor)&&s.jsx("div",{className:"w-full px-5 py-3 bg-amber-500/8 border border-amber-500/20 rounded-2xl animate-fade-in-up",children:s.jsxs("div",{className:"flex items-center gap-2",children:[s.jsx(ul,{size:14,className:"text-amber-400 shrink-0"}),s.jsxs("p",{className:"text-amber-400 text-xs",children:[s.jsx("span",{className:"font-bold",children:o.players.filter(_=>_.wantsMayor).map(_=>_.name).join(", ")}),o.players.filter(_=>_.wantsMayor).length===1?" wants":" want"," to be Pack Leader",o.players.filter(_=>_.wantsMayor).length>1&&s.jsx("span",{className:"text-amber-500/60 ml-1",children:"(one picked randomly)"})]})]})}),o.players.some(_=>_.score>0)&&s.jsx("div",{className:"w-full animate-fade-in-up",children:s.jsx(D1,{players:o.players,myPlayerId:o.myPlayerId})}),s.jsxs("div",{className:"w-full bg-slate-900/40 backdrop-blur-md rounded-2xl border border-slate-700/30 p-3",children:[s.jsxs("div",{className:"flex items-center gap-2 mb-2",children:[s.jsx(Au,{size:12,className:"text-amber-400/60"}),s.jsx("span",{className:"text-[10px] text-slate-500 uppercase tracking-widest font-bold",children:"Difficulty"})]}),s.jsx("div",{className:"flex gap-2",children:["EASY","MEDIUM","HARD"].map(_=>{const w=E1[_],K=o.difficulty===_;return s.jsxs("button",{onClick:()=>{Fe.setDifficulty(_),le.playClick()},className:`flex-1 py-2 rounded-xl text-xs font-bold flex items-center justify-center gap-1.5 transition-all ${K?`bg-gradient-to-r ${w.color} text-white shadow-lg`:"bg-slate-800/50 text-slate-500 hover:text-slate-300 border border-slate-700/30"}`,children:[s.jsx("span",{children:w.emoji})," ",w.label]},_)})}),s.jsx("div",{className:"mt-2 text-center",children:s.jsxs("span",{className:"text-[10px] text-slate-500",children:["🐺 ",o.numWerewolves||1," werewolf pup",(o.numWerewolves||1)>1?"s":""," will be among you"]})})]}),s.jsxs("div",{className:"w-full flex flex-col gap-3",children:[s.jsxs("div",{className:"flex gap-3 w-full",children:[s.jsx("button",{onClick:()=>{Fe.toggleReady(),le.playClick()},className:`flex-1 py-3.5 rounded-xl font-bold transition-all text-sm flex items-center justify-center gap-2 ${ae!=null&&ae.isReady?"bg-green-500/15 text-green-400 border border-green-500/30 hover:bg-green-500/25":"bg-gradient-to-r from-green-600 to-emerald-600 text-white hover:from-green-500 hover:to-emerald-500 shadow-lg shadow-green-600/20"}`,children:ae!=null&&ae.isReady?s.jsxs(s.Fragment,{children:[s.jsx("span",{className:"text-green-400",children:"✓"})," Ready!"]}):s.jsxs(s.Fragment,{children:[s.jsx(La,{size:16})," Ready Up"]})}),(ae==null?void 0:ae.isReady)&&h&&s.jsxs("button",{onClick:()=>Fe.startGame(),className:"flex-1 py-3.5 rounded-xl font-bold bg-gradient-to-r from-amber-500 to-orange-500 text-slate-900 hover:from-amber-400 hover:to-orange-400 flex items-center justify-center gap-2 shadow-lg shadow-amber-500/25 text-sm animate-pulse",children:[s.jsx(Au,{size:16})," Start Game ",s.jsx($h,{size:16})]})]}),s.jsxs("div",{className:"flex gap-3 w-full",children:[s.jsxs("button",{onClick:()=>{Fe.toggleWantsMayor(),le.playClick()},className:`flex-1 py-3 rounded-xl font-bold text-sm flex items-center justify-center gap-2 transition-all ${ae!=null&&ae.wantsMayor?"bg-amber-500/15 text-amber-400 border border-amber-500/35 shadow-md shadow-amber-500/10":"bg-slate-800/60 text-slate-400 hover:bg-slate-700/60 hover:text-slate-200 border border-slate-700/40"}`,children:[s.jsx(ul,{size:15})," ",ae!=null&&ae.wantsMayor?"Volunteered!":"Be Pack Leader"]}),s.jsxs("button",{onClick:()=>{Fe.addBot(),le.playPop()},className:"flex-1 py-3 rounded-xl font-bold text-sm bg-slate-800/60 text-slate-400 hover:bg-slate-700/60 hover:text-slate-200 border border-slate-700/40 flex items-center justify-center gap-2 transition-all",children:[s.jsx(Ou,{size:15})," Add Bot"]})]})]}),!h&&g.length>=2&&s.jsx("div",{className:"text-center animate-fade-in-up",children:s.jsxs("p",{className:"text-slate-500 text-xs flex items-center gap-2 justify-center",children:[s.jsx("span",{className:"text-base animate-emoji-bounce",children:"🐕"}),"Sniffing around",s.jsxs("span",{className:"sniff-dots",children:[s.jsx("span",{children:"."}),s.jsx("span",{children:"."}),s.jsx("span",{children:"."})]}),"waiting for all pups"]})}),g.length<2&&s.jsxs("div",{className:"text-center animate-fade-in-up",children:[s.jsxs("p",{className:"text-slate-500 text-xs flex items-center justify-center gap-1.5",children:[s.jsx("span",{className:"text-base",children:"🐶"}),"It's lonely here! Need at least 2 pups to start."]}),s.jsx("p",{className:"text-slate-600 text-[10px] mt-1",children:"Share the room code with your friends!"})]})]})})]})})(),o.phase===q.ROLE_REVEAL&&ae&&s.jsx("div",{className:"min-h-screen flex flex-col items-center justify-center p-4 relative z-10",children:s.jsxs("div",{className:"w-full flex flex-col items-center",children:[s.jsxs("div",{className:"mb-8 text-center animate-fade-in-up",children:[s.jsxs("div",{className:"relative inline-block mb-3",children:[s.jsx(e1,{size:44,className:"text-indigo-300 mx-auto opacity-70"}),s.jsx("div",{className:"absolute inset-0 flex items-center justify-center",children:s.jsx("div",{className:"w-10 h-10 rounded-full bg-indigo-400/10 animate-ping-slow"})})]}),s.jsx("h2",{className:"text-3xl font-serif text-slate-100 mb-2",children:"Night Has Fallen"}),s.jsx("p",{className:"text-slate-400 text-sm",children:"Tap your card to discover your breed..."}),s.jsx("p",{className:"text-indigo-400/40 text-xs mt-1 italic",children:"Shh... the pups are sleeping"})]}),s.jsx(r1,{role:ae.role,isMayor:ae.isMayor,secretWord:o.secretWord,revealed:b,onReveal:()=>{B(!0),le.playReveal()}}),b&&s.jsxs("div",{className:"mt-10 text-center animate-fade-in-up",children:[s.jsx("p",{className:"text-amber-400 animate-pulse mb-3 text-sm",children:"Dawn is breaking..."}),s.jsx("div",{className:"w-48 h-1.5 bg-slate-800 rounded-full overflow-hidden mx-auto",children:s.jsx("div",{className:"h-full bg-gradient-to-r from-amber-500 to-orange-400 rounded-full",style:{animation:"width 6s linear forwards",width:"0%"}})})]}),s.jsx("style",{children:"@keyframes width { from { width: 0%; } to { width: 100%; } }"})]})}),o.phase===q.WORD_SELECTION&&ae&&s.jsx("div",{className:"min-h-screen flex flex-col items-center justify-center p-4 relative z-10",children:s.jsx("div",{className:"w-full max-w-lg flex flex-col items-center",children:ae.isMayor?s.jsxs(s.Fragment,{children:[s.jsxs("div",{className:"mb-8 text-center animate-fade-in-up",children:[s.jsx(La,{size:36,className:"text-amber-400 mx-auto mb-3"}),s.jsx("h2",{className:"text-3xl font-serif text-slate-100 mb-2",children:"Choose the Secret Word"}),s.jsx("p",{className:"text-slate-400 text-sm",children:"As Pack Leader, pick a word for your pack to guess!"}),s.jsxs("div",{className:"mt-3 flex items-center justify-center gap-2",children:[s.jsxs("span",{className:"text-amber-400 font-mono text-lg font-bold",children:[o.timeRemaining,"s"]}),s.jsx("span",{className:"text-slate-500 text-xs",children:"remaining"})]})]}),s.jsx("div",{className:"grid gap-3 w-full",children:(o.wordOptions||[]).map((h,g)=>s.jsxs("button",{onClick:()=>{Fe.chooseWord(h),le.playWordChosen()},className:"w-full px-6 py-4 rounded-xl font-bold text-lg bg-gradient-to-r from-slate-800 to-slate-700 text-slate-100 border border-slate-600/50 hover:from-amber-500/20 hover:to-orange-500/20 hover:border-amber-500/50 hover:text-amber-300 transition-all duration-200 shadow-lg hover:shadow-amber-500/10 animate-fade-in-up flex items-center justify-between group",style:{animationDelay:`${g*80}ms`},children:[s.jsx("span",{children:h}),s.jsx("span",{className:"text-slate-500 group-hover:text-amber-400 transition-colors text-sm",children:"Pick"})]},h))}),s.jsx("p",{className:"mt-4 text-slate-500 text-xs text-center",children:"A random word will be chosen if time runs out"})]}):s.jsxs("div",{className:"text-center animate-fade-in-up",children:[s.jsxs("div",{className:"mb-6",children:[s.jsx("div",{className:"w-20 h-20 mx-auto rounded-full bg-amber-500/10 border border-amber-500/20 flex items-center justify-center mb-4",children:s.jsx(La,{size:32,className:"text-amber-400 animate-pulse"})}),s.jsx("h2",{className:"text-2xl font-serif text-slate-100 mb-2",children:"Pack Leader is Choosing..."}),s.jsx("p",{className:"text-slate-400 text-sm",children:"The Pack Leader is picking the secret word for this round"})]}),s.jsx("div",{className:"flex items-center justify-center gap-2 mb-4",children:s.jsxs("span",{className:"text-amber-400 font-mono text-lg font-bold",children:[o.timeRemaining,"s"]})}),s.jsxs("div",{className:"flex gap-1.5 justify-center",children:[s.jsx("div",{className:"w-2.5 h-2.5 rounded-full bg-amber-400 animate-bounce",style:{animationDelay:"0ms"}}),s.jsx("div",{className:"w-2.5 h-2.5 rounded-full bg-amber-400 animate-bounce",style:{animationDelay:"150ms"}}),s.jsx("div",{className:"w-2.5 h-2.5 rounded-full bg-amber-400 animate-bounce",style:{animationDelay:"300ms"}})]})]})})}),o.phase===q.DAY_PHASE&&s.jsx("div",{className:"h-screen relative z-10",children:s.jsx(m1,{state:o,onTokenClick:(h,g)=>Fe.submitToken(h,g),onSubmitGuess:h=>Fe.submitGuess(h)})}),o.phase===q.VOTING&&s.jsx("div",{className:"min-h-screen flex flex-col items-center justify-center p-6 relative z-10",children:s.jsxs("div",{className:"max-w-3xl w-full flex flex-col items-center",children:[s.jsxs("div",{className:"mb-4 text-center animate-fade-in-up",children:[s.jsx("div",{className:"text-4xl mb-3 animate-heartbeat",children:"🐺"}),s.jsx("h2",{className:"text-3xl sm:text-4xl font-serif text-red-400 font-bold animate-heartbeat",children:"Time's Up!"}),s.jsx("p",{className:"text-slate-300 mt-2",children:"Which pup is secretly a Werewolf? Cast your vote!"})]}),s.jsx(Nu,{players:o.players,myPlayerId:o.myPlayerId,onPlayerClick:lt,canClick:h=>!z&&h!==(ae==null?void 0:ae.id),hoverLabel:"VOTE",showVotes:!0,accentColor:"red",centerContent:s.jsxs("div",{className:"flex flex-col items-center opacity-60",children:[s.jsx("span",{className:"text-3xl",children:"🐾"}),s.jsx("span",{className:"text-red-400/60 text-[10px] uppercase tracking-widest mt-1",children:"Sniff Out"})]})}),z&&s.jsxs("div",{className:"mt-4 text-slate-400 text-sm text-center flex items-center justify-center gap-2",children:[s.jsx("span",{className:"animate-emoji-bounce",children:"🐾"}),"Sniffing",s.jsxs("span",{className:"sniff-dots",children:[s.jsx("span",{children:"."}),s.jsx("span",{children:"."}),s.jsx("span",{children:"."})]}),"waiting for the other pups"]})]})}),o.phase===q.WEREWOLF_GUESS&&(()=>{const h=(ae==null?void 0:ae.role)===_e.WEREWOLF,g=Math.floor(o.timeRemaining/60),D=o.timeRemaining%60;return s.jsx("div",{className:"min-h-screen flex flex-col items-center justify-center p-6 relative z-10",children:s.jsxs("div",{className:"max-w-3xl w-full flex flex-col items-center",children:[s.jsxs("div",{className:"mb-4 text-center animate-fade-in-up",children:[s.jsx(Ru,{size:40,className:"text-purple-400 mx-auto mb-3"}),s.jsx("h2",{className:"text-3xl sm:text-4xl font-serif text-purple-300 font-bold",children:"The Psychic Pup Hunt"}),s.jsxs("p",{className:"text-slate-300 mt-2",children:["The word was ",s.jsx("span",{className:"font-bold text-amber-400",children:o.secretWord})]}),s.jsxs("div",{className:`mt-3 text-2xl font-mono ${o.timeRemaining<=10?"text-red-400 animate-pulse":"text-purple-300"}`,children:[String(g).padStart(2,"0"),":",String(D).padStart(2,"0")]})]}),h?s.jsxs(s.Fragment,{children:[s.jsx("div",{className:"mb-3 px-5 py-2.5 bg-red-900/20 border border-red-800/50 rounded-xl text-center animate-bounce-in",children:s.jsxs("p",{className:"text-red-400 text-sm font-bold flex items-center justify-center gap-2",children:[s.jsx(Ph,{size:16})," You're the Werewolf Pup! Find the Psychic Pup to steal the win!"]})}),s.jsx(Nu,{players:o.players,myPlayerId:o.myPlayerId,onPlayerClick:lt,canClick:U=>!z&&U!==(ae==null?void 0:ae.id),hoverLabel:"ACCUSE",showVotes:!0,accentColor:"purple",centerContent:s.jsxs("div",{className:"flex flex-col items-center opacity-60",children:[s.jsx(Ru,{size:28,className:"text-purple-400"}),s.jsx("span",{className:"text-purple-400/60 text-[10px] uppercase tracking-widest mt-1",children:"Find Psychic"})]})}),z&&s.jsx("div",{className:"mt-4 text-slate-400 text-sm animate-pulse",children:"Your guess has been submitted..."})]}):s.jsxs(s.Fragment,{children:[s.jsx(Nu,{players:o.players,myPlayerId:o.myPlayerId,accentColor:"purple",centerContent:s.jsxs("div",{className:"flex flex-col items-center",children:[s.jsx("div",{className:"text-3xl animate-float",children:"🐺"}),s.jsx("span",{className:"text-purple-400/60 text-[10px] uppercase tracking-widest mt-1",children:"Hunting..."})]})}),s.jsxs("div",{className:"mt-4 p-5 bg-slate-900/40 rounded-xl border border-slate-800/50 text-center max-w-sm",children:[s.jsx("p",{className:"text-purple-300 animate-pulse text-sm",children:"The Werewolf Pup is sniffing for the Psychic Pup..."}),s.jsx("p",{className:"text-slate-500 mt-1.5 text-xs",children:"If they guess correctly, they steal the win!"})]})]})]})})})(),o.phase===q.GAME_OVER&&(()=>{var _;const h=[...o.players].sort((w,K)=>K.score-w.score),g=h[0],D=g&&g.score>0,U=[];(o.guesses||[]).forEach(w=>{const K=o.players.find(ue=>ue.id===w.playerId);U.push({time:w.timestamp,type:"guess",player:(K==null?void 0:K.name)||"?",detail:`guessed "${w.text}"`})}),(o.tokenHistory||[]).forEach(w=>{const K=o.players.find(ue=>ue.id===w.targetPlayerId);U.push({time:w.timestamp,type:"token",player:(K==null?void 0:K.name)||"?",detail:`received ${w.type} token`})}),U.sort((w,K)=>w.time-K.time);const X=((_=U[0])==null?void 0:_.time)||0;return s.jsx("div",{className:"min-h-screen flex items-center justify-center p-4 relative z-10",children:s.jsx("div",{className:"max-w-lg w-full",children:s.jsxs("div",{className:`bg-slate-900/60 backdrop-blur-xl rounded-3xl p-8 border shadow-[0_0_60px_rgba(0,0,0,0.5)] text-center ${H?"border-emerald-500/30":"border-red-500/30"}`,children:[s.jsx("div",{className:"mb-4 animate-bounce-in",children:H?s.jsx("div",{className:"inline-flex items-center justify-center w-24 h-24 rounded-full bg-emerald-500/10 border-2 border-emerald-500/30 animate-winner-glow",children:s.jsx(td,{size:48,className:"text-amber-400 drop-shadow-lg"})}):s.jsx("div",{className:"inline-flex items-center justify-center w-24 h-24 rounded-full bg-red-500/10 border-2 border-red-500/30",children:s.jsx("span",{className:"text-5xl",children:"🐺"})})}),s.jsx("h2",{className:`text-3xl sm:text-4xl font-serif font-black mb-1 ${H?"text-emerald-400":"text-red-400"}`,children:H?"Good Pups Win!":"Werewolf Pups Win!"}),Z?s.jsx("p",{className:"text-emerald-300 text-sm font-medium mb-3",children:"You won this round! 🎉"}):s.jsx("p",{className:"text-slate-400 text-sm mb-3",children:"Better luck next time, pup!"}),s.jsxs("div",{className:"mb-5 inline-block px-6 py-2.5 bg-slate-800/60 rounded-2xl border border-amber-500/20",children:[s.jsx("p",{className:"text-[10px] text-slate-500 uppercase tracking-widest mb-0.5",children:"The Secret Word"}),s.jsx("p",{className:"text-2xl font-serif font-black text-amber-400 tracking-wide",children:o.secretWord})]}),D&&s.jsx("div",{className:"mb-4 animate-role-reveal",style:{animationDelay:"0.3s"},children:s.jsxs("div",{className:"inline-flex items-center gap-3 px-4 py-2 bg-amber-500/10 border border-amber-500/25 rounded-2xl",children:[s.jsx("img",{src:g.avatarUrl,alt:g.name,className:"w-10 h-10 rounded-full border-2 border-amber-400 shadow-lg animate-victory-dance"}),s.jsxs("div",{className:"text-left",children:[s.jsx("p",{className:"text-[10px] uppercase tracking-widest mvp-badge font-bold",children:"MVP"}),s.jsx("p",{className:"text-amber-300 text-sm font-bold",children:g.name})]}),s.jsxs("div",{className:"flex items-center gap-1",children:[s.jsx(es,{size:16,className:"text-amber-400"}),s.jsx("span",{className:"text-xl font-black text-amber-400",children:g.score})]})]})}),s.jsx("div",{className:"space-y-2 mb-5",children:h.map((w,K)=>{const ue=H?w.role!=="WEREWOLF":w.role==="WEREWOLF",Qe=w.role==="WEREWOLF"?"border-red-500/40 bg-red-950/20":w.role==="SEER"?"border-blue-500/40 bg-blue-950/20":"border-emerald-500/30 bg-emerald-950/15";return s.jsxs("div",{className:`flex items-center gap-3 p-3 rounded-xl border animate-role-reveal transition-all ${Qe} ${ue?"ring-1 ring-emerald-400/10":""}`,style:{animationDelay:`${.5+K*.15}s`},children:[s.jsx("div",{className:"w-6 text-center shrink-0",children:K===0&&w.score>0?s.jsx("span",{className:"text-base",children:"🥇"}):K===1&&w.score>0?s.jsx("span",{className:"text-base",children:"🥈"}):K===2&&w.score>0?s.jsx("span",{className:"text-base",children:"🥉"}):s.jsx("span",{className:"text-[10px] text-slate-600 font-mono",children:K+1})}),s.jsxs("div",{className:`relative ${ue?"animate-victory-dance":"animate-defeat-slouch"}`,style:{animationDelay:`${.8+K*.15}s`},children:[s.jsx("img",{src:w.avatarUrl,alt:w.name,className:`w-11 h-11 rounded-full border-[2.5px] shadow-lg ${w.role==="WEREWOLF"?"border-red-500":w.role==="SEER"?"border-blue-400":"border-emerald-400"}`}),w.isMayor&&s.jsx("div",{className:"absolute -top-1 -right-1 bg-amber-400 text-slate-900 rounded-full p-0.5 z-10",children:s.jsx(ul,{size:10,fill:"currentColor"})})]}),s.jsxs("div",{className:"flex-1 text-left min-w-0",children:[s.jsxs("div",{className:"flex items-center gap-1.5",children:[s.jsx("span",{className:"text-sm font-semibold text-slate-200 truncate",children:w.name}),w.isBot&&s.jsx("span",{className:"text-[9px] text-slate-500 bg-slate-800 px-1 py-0.5 rounded",children:"BOT"}),ue&&s.jsx("span",{className:"text-[9px]",children:"✨"})]}),s.jsxs("div",{className:"flex items-center gap-1.5 mt-0.5",children:[s.jsx("span",{className:"text-sm",children:he(w.role)}),s.jsx("span",{className:`text-[11px] font-bold ${w.role==="WEREWOLF"?"text-red-400":w.role==="SEER"?"text-blue-400":"text-emerald-400"}`,children:O(w.role)}),(w.votesReceived||0)>0&&s.jsxs("span",{className:"text-[9px] text-red-400 bg-red-900/30 px-1.5 py-0.5 rounded-full",children:[w.votesReceived," vote",(w.votesReceived||0)>1?"s":""]})]}),w.achievements&&w.achievements.length>0&&s.jsx("div",{className:"flex gap-1 mt-1 flex-wrap",children:w.achievements.map(Tt=>{const Ft=W0[Tt];return Ft?s.jsxs("span",{className:"text-[8px] bg-amber-500/10 text-amber-400 border border-amber-500/20 rounded-full px-1.5 py-0.5 flex items-center gap-0.5",children:[Ft.icon," ",Ft.label]},Tt):null})})]}),s.jsxs("div",{className:"flex flex-col items-center shrink-0",children:[s.jsxs("div",{className:"flex items-center gap-1",children:[s.jsx(es,{size:14,className:"text-amber-400"}),s.jsx("span",{className:"text-lg font-black text-amber-400 tabular-nums animate-score-pop",style:{animationDelay:`${.8+K*.15}s`},children:w.score})]}),ue&&s.jsx("span",{className:"text-[8px] text-emerald-400 font-bold uppercase tracking-wider",children:"Winner"})]})]},w.id)})}),U.length>0&&s.jsxs("div",{className:"mb-5",children:[s.jsxs("button",{onClick:()=>V(!pe),className:"text-xs text-slate-400 hover:text-slate-200 transition-colors flex items-center gap-1.5 mx-auto mb-2",children:[s.jsx(ld,{size:12}),pe?"Hide":"Show"," Game Replay",s.jsx("span",{className:`transition-transform ${pe?"rotate-180":""}`,children:"▼"})]}),pe&&s.jsx("div",{className:"bg-slate-800/40 rounded-xl border border-slate-700/30 p-3 max-h-48 overflow-y-auto text-left space-y-1.5",children:U.map((w,K)=>s.jsxs("div",{className:"flex items-center gap-2 animate-timeline-slide",style:{animationDelay:`${K*50}ms`},children:[s.jsxs("span",{className:"text-[9px] text-slate-600 font-mono w-12 shrink-0 text-right",children:[Math.round((w.time-X)/1e3),"s"]}),s.jsx("div",{className:`w-1.5 h-1.5 rounded-full shrink-0 ${w.type==="guess"?"bg-sky-400":w.detail.includes("CORRECT")?"bg-emerald-400":"bg-amber-400"}`}),s.jsxs("span",{className:"text-[10px] text-slate-300",children:[s.jsx("span",{className:"font-semibold",children:w.player})," ",w.detail]})]},K))})]}),s.jsxs("button",{onClick:()=>{Fe.resetGame(),V(!1)},className:"inline-flex items-center gap-2 px-10 py-3.5 bg-gradient-to-r from-amber-500 to-orange-500 text-slate-900 rounded-xl font-bold text-base hover:from-amber-400 hover:to-orange-400 hover:scale-105 active:scale-95 transition-all shadow-lg shadow-amber-500/25",children:[s.jsx(Ih,{size:18})," Play Again"]})]})})})})()]})},nd=document.getElementById("root");if(!nd)throw new Error("Could not find root element to mount to");const w1=Lh.createRoot(nd);w1.render(s.jsx(Oh.StrictMode,{children:s.jsx(_1,{})}));
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// newAdminHandler serves the operator API under /admin. Every request must
+// carry "Authorization: Bearer <token>".
+//
+//	GET    /admin/rooms                   list rooms with full internal state
+//	GET    /admin/rooms/{code}            one room
+//	DELETE /admin/rooms/{code}            close a room, detaching its players
+//	POST   /admin/rooms/{code}/end        force-end: {"winner": "VILLAGE"|"WEREWOLF"|""}
+//	POST   /admin/rooms/{code}/kick       {"playerId": "...", "reason": "..."}
+//	PUT    /admin/rooms/{code}/logging    {"debug": true|false}
+//	POST   /admin/announce                {"message": "..."} to every client
+//	GET    /admin/reaped                  rooms recently closed for idling, and why
+//	GET    /admin/wordpacks               word packs in use
+//	POST   /admin/wordpacks/reload        reload word packs from WORD_PACKS_DIR
+//	GET    /admin/wordstats               per-word play stats, hardest first (?language=)
+func newAdminHandler(h *Hub, token string) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /admin/rooms", func(w http.ResponseWriter, r *http.Request) {
+		snapshots := make([]RoomSnapshot, 0)
+		for _, room := range h.roomList() {
+			room.mu.Lock()
+			snapshots = append(snapshots, room.snapshot())
+			room.mu.Unlock()
+		}
+		sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Code < snapshots[j].Code })
+		writeJSON(w, http.StatusOK, snapshots)
+	})
+
+	mux.HandleFunc("GET /admin/rooms/{code}", func(w http.ResponseWriter, r *http.Request) {
+		room := adminRoom(w, r, h)
+		if room == nil {
+			return
+		}
+		room.mu.Lock()
+		snapshot := room.snapshot()
+		room.mu.Unlock()
+		writeJSON(w, http.StatusOK, snapshot)
+	})
+
+	mux.HandleFunc("DELETE /admin/rooms/{code}", func(w http.ResponseWriter, r *http.Request) {
+		room := adminRoom(w, r, h)
+		if room == nil {
+			return
+		}
+		room.close("This room was closed by a moderator")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /admin/rooms/{code}/end", func(w http.ResponseWriter, r *http.Request) {
+		room := adminRoom(w, r, h)
+		if room == nil {
+			return
+		}
+		var body struct {
+			Winner string `json:"winner"`
+		}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		if err := room.forceEnd(body.Winner); err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /admin/rooms/{code}/kick", func(w http.ResponseWriter, r *http.Request) {
+		room := adminRoom(w, r, h)
+		if room == nil {
+			return
+		}
+		var body struct {
+			PlayerID string `json:"playerId"`
+			Reason   string `json:"reason"`
+		}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		if body.Reason == "" {
+			body.Reason = "You were removed from the room by a moderator"
+		}
+		if err := room.kick(body.PlayerID, body.Reason); err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("PUT /admin/rooms/{code}/logging", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Debug bool `json:"debug"`
+		}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		// Works for codes that don't exist yet, so a room can be traced from creation
+		h.setRoomDebug(r.PathValue("code"), body.Debug)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /admin/announce", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Message string `json:"message"`
+		}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		if strings.TrimSpace(body.Message) == "" {
+			writeError(w, http.StatusBadRequest, "message is required")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]int{"delivered": h.announce(body.Message)})
+	})
+
+	mux.HandleFunc("GET /admin/reaped", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, h.reapHistory())
+	})
+
+	mux.HandleFunc("GET /admin/wordpacks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, currentWordBank().packInfo())
+	})
+
+	mux.HandleFunc("POST /admin/wordpacks/reload", func(w http.ResponseWriter, r *http.Request) {
+		bank, err := reloadWordPacks(wordPacksDir)
+		if err != nil {
+			// Rooms keep using the packs already loaded
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		slog.Info("word packs reloaded", logKeyComponent, "admin", "packs", len(bank.packs), "entries", bank.entryCount())
+		writeJSON(w, http.StatusOK, bank.packInfo())
+	})
+
+	mux.HandleFunc("GET /admin/wordstats", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"targetWinRate": adaptiveTargetWinRate,
+			"words":         wordStats.list(strings.ToLower(r.URL.Query().Get("language"))),
+		})
+	})
+
+	return requireBearer(token, mux)
+}
+
+func requireBearer(token string, next http.Handler) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(got, want) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func adminRoom(w http.ResponseWriter, r *http.Request, h *Hub) *Room {
+	code := r.PathValue("code")
+	room := h.getRoom(code)
+	if room == nil {
+		writeError(w, http.StatusNotFound, "room not found: "+code)
+	}
+	return room
+}
+
+// --- JSON helpers ---
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, ErrorPayload{Message: message})
+}
+
+// readJSON decodes the request body into v, replying 400 on failure.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
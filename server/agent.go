@@ -0,0 +1,221 @@
+package main
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// External bot agents connect to /ws with a token from AGENT_TOKENS and are
+// seated by a host with ADD_BOT {"agent": name}. A seated agent receives
+// the same STATE_UPDATE messages a human in its seat would, and plays by
+// sending the usual SUBMIT_GUESS, SUBMIT_TOKEN, CHOOSE_WORD and VOTE
+// messages; host commands such as START_GAME or ADD_BOT are refused.
+// Whenever it leaves a move for longer than AGENT_TIMEOUT, or disconnects,
+// the built-in strategy plays for it.
+
+// agentMessageTypes are the messages a seated agent may send: the moves of
+// its own seat, never host commands.
+var agentMessageTypes = map[string]bool{
+	"CHOOSE_WORD": true, "REROLL_WORDS": true, "SUBMIT_TOKEN": true, "REVEAL_HINT": true,
+	"SUBMIT_GUESS": true, "VOTE": true, "SEND_REACTION": true,
+}
+
+// agentStrategy plays for a bot driven by an external agent. The agent
+// makes its own moves by sending messages, so the strategy passes while
+// the agent still has time and then lets fallback make the move.
+type agentStrategy struct {
+	conn     *Client
+	timeout  time.Duration
+	fallback BotStrategy
+
+	epoch int                  // game the due times belong to
+	due   map[string]time.Time // move → when it was first asked for
+}
+
+func newAgentStrategy(conn *Client, timeout time.Duration, fallback BotStrategy) *agentStrategy {
+	return &agentStrategy{conn: conn, timeout: timeout, fallback: fallback, due: make(map[string]time.Time)}
+}
+
+// overdue reports whether the agent has left a move for longer than the
+// timeout. The clock runs from since, or from when the move was first
+// asked for, and restarts whenever the agent sends a command so an agent
+// that is busy playing isn't overruled.
+// Must be called with the room's lock held.
+func (s *agentStrategy) overdue(r *Room, move string, since time.Time) bool {
+	if s.epoch != r.gameEpoch {
+		s.epoch = r.gameEpoch
+		s.due = make(map[string]time.Time)
+	}
+	if since.IsZero() {
+		var ok bool
+		if since, ok = s.due[move]; !ok {
+			since = time.Now()
+			s.due[move] = since
+		}
+	}
+	if last := time.UnixMilli(s.conn.lastCommand.Load()); last.After(since) {
+		since = last
+	}
+	return time.Since(since) >= s.timeout
+}
+
+func (s *agentStrategy) ChooseWord(r *Room, bot *Player) string {
+	if !s.overdue(r, "word", time.Time{}) {
+		return ""
+	}
+	return s.fallback.ChooseWord(r, bot)
+}
+
+func (s *agentStrategy) Answer(r *Room, bot *Player, q GuessEntry) string {
+	if !s.overdue(r, "answer", time.UnixMilli(q.Timestamp)) {
+		return ""
+	}
+	return s.fallback.Answer(r, bot, q)
+}
+
+func (s *agentStrategy) Guess(r *Room, bot *Player) string {
+	if !s.overdue(r, "guess", time.Time{}) {
+		return ""
+	}
+	return s.fallback.Guess(r, bot)
+}
+
+func (s *agentStrategy) Vote(r *Room, bot *Player, phase string) string {
+	if !s.overdue(r, "vote:"+phase, time.Time{}) {
+		return ""
+	}
+	return s.fallback.Vote(r, bot, phase)
+}
+
+// AgentReadyPayload tells a freshly connected agent its name and the
+// player ID its bot will use once seated.
+type AgentReadyPayload struct {
+	Name     string `json:"name"`
+	PlayerID string `json:"playerId"`
+}
+
+// parseAgentTokens reads "name:token" pairs into a token → name map.
+func parseAgentTokens(pairs []string) (map[string]string, error) {
+	tokens := make(map[string]string, len(pairs))
+	names := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		name, token, ok := strings.Cut(pair, ":")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		if !ok || name == "" || token == "" {
+			return nil, fmt.Errorf("agent token %q is not in name:token form", pair)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("agent name %q is listed twice", name)
+		}
+		names[name] = true
+		tokens[token] = name
+	}
+	return tokens, nil
+}
+
+// setAgents installs the agent tokens and how long agents get to act
+// before the built-in strategy steps in.
+func (h *Hub) setAgents(tokens map[string]string, timeout time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.agentTokens = tokens
+	h.agentTimeout = timeout
+}
+
+// agentFromRequest returns the agent name for a request's token, taken
+// from "Authorization: Bearer <token>" or the agentToken query parameter.
+// ok is false when the request presents a token that isn't recognised;
+// a request without a token is an ordinary player.
+func (h *Hub) agentFromRequest(r *http.Request) (name string, ok bool) {
+	token := r.URL.Query().Get("agentToken")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if token == "" {
+		return "", true
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	// Compare against every token so timing doesn't reveal which matched
+	for candidate, agent := range h.agentTokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			name = agent
+		}
+	}
+	return name, name != ""
+}
+
+// registerAgent records a connected agent. Each agent may hold only one
+// connection at a time.
+func (h *Hub) registerAgent(c *Client) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, exists := h.agents[c.agent]; exists {
+		return fmt.Errorf("agent %s is already connected", c.agent)
+	}
+	h.agents[c.agent] = c
+	return nil
+}
+
+func (h *Hub) unregisterAgent(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.agents[c.agent] == c {
+		delete(h.agents, c.agent)
+	}
+	delete(h.seatedAgents, c)
+}
+
+// takeAgent reserves a connected, unseated agent for a bot seat.
+func (h *Hub) takeAgent(name string) (*Client, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c := h.agents[name]
+	if c == nil {
+		return nil, fmt.Errorf("Agent %s is not connected", name)
+	}
+	if h.seatedAgents[c] {
+		return nil, errors.New("Agent " + name + " is already playing in a room")
+	}
+	h.seatedAgents[c] = true
+	return c, nil
+}
+
+// releaseAgent frees an agent to be seated again.
+func (h *Hub) releaseAgent(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.seatedAgents, c)
+}
+
+// detachAgent unhooks an agent from its bot, which stays in the room and
+// falls back to the built-in strategy. If notice is non-empty the agent is
+// told why with a ROOM_CLOSED message.
+// Must be called with lock held.
+func (r *Room) detachAgent(botID, notice string) {
+	a := r.agents[botID]
+	if a == nil {
+		return
+	}
+	c := a.conn
+	if notice != "" {
+		c.sendNotice("ROOM_CLOSED", notice)
+	}
+	delete(r.agents, botID)
+	c.room.Store(nil)
+	r.hub.releaseAgent(c)
+	r.logger().Info("agent detached", logKeyPlayer, botID, logKeyName, c.agent)
+}
+
+// detachAllAgents detaches every agent, e.g. when the room goes away.
+// Must be called with lock held.
+func (r *Room) detachAllAgents(notice string) {
+	for id := range r.agents {
+		r.detachAgent(id, notice)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// fixedStrategy makes the same move every time.
+type fixedStrategy string
+
+func (s fixedStrategy) ChooseWord(r *Room, bot *Player) string           { return string(s) }
+func (s fixedStrategy) Answer(r *Room, bot *Player, q GuessEntry) string { return string(s) }
+func (s fixedStrategy) Guess(r *Room, bot *Player) string                { return string(s) }
+func (s fixedStrategy) Vote(r *Room, bot *Player, phase string) string   { return string(s) }
+
+func TestAgentStrategyFallsBackWhenAgentIsSlow(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+	r := newRoom("ROOM", newTestHub(t))
+	conn := &Client{agent: "agent"}
+	s := newAgentStrategy(conn, timeout, fixedStrategy("fallback"))
+	bot := &Player{ID: "bot", IsBot: true}
+
+	if move := s.Guess(r, bot); move != "" {
+		t.Fatalf("Guess before the timeout = %q, want a pass", move)
+	}
+	time.Sleep(timeout)
+	if move := s.Guess(r, bot); move != "fallback" {
+		t.Fatalf("Guess after the timeout = %q, want the fallback's move", move)
+	}
+
+	// An agent that is busy sending commands isn't overruled
+	conn.lastCommand.Store(time.Now().UnixMilli())
+	if move := s.Guess(r, bot); move != "" {
+		t.Fatalf("Guess right after an agent command = %q, want a pass", move)
+	}
+
+	// Answers are due from when the question was asked
+	old := GuessEntry{PlayerID: "p1", Text: "is it big", Timestamp: time.Now().Add(-time.Second).UnixMilli()}
+	time.Sleep(timeout)
+	if move := s.Answer(r, bot, old); move != "fallback" {
+		t.Fatalf("Answer to an old question = %q, want the fallback's move", move)
+	}
+
+	// A new game starts every move's clock again
+	r.gameEpoch++
+	if move := s.Guess(r, bot); move != "" {
+		t.Fatalf("Guess in a new game = %q, want a pass", move)
+	}
+}
+
+func TestRoomStrategyForBot(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	a := newAgentStrategy(&Client{agent: "agent"}, time.Second, r.strategy)
+	r.agents["agent-bot"] = a
+
+	if got := r.strategyFor("agent-bot"); got != BotStrategy(a) {
+		t.Fatalf("strategyFor(agent-bot) = %T, want the agent's strategy", got)
+	}
+	if _, ok := r.strategyFor("other-bot").(builtinStrategy); !ok {
+		t.Fatalf("strategyFor(other-bot) = %T, want builtinStrategy", r.strategyFor("other-bot"))
+	}
+}
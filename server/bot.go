@@ -0,0 +1,479 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxBotNameLength = 20
+
+var botNames = []string{
+	"Luna", "Felix", "Shadow", "Maple", "Coco", "Mochi",
+	"Pepper", "Honey", "Biscuit", "Pumpkin", "Stormy", "Hazel",
+}
+
+// BotStrategy decides a bot's moves. Every method is called with the room
+// lock held and may read the room's state; returning "" passes.
+type BotStrategy interface {
+	// ChooseWord picks the secret word from the room's options (bot Mayor).
+	ChooseWord(r *Room, bot *Player) string
+	// Answer returns the Mayor's token for a player's question or guess.
+	Answer(r *Room, bot *Player, q GuessEntry) string
+	// Guess returns the bot's next question or guess.
+	Guess(r *Room, bot *Player) string
+	// Vote returns the player the bot votes for in the given phase.
+	Vote(r *Room, bot *Player, phase string) string
+}
+
+// builtinStrategy is the in-process bot. It is also the fallback of
+// agentStrategy, for external agents that don't act in time.
+type builtinStrategy struct{}
+
+func (builtinStrategy) ChooseWord(r *Room, bot *Player) string {
+	if len(r.wordOptions) == 0 {
+		return ""
+	}
+	return r.wordOptions[rand.Intn(len(r.wordOptions))]
+}
+
+// Answer judges guesses the way the room matches them, so a near-miss
+// spelling the room suggests as SO_CLOSE is only answered that way when
+// the word isn't known to be way off.
+func (builtinStrategy) Answer(r *Room, bot *Player, q GuessEntry) string {
+	return r.answerAsMayor(r.secretWord, q.Text)
+}
+
+func (builtinStrategy) Guess(r *Room, bot *Player) string {
+	return r.botGuessText(bot)
+}
+
+func (builtinStrategy) Vote(r *Room, bot *Player, phase string) string {
+	return r.botVoteTarget(bot, phase)
+}
+
+// ============================================================
+// Bot Management
+// ============================================================
+
+func (r *Room) handleAddBot(c *Client, payload AddBotPayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseLobby {
+		return
+	}
+	if len(r.players) >= maxPlayers {
+		return
+	}
+	skill, ok := parseBotSkill(payload.Skill)
+	if !ok {
+		c.sendError("Invalid bot skill")
+		return
+	}
+	personality, ok := parseBotPersonality(payload.Personality)
+	if !ok {
+		c.sendError("Unknown bot personality")
+		return
+	}
+
+	var agent *Client
+	if payload.Agent != "" {
+		var err error
+		if agent, err = r.hub.takeAgent(payload.Agent); err != nil {
+			c.sendError(err.Error())
+			return
+		}
+	}
+	r.addBot(skill, personality, agent)
+	r.broadcastState()
+}
+
+// addBot seats a bot, driven by agent if one is given.
+// Must be called with lock held.
+func (r *Room) addBot(skill, personality string, agent *Client) *Player {
+	botID := newUUID()
+	name := r.pickBotName()
+	if agent != nil {
+		// The agent's connection speaks for the bot, so they share an ID
+		botID = agent.playerID
+		name = agent.agent
+	}
+
+	player := &Player{
+		ID:             botID,
+		Name:           name,
+		Role:           RoleVillager,
+		IsMayor:        false,
+		IsReady:        true,
+		AvatarURL:      fmt.Sprintf("https://api.dicebear.com/7.x/adventurer/svg?seed=bot-%s&backgroundColor=b6e3f4,c0aede,d1d4f9,ffd5dc,ffdfbf", botID),
+		IsBot:          true,
+		BotSkill:       skill,
+		BotPersonality: personality,
+	}
+
+	// Bots go in players + order, but NOT in clients (no WS connection)
+	r.players[botID] = player
+	r.order = append(r.order, botID)
+	if agent != nil {
+		r.agents[botID] = newAgentStrategy(agent, r.hub.agentTimeout, r.strategy)
+		agent.room.Store(r)
+	}
+
+	r.logger().Info("bot added", logKeyPlayer, botID, logKeyName, name, "skill", skill, "personality", personality, "agent", agent != nil, "players", len(r.players))
+	return player
+}
+
+// lobbyBot returns the bot a lobby command targets, reporting problems to c.
+// Must be called with lock held.
+func (r *Room) lobbyBot(c *Client, botID string) *Player {
+	if r.phase != PhaseLobby {
+		c.sendError("Bots can only be changed in the lobby")
+		return nil
+	}
+	p := r.players[botID]
+	if p == nil || !p.IsBot {
+		c.sendError("No such bot")
+		return nil
+	}
+	return p
+}
+
+func (r *Room) handleRemoveBot(c *Client, payload RemoveBotPayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.lobbyBot(c, payload.BotID)
+	if p == nil {
+		return
+	}
+	r.logger().Info("bot removed", logKeyPlayer, p.ID, logKeyName, p.Name)
+	r.removePlayer(p.ID)
+}
+
+func (r *Room) handleConfigureBot(c *Client, payload ConfigureBotPayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.lobbyBot(c, payload.BotID)
+	if p == nil {
+		return
+	}
+
+	name := strings.TrimSpace(payload.Name)
+	if name != "" {
+		if utf8.RuneCountInString(name) > maxBotNameLength {
+			c.sendError(fmt.Sprintf("Bot names can be at most %d characters", maxBotNameLength))
+			return
+		}
+		for _, other := range r.players {
+			if other != p && strings.EqualFold(other.Name, name) {
+				c.sendError("That name is already taken")
+				return
+			}
+		}
+	}
+	skill := p.BotSkill
+	if payload.Skill != "" {
+		var ok bool
+		if skill, ok = parseBotSkill(payload.Skill); !ok {
+			c.sendError("Invalid bot skill")
+			return
+		}
+	}
+	personality := p.BotPersonality
+	if payload.Personality != "" {
+		var ok bool
+		if personality, ok = parseBotPersonality(payload.Personality); !ok {
+			c.sendError("Unknown bot personality")
+			return
+		}
+	}
+
+	if name != "" {
+		p.Name = name
+	}
+	p.BotSkill = skill
+	p.BotPersonality = personality
+	p.autoFilled = false // Customised bots stay when humans join
+
+	r.logger().Info("bot configured", logKeyPlayer, p.ID, logKeyName, p.Name, "skill", skill, "personality", personality)
+	r.broadcastState()
+}
+
+func (r *Room) handleAutoFillBots(c *Client, payload AutoFillBotsPayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseLobby {
+		c.sendError("Auto-fill can only be changed in the lobby")
+		return
+	}
+	r.autoFillBots = payload.Enabled
+	if !r.autoFillBots {
+		for _, id := range append([]string(nil), r.order...) {
+			if p := r.players[id]; p != nil && p.autoFilled {
+				r.dropPlayer(id)
+			}
+		}
+	}
+	r.balanceAutoBots()
+	r.logger().Info("bot auto-fill changed", "enabled", r.autoFillBots, "players", len(r.players))
+	r.broadcastState()
+}
+
+// balanceAutoBots tops the lobby up to minPlayers with bots when auto-fill
+// is on, and retires auto-filled bots again as humans take their place.
+// Must be called with lock held.
+func (r *Room) balanceAutoBots() {
+	if !r.autoFillBots || r.phase != PhaseLobby {
+		return
+	}
+	for len(r.players) < minPlayers {
+		personality, _ := parseBotPersonality("")
+		r.addBot(BotSkillNormal, personality, nil).autoFilled = true
+	}
+	for i := len(r.order) - 1; i >= 0 && len(r.players) > minPlayers; i-- {
+		if p := r.players[r.order[i]]; p != nil && p.autoFilled {
+			r.logger().Info("auto-filled bot retired", logKeyPlayer, p.ID, logKeyName, p.Name)
+			r.dropPlayer(p.ID)
+		}
+	}
+}
+
+func (r *Room) pickBotName() string {
+	usedNames := make(map[string]bool)
+	for _, p := range r.players {
+		usedNames[p.Name] = true
+	}
+	for _, n := range botNames {
+		if !usedNames[n] {
+			return n
+		}
+	}
+	return fmt.Sprintf("Bot-%d", rand.Intn(999))
+}
+
+// strategyFor returns the strategy playing a bot: its agent's if it has
+// one, otherwise the room's built-in one.
+// Must be called with lock held.
+func (r *Room) strategyFor(botID string) BotStrategy {
+	if a := r.agents[botID]; a != nil {
+		return a
+	}
+	return r.strategy
+}
+
+// ============================================================
+// Bot Actions
+// ============================================================
+
+// scheduleBotActions starts goroutines for bots to act in the current phase.
+// Must be called with lock held.
+func (r *Room) scheduleBotActions(epoch int) {
+	for _, id := range r.order {
+		if p := r.players[id]; p != nil && p.IsBot {
+			r.startBotTurn(p, epoch)
+		}
+	}
+}
+
+// startBotTurn starts the goroutine that plays a bot's part in the current
+// phase, if it has one. Each runner stops once the phase ends or the seat
+// is no longer a bot.
+// Must be called with lock held.
+func (r *Room) startBotTurn(p *Player, epoch int) {
+	switch {
+	case r.phase == PhaseWordSelection && p.IsMayor:
+		go r.runBotWordChoice(p.ID, epoch)
+	case r.phase == PhaseDayPhase && p.IsMayor:
+		go r.runBotMayor(p.ID, epoch)
+	case r.phase == PhaseDayPhase:
+		go r.runBotGuesser(p.ID, epoch)
+	case r.phase == PhaseVoting:
+		go r.runBotVote(p.ID, epoch, PhaseVoting)
+	case r.phase == PhaseWerewolfGuess && p.Role == RoleWerewolf:
+		go r.runBotVote(p.ID, epoch, PhaseWerewolfGuess)
+	}
+}
+
+// runBotMayor answers the players' questions and guesses one at a time,
+// oldest first.
+func (r *Room) runBotMayor(botID string, epoch int) {
+	for {
+		delay := time.Duration(2+rand.Intn(3)) * time.Second
+		select {
+		case <-time.After(delay):
+			r.mu.Lock()
+			if r.gameEpoch != epoch || r.phase != PhaseDayPhase {
+				r.mu.Unlock()
+				return
+			}
+			bot := r.players[botID]
+			var next *GuessEntry
+			for _, g := range r.guesses {
+				if next == nil || g.Timestamp < next.Timestamp {
+					next = g
+				}
+			}
+			if bot == nil || !bot.IsBot {
+				r.mu.Unlock()
+				return // Its player took the seat back
+			}
+			if next == nil {
+				r.mu.Unlock()
+				continue
+			}
+			answer := r.strategyFor(botID).Answer(r, bot, *next)
+			if answer == "" {
+				r.mu.Unlock()
+				continue
+			}
+			r.logger().Debug("bot mayor answered", logKeyPlayer, next.PlayerID, "question", next.Text, "token", answer)
+			r.recordToken(answer, next.PlayerID)
+			delete(r.guesses, next.PlayerID)
+			if answer == TokenCorrect {
+				r.guesses = make(map[string]*GuessEntry)
+				r.startWerewolfGuess()
+			} else {
+				r.broadcastState()
+			}
+			r.mu.Unlock()
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
+// runBotGuesser makes a non-Mayor bot ask questions and propose words
+// during the day phase, one at a time while it has nothing pending.
+func (r *Room) runBotGuesser(botID string, epoch int) {
+	for {
+		delay := 5 * time.Second
+		r.mu.Lock()
+		if p := r.players[botID]; p != nil {
+			delay = randomDelay(personalityOf(p).GuessDelay)
+		}
+		r.mu.Unlock()
+		select {
+		case <-time.After(delay):
+			r.mu.Lock()
+			if r.gameEpoch != epoch || r.phase != PhaseDayPhase {
+				r.mu.Unlock()
+				return
+			}
+			p := r.players[botID]
+			if p == nil || !p.IsBot {
+				r.mu.Unlock()
+				return
+			}
+			if r.guesses[botID] == nil {
+				if text := r.strategyFor(botID).Guess(r, p); text != "" {
+					r.logger().Debug("bot guessed", logKeyPlayer, botID, "text", text)
+					r.submitGuess(botID, text)
+				}
+			}
+			r.mu.Unlock()
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
+// runBotVote casts a bot's vote, asking its strategy again every second
+// while it passes.
+func (r *Room) runBotVote(botID string, epoch int, phase string) {
+	delay := 2 * time.Second
+	r.mu.Lock()
+	if p := r.players[botID]; p != nil {
+		delay = randomDelay(personalityOf(p).VoteDelay)
+	}
+	r.mu.Unlock()
+	for {
+		select {
+		case <-time.After(delay):
+			r.mu.Lock()
+			if r.gameEpoch != epoch || r.phase != phase {
+				r.mu.Unlock()
+				return
+			}
+			if _, hasVoted := r.votes[botID]; hasVoted {
+				r.mu.Unlock()
+				return
+			}
+			if p := r.players[botID]; p == nil || !p.IsBot {
+				r.mu.Unlock()
+				return
+			}
+			voted := r.castBotVote(botID, phase)
+			r.mu.Unlock()
+			if voted {
+				return
+			}
+			delay = time.Second
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
+// castBotVote records the strategy's vote for a bot, reporting whether
+// it voted. Must be called with lock held.
+func (r *Room) castBotVote(botID, phase string) bool {
+	bot := r.players[botID]
+	if bot == nil {
+		return false
+	}
+	targetID := r.strategyFor(botID).Vote(r, bot, phase)
+	target := r.players[targetID]
+	if target == nil || targetID == botID {
+		return false
+	}
+	r.votes[botID] = targetID
+	target.VotesReceived++
+
+	r.logger().Debug("bot voted", logKeyPlayer, botID, "target", targetID)
+
+	if phase == PhaseWerewolfGuess {
+		r.checkWerewolfGuessComplete()
+	} else {
+		r.checkVotingComplete()
+	}
+	r.broadcastState()
+	return true
+}
+
+// runBotWordChoice picks the secret word for a bot Mayor, asking its
+// strategy again every second while it passes.
+func (r *Room) runBotWordChoice(botID string, epoch int) {
+	delay := time.Duration(2+rand.Intn(3)) * time.Second
+	for {
+		select {
+		case <-time.After(delay):
+			r.mu.Lock()
+			if r.gameEpoch != epoch || r.phase != PhaseWordSelection || r.secretWord != "" {
+				r.mu.Unlock()
+				return
+			}
+			bot := r.players[botID]
+			if bot == nil || !bot.IsBot {
+				r.mu.Unlock()
+				return
+			}
+			if word := r.strategyFor(botID).ChooseWord(r, bot); word != "" {
+				r.secretWord = word
+				r.wordOptions = nil
+				r.wordChoices = nil
+				r.transitionToDayPhase()
+				r.mu.Unlock()
+				return
+			}
+			r.mu.Unlock()
+			delay = time.Second
+		case <-r.stopCh:
+			return
+		}
+	}
+}
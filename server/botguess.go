@@ -0,0 +1,270 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// AnsweredQuestion is a player's question or guess and the Mayor's token.
+type AnsweredQuestion struct {
+	PlayerID string
+	Text     string
+	Token    string
+}
+
+// botQuestions are the questions guessing bots ask. Each one must be
+// understood by answerAsMayor so bots can predict answers for candidates.
+var botQuestions = []string{
+	"Is it an animal?",
+	"Is it alive?",
+	"Can you eat it?",
+	"Is it a drink?",
+	"Is it a fruit?",
+	"Is it sweet?",
+	"Is it a place?",
+	"Is it a person?",
+	"Is it a feeling or idea?",
+	"Is it magical?",
+	"Is it man-made?",
+	"Is it bigger than a car?",
+	"Is it small enough to hold?",
+	"Is it found outdoors?",
+	"Does it live in water?",
+	"Can it fly?",
+	"Is it found in the sky?",
+	"Is it a kind of weather?",
+	"Is it in space?",
+	"Is it a plant?",
+	"Is it dangerous?",
+	"Does it make noise?",
+	"Is it hot?",
+	"Is it cold?",
+	"Is it round?",
+	"Is it fast?",
+	"Is it electric?",
+	"Is it a game?",
+	"Can you wear it?",
+	"Is it jewelry?",
+	"Is it a toy?",
+	"Is it a musical instrument?",
+	"Is it a vehicle?",
+	"Is it a pet?",
+	"Does it live on a farm?",
+	"Is it wild?",
+	"Is it part of the body?",
+	"Is it a holiday or event?",
+}
+
+// botGuessText picks a bot's next question or guess for its role: villagers
+// narrow the word down honestly, the Seer steers toward the secret word
+// without naming it, and werewolves waste the Mayor's time.
+// Must be called with lock held.
+func (r *Room) botGuessText(p *Player) string {
+	asked := make(map[string]bool)
+	for _, q := range r.answered {
+		asked[foldWord(q.Text)] = true
+	}
+	for _, g := range r.guesses {
+		asked[foldWord(g.Text)] = true
+	}
+	if !playsWell(p) {
+		// Asks or guesses whatever comes to mind
+		if text := pickUnasked(r.roomQuestions(), asked); text != "" && rand.Intn(2) == 0 {
+			return text
+		}
+		words := r.wordPool()
+		if p.Role != RoleVillager {
+			words = withoutWord(words, r.secretWord) // Knows better than to say it
+		}
+		return pickUnasked(words, asked)
+	}
+	candidates := r.candidateWords()
+
+	// The Seer and werewolves only tip their hand some of the time; the
+	// rest of the time they blend in, but never name the word themselves
+	blendIn := rand.Intn(2) == 0
+	switch {
+	case p.Role == RoleWerewolf && !blendIn:
+		return r.werewolfGuessText(candidates, asked)
+	case p.Role == RoleSeer && !blendIn:
+		return r.seerGuessText(candidates, asked)
+	case p.Role != RoleVillager:
+		return r.villagerGuessText(withoutWord(candidates, r.secretWord), asked)
+	default:
+		return r.villagerGuessText(candidates, asked)
+	}
+}
+
+// candidateWords returns the words from the room's difficulty that agree
+// with every answer the Mayor has given so far.
+// Must be called with lock held.
+func (r *Room) candidateWords() []string {
+	parsed := make([]playerMessage, len(r.answered))
+	for i, q := range r.answered {
+		parsed[i] = r.parseMessage(q.Text)
+	}
+	candidates := make([]string, 0)
+	for _, w := range r.wordPool() {
+		ok := true
+		for i, q := range r.answered {
+			if !answerConsistent(parsed[i].answer(r, w), q.Token) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			candidates = append(candidates, w)
+		}
+	}
+	return candidates
+}
+
+// answerConsistent reports whether a predicted answer could be what the
+// Mayor actually said. MAYBE agrees with anything, since Mayors differ.
+func answerConsistent(predicted, actual string) bool {
+	if predicted == actual || predicted == TokenMaybe || actual == TokenMaybe {
+		return true
+	}
+	switch {
+	case predicted == TokenCorrect || actual == TokenCorrect:
+		return false
+	case (predicted == TokenYes && actual == TokenNo) || (predicted == TokenNo && actual == TokenYes):
+		return false
+	case (predicted == TokenSoClose && actual == TokenWayOff) || (predicted == TokenWayOff && actual == TokenSoClose):
+		return false
+	}
+	return true
+}
+
+// rankedQuestion is an unasked question and how evenly it splits the
+// candidates: 0.5 halves them, 0 tells nothing.
+type rankedQuestion struct {
+	text  string
+	split float64
+	yes   map[string]bool // candidates the answer would be YES for
+}
+
+// roomQuestions returns the questions bots can ask in the room's language.
+// Must be called with lock held.
+func (r *Room) roomQuestions() []string {
+	if r.language != botQuestionLanguage {
+		return nil
+	}
+	return botQuestions
+}
+
+// Must be called with lock held.
+func (r *Room) rankQuestions(candidates []string, asked map[string]bool) []rankedQuestion {
+	questions := r.roomQuestions()
+	ranked := make([]rankedQuestion, 0, len(questions))
+	for _, q := range questions {
+		if asked[foldWord(q)] {
+			continue
+		}
+		msg := r.parseMessage(q)
+		yes := make(map[string]bool)
+		no := 0
+		for _, w := range candidates {
+			switch msg.answer(r, w) {
+			case TokenYes:
+				yes[w] = true
+			case TokenNo:
+				no++
+			}
+		}
+		split := 0.0
+		if len(candidates) > 0 {
+			split = float64(min(len(yes), no)) / float64(len(candidates))
+		}
+		ranked = append(ranked, rankedQuestion{text: q, split: split, yes: yes})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].split > ranked[j].split })
+	return ranked
+}
+
+// villagerGuessText asks one of the most informative questions, or guesses
+// once the field is small or no question helps any more.
+// Must be called with lock held.
+func (r *Room) villagerGuessText(candidates []string, asked map[string]bool) string {
+	ranked := r.rankQuestions(candidates, asked)
+	if len(candidates) > 3 && len(ranked) > 0 && ranked[0].split > 0 {
+		top := 0
+		for top < len(ranked) && top < 3 && ranked[top].split > 0 {
+			top++
+		}
+		return ranked[rand.Intn(top)].text
+	}
+	return pickUnasked(candidates, asked)
+}
+
+// seerGuessText asks informative questions the secret word answers YES to,
+// so the village moves toward it. The Seer never names the word itself;
+// when questions run dry it floats a near miss instead.
+// Must be called with lock held.
+func (r *Room) seerGuessText(candidates []string, asked map[string]bool) string {
+	for _, q := range r.rankQuestions(candidates, asked) {
+		if q.split > 0 && q.yes[r.secretWord] {
+			return q.text
+		}
+	}
+	nearMisses := make([]string, 0)
+	for _, w := range candidates {
+		if w != r.secretWord && r.judgeGuess(r.secretWord, w) == TokenSoClose {
+			nearMisses = append(nearMisses, w)
+		}
+	}
+	if text := pickUnasked(nearMisses, asked); text != "" {
+		return text
+	}
+	return r.villagerGuessText(withoutWord(candidates, r.secretWord), asked)
+}
+
+// werewolfGuessText sounds plausible but leads nowhere: questions the
+// secret word answers NO to, or guesses of words that are still possible
+// but nowhere near the secret word.
+// Must be called with lock held.
+func (r *Room) werewolfGuessText(candidates []string, asked map[string]bool) string {
+	if rand.Intn(2) == 0 {
+		for _, q := range r.rankQuestions(candidates, asked) {
+			if q.split > 0 && r.answerAsMayor(r.secretWord, q.text) == TokenNo {
+				return q.text
+			}
+		}
+	}
+	for _, pool := range [][]string{candidates, r.wordPool()} {
+		decoys := make([]string, 0)
+		for _, w := range pool {
+			if w != r.secretWord && r.judgeGuess(r.secretWord, w) != TokenSoClose {
+				decoys = append(decoys, w)
+			}
+		}
+		if text := pickUnasked(decoys, asked); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+// pickUnasked returns a random word nobody has guessed yet, or "".
+func pickUnasked(words []string, asked map[string]bool) string {
+	fresh := make([]string, 0, len(words))
+	for _, w := range words {
+		if !asked[foldWord(w)] {
+			fresh = append(fresh, w)
+		}
+	}
+	if len(fresh) == 0 {
+		return ""
+	}
+	return fresh[rand.Intn(len(fresh))]
+}
+
+func withoutWord(words []string, word string) []string {
+	out := make([]string, 0, len(words))
+	for _, w := range words {
+		if w != word {
+			out = append(out, w)
+		}
+	}
+	return out
+}
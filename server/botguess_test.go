@@ -0,0 +1,64 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBotMayorDoesNotCallWayOffSpellingsClose(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	r.difficulty = DifficultyEasy
+	r.secretWord = "House"
+	for _, w := range []string{"House", "Horse"} {
+		if !slices.Contains(r.packPool(), w) {
+			t.Fatalf("%q isn't an easy English word", w)
+		}
+	}
+	if got := r.matchGuess("Horse"); got != guessClose {
+		t.Fatalf("matchGuess(Horse) = %v, want guessClose", got)
+	}
+
+	q := GuessEntry{Text: "Horse", SuggestedToken: TokenSoClose}
+	if got := (builtinStrategy{}).Answer(r, nil, q); got != TokenWayOff {
+		t.Errorf("bot Mayor answered %s to Horse for House, want %s", got, TokenWayOff)
+	}
+
+	r.answered = []AnsweredQuestion{{Text: "Horse", Token: TokenWayOff}}
+	candidates := r.candidateWords()
+	if !slices.Contains(candidates, "House") {
+		t.Errorf("candidates after Horse was WAY_OFF dropped House: %v", candidates)
+	}
+	if slices.Contains(candidates, "Horse") {
+		t.Errorf("candidates after Horse was WAY_OFF still hold Horse")
+	}
+}
+
+func TestBotMayorCallsUnknownNearMissesClose(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	r.secretWord = "House"
+	if got := r.judgeGuess("House", "Hous"); got != TokenSoClose {
+		t.Errorf("judgeGuess(House, Hous) = %s, want %s", got, TokenSoClose)
+	}
+}
+
+func TestBotsOnlyAskQuestionsInEnglish(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	r.secretWord = "Horse"
+	if got := r.answerAsMayor("Horse", "Is it an animal?"); got != TokenYes {
+		t.Fatalf("English bot Mayor answered %s to Is it an animal? for Horse, want %s", got, TokenYes)
+	}
+
+	r.language = "es"
+	r.secretWord = "Gato"
+	if ranked := r.rankQuestions([]string{"Gato", "Perro"}, nil); len(ranked) != 0 {
+		t.Errorf("bots would ask %d English questions in a Spanish room", len(ranked))
+	}
+	for _, q := range []string{"Is it an animal?", "¿Es un animal?"} {
+		if got := r.answerAsMayor("Gato", q); got != TokenMaybe {
+			t.Errorf("Spanish bot Mayor answered %s to %q, want %s", got, q, TokenMaybe)
+		}
+	}
+	if got := r.answerAsMayor("Gato", "Gato"); got != TokenCorrect {
+		t.Errorf("Spanish bot Mayor answered %s to its own word, want %s", got, TokenCorrect)
+	}
+}
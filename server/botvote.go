@@ -0,0 +1,206 @@
+package main
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// botAccuracy is how often a bot of each skill acts on its best read of the
+// game; the rest of the time it plays a random (but legal) move.
+var botAccuracy = map[string]float64{
+	BotSkillEasy:   0.35,
+	BotSkillNormal: 0.7,
+	BotSkillHard:   0.95,
+}
+
+// playsWell rolls whether a bot makes its considered move this time.
+func playsWell(p *Player) bool {
+	accuracy, ok := botAccuracy[p.BotSkill]
+	if !ok {
+		accuracy = botAccuracy[BotSkillNormal]
+	}
+	return rand.Float64() < accuracy
+}
+
+// playerEvidence is what the table has seen of one player's questions and
+// guesses this game.
+type playerEvidence struct {
+	// suspicion grows with werewolf-like play: guessing words already ruled
+	// out, pointless questions, way-off guesses.
+	suspicion float64
+	// insight grows with Seer-like play: near misses, and narrow questions
+	// that hit while many words were still possible.
+	insight float64
+}
+
+// gatherEvidence replays the Mayor's answers in order, judging each
+// question against the words that were still possible when it was asked.
+// Must be called with lock held.
+func (r *Room) gatherEvidence() map[string]*playerEvidence {
+	evidence := make(map[string]*playerEvidence)
+	for _, id := range r.order {
+		evidence[id] = &playerEvidence{}
+	}
+
+	possible := append([]string(nil), r.wordPool()...)
+	for _, q := range r.answered {
+		e := evidence[q.PlayerID]
+		msg := r.parseMessage(q.Text)
+		if e != nil {
+			if guess := guessedWord(msg); guess != "" {
+				if !r.namesAny(guess, possible) {
+					e.suspicion += 2 // Already ruled out by earlier answers
+				}
+				switch q.Token {
+				case TokenSoClose:
+					e.insight += 2
+					e.suspicion--
+				case TokenWayOff:
+					e.suspicion++
+				}
+			} else {
+				yes, no := 0, 0
+				for _, w := range possible {
+					switch msg.answer(r, w) {
+					case TokenYes:
+						yes++
+					case TokenNo:
+						no++
+					}
+				}
+				if len(possible) > 0 {
+					split := float64(min(yes, no)) / float64(len(possible))
+					switch {
+					case split < 0.05:
+						e.suspicion++ // Tells the village nothing
+					case split >= 0.25:
+						e.suspicion -= 0.5
+					}
+					// A long shot that paid off is what a Seer would ask
+					if q.Token == TokenYes && len(possible) > 20 && float64(yes)/float64(len(possible)) < 0.2 {
+						e.insight += 2
+					} else if q.Token == TokenYes {
+						e.insight += split
+					}
+				}
+			}
+		}
+
+		narrowed := possible[:0:0]
+		for _, w := range possible {
+			if answerConsistent(msg.answer(r, w), q.Token) {
+				narrowed = append(narrowed, w)
+			}
+		}
+		possible = narrowed
+	}
+
+	for _, t := range r.tokenHistory {
+		if e := evidence[t.TargetPlayerID]; e != nil && t.Type == TokenCorrect {
+			e.insight++
+		}
+	}
+	return evidence
+}
+
+// guessedWord returns the word a message guesses, or "" for a question.
+func guessedWord(msg playerMessage) string {
+	if !msg.isQuestion {
+		return msg.text
+	}
+	if msg.named != "" && msg.namedKnown {
+		return msg.named
+	}
+	return ""
+}
+
+// namesAny reports whether a guess names any of words, as the room would
+// match it. Must be called with lock held.
+func (r *Room) namesAny(guess string, words []string) bool {
+	for _, w := range words {
+		if r.matchGuessTo(guess, w) == guessExact {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether words holds w, ignoring case.
+func containsFold(words []string, w string) bool {
+	for _, x := range words {
+		if sameText(x, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// botVoteTarget picks who a bot votes for. In the village vote werewolves
+// pile onto the most suspected villager and everyone else votes for the
+// most suspicious player; in the Seer hunt werewolves go after the most
+// insightful villager. A bot's personality adds weight to targets that
+// already have votes. Werewolves never vote for a fellow werewolf.
+// Must be called with lock held.
+func (r *Room) botVoteTarget(bot *Player, phase string) string {
+	targets := make([]string, 0)
+	for _, id := range r.order {
+		p := r.players[id]
+		if p == nil || id == bot.ID {
+			continue
+		}
+		if bot.Role == RoleWerewolf && p.Role == RoleWerewolf {
+			continue
+		}
+		targets = append(targets, id)
+	}
+	if len(targets) == 0 {
+		return ""
+	}
+	if !playsWell(bot) {
+		return targets[rand.Intn(len(targets))]
+	}
+
+	evidence := r.gatherEvidence()
+	bandwagon := personalityOf(bot).Bandwagon
+	score := func(id string) float64 {
+		e := evidence[id]
+		switch {
+		case phase == PhaseWerewolfGuess:
+			// Back up the pack's earlier votes so the hunt doesn't split
+			s := e.insight
+			for voter, target := range r.votes {
+				if target == id && r.players[voter] != nil && r.players[voter].Role == RoleWerewolf {
+					s += 0.5
+				}
+			}
+			return s
+		case bot.Role == RoleWerewolf:
+			return e.suspicion + (2+bandwagon)*float64(r.players[id].VotesReceived)
+		default:
+			return e.suspicion + bandwagon*float64(r.players[id].VotesReceived)
+		}
+	}
+
+	best := make([]string, 0)
+	bestScore := 0.0
+	for _, id := range targets {
+		s := score(id)
+		switch {
+		case len(best) == 0 || s > bestScore:
+			best, bestScore = []string{id}, s
+		case s == bestScore:
+			best = append(best, id)
+		}
+	}
+	return best[rand.Intn(len(best))]
+}
+
+// parseBotSkill validates a bot skill, defaulting to NORMAL.
+func parseBotSkill(skill string) (string, bool) {
+	skill = strings.ToUpper(strings.TrimSpace(skill))
+	if skill == "" {
+		return BotSkillNormal, true
+	}
+	_, ok := botAccuracy[skill]
+	return skill, ok
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Broker coordinates several server nodes: it records which node owns each
+// room and routes messages between nodes. A single node uses memoryBroker;
+// replicas behind a load balancer share a redisBroker.
+type Broker interface {
+	// Claim makes nodeID the owner of a room code. It reports false if
+	// another node already owns the code.
+	Claim(code, nodeID string) (bool, error)
+	// Refresh extends the claims a live node holds, so rooms of a crashed
+	// node eventually become free again.
+	Refresh(codes []string, nodeID string) error
+	// Release gives up a claim. Claims held by other nodes are left alone.
+	Release(code, nodeID string) error
+	// Owner returns the node that owns a room code, or "" if nobody does.
+	Owner(code string) (string, error)
+
+	// Advertise publishes the URL clients should use to reach nodeID directly.
+	Advertise(nodeID, url string) error
+	// NodeURL returns a node's advertised URL, or "" if unknown.
+	NodeURL(nodeID string) (string, error)
+
+	// ShareRooms records the rooms nodeID hosts, for other nodes' room
+	// browsers. Sharing none removes the record; a node that stops sharing
+	// drops out after the claim TTL.
+	ShareRooms(nodeID string, rooms []RoomInfo) error
+	// SharedRooms returns the rooms every live node has shared, by node ID.
+	SharedRooms() (map[string][]RoomInfo, error)
+
+	// Publish delivers a message to one node's subscription.
+	Publish(nodeID string, msg BrokerMessage) error
+	// Subscribe returns the stream of messages addressed to nodeID.
+	Subscribe(nodeID string) (<-chan BrokerMessage, error)
+
+	Close() error
+}
+
+// Broker message kinds for proxied client sessions.
+const (
+	BrokerSessionOpen   = "OPEN"   // origin → owner: a client wants to join a room
+	BrokerSessionClient = "CLIENT" // origin → owner: a client message
+	BrokerSessionServer = "SERVER" // owner → origin: a server message for the client
+	BrokerSessionClose  = "CLOSE"  // either way: the session is over
+)
+
+// BrokerMessage is one hop of a proxied session between two nodes.
+type BrokerMessage struct {
+	Kind    string          `json:"kind"`
+	Session string          `json:"session"`
+	From    string          `json:"from"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// newBroker returns the broker for a BROKER value: "memory" or a
+// "redis://host:port[/db]" URL.
+func newBroker(spec string, claimTTL time.Duration) (Broker, error) {
+	switch {
+	case spec == "" || spec == "memory":
+		return newMemoryBroker(), nil
+	case strings.HasPrefix(spec, "redis://"):
+		return newRedisBroker(spec, claimTTL)
+	default:
+		return nil, fmt.Errorf("unknown broker %q (want memory or redis://host:port)", spec)
+	}
+}
+
+// ============================================================
+// In-memory broker
+// ============================================================
+
+// memoryBroker keeps everything in process. It is the default for a single
+// node, and several hubs in one process can share one to simulate a cluster.
+type memoryBroker struct {
+	mu     sync.Mutex
+	owners map[string]string
+	urls   map[string]string
+	rooms  map[string][]RoomInfo
+	subs   map[string]chan BrokerMessage
+}
+
+func newMemoryBroker() *memoryBroker {
+	return &memoryBroker{
+		owners: make(map[string]string),
+		urls:   make(map[string]string),
+		rooms:  make(map[string][]RoomInfo),
+		subs:   make(map[string]chan BrokerMessage),
+	}
+}
+
+func (b *memoryBroker) Claim(code, nodeID string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if owner, ok := b.owners[code]; ok && owner != nodeID {
+		return false, nil
+	}
+	b.owners[code] = nodeID
+	return true, nil
+}
+
+func (b *memoryBroker) Refresh(codes []string, nodeID string) error {
+	return nil // Claims never expire in process
+}
+
+func (b *memoryBroker) Release(code, nodeID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.owners[code] == nodeID {
+		delete(b.owners, code)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Owner(code string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.owners[code], nil
+}
+
+func (b *memoryBroker) Advertise(nodeID, url string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.urls[nodeID] = url
+	return nil
+}
+
+func (b *memoryBroker) NodeURL(nodeID string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.urls[nodeID], nil
+}
+
+func (b *memoryBroker) ShareRooms(nodeID string, rooms []RoomInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(rooms) == 0 {
+		delete(b.rooms, nodeID)
+	} else {
+		b.rooms[nodeID] = rooms
+	}
+	return nil
+}
+
+func (b *memoryBroker) SharedRooms() (map[string][]RoomInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	shared := make(map[string][]RoomInfo, len(b.rooms))
+	for node, rooms := range b.rooms {
+		shared[node] = rooms
+	}
+	return shared, nil
+}
+
+func (b *memoryBroker) Publish(nodeID string, msg BrokerMessage) error {
+	b.mu.Lock()
+	ch := b.subs[nodeID]
+	b.mu.Unlock()
+	if ch == nil {
+		return fmt.Errorf("node %s is not subscribed", nodeID)
+	}
+	select {
+	case ch <- msg:
+		return nil
+	default:
+		return fmt.Errorf("node %s inbox is full", nodeID)
+	}
+}
+
+func (b *memoryBroker) Subscribe(nodeID string) (<-chan BrokerMessage, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[nodeID]; ok {
+		return nil, fmt.Errorf("node %s is already subscribed", nodeID)
+	}
+	ch := make(chan BrokerMessage, 1024)
+	b.subs[nodeID] = ch
+	return ch, nil
+}
+
+func (b *memoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for id, ch := range b.subs {
+		close(ch)
+		delete(b.subs, id)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redisBroker implements Broker on any server speaking the Redis protocol
+// (Redis, Valkey, KeyDB, or an in-process stand-in such as miniredis).
+// It uses only SET/GET/EXPIRE/HSET/HGETALL/HDEL/PUBLISH/SUBSCRIBE plus
+// EVAL of the two compare-and-act scripts below, so stand-ins work.
+//
+//	werewords:room:<code>  → owning node ID, expires after claimTTL
+//	werewords:node:<id>    → node URL, expires after claimTTL
+//	werewords:rooms        → hash of node ID → that node's sharedRooms
+//	werewords:inbox:<id>   → pub/sub channel for messages to a node
+type redisBroker struct {
+	addr     string
+	db       int
+	password string
+	claimTTL time.Duration
+
+	mu   sync.Mutex // guards cmd
+	cmd  *respConn
+	subs []*respConn
+}
+
+const redisDialTimeout = 5 * time.Second
+
+// Scripts that act on a room claim only if this node still holds it. Doing
+// the check and the change in one EVAL means a claim that expires and is
+// taken by another node in between is never deleted or extended.
+const (
+	redisReleaseScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`
+	redisRefreshScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("EXPIRE", KEYS[1], ARGV[2]) end return 0`
+)
+
+func newRedisBroker(rawURL string, claimTTL time.Duration) (*redisBroker, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing broker URL: %w", err)
+	}
+	b := &redisBroker{addr: u.Host, claimTTL: claimTTL}
+	if b.addr == "" {
+		b.addr = "localhost:6379"
+	} else if !strings.Contains(b.addr, ":") {
+		b.addr += ":6379"
+	}
+	if p, ok := u.User.Password(); ok {
+		b.password = p
+	}
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if b.db, err = strconv.Atoi(db); err != nil {
+			return nil, fmt.Errorf("invalid database %q in broker URL", db)
+		}
+	}
+	if _, err := b.do("PING"); err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", b.addr, err)
+	}
+	return b, nil
+}
+
+const sharedRoomsKey = "werewords:rooms"
+
+// sharedRooms is one node's entry in werewords:rooms. Hash fields can't
+// expire, so entries older than claimTTL are treated as gone.
+type sharedRooms struct {
+	UpdatedAt int64      `json:"updatedAt"` // Unix ms
+	Rooms     []RoomInfo `json:"rooms"`
+}
+
+func roomKey(code string) string    { return "werewords:room:" + code }
+func nodeKey(nodeID string) string  { return "werewords:node:" + nodeID }
+func inboxKey(nodeID string) string { return "werewords:inbox:" + nodeID }
+
+func (b *redisBroker) ttlSeconds() string {
+	secs := int(b.claimTTL.Seconds())
+	if secs < 1 {
+		secs = 1
+	}
+	return strconv.Itoa(secs)
+}
+
+func (b *redisBroker) Claim(code, nodeID string) (bool, error) {
+	reply, err := b.do("SET", roomKey(code), nodeID, "NX", "EX", b.ttlSeconds())
+	if err != nil {
+		return false, err
+	}
+	if reply != nil {
+		return true, nil
+	}
+	owner, err := b.Owner(code)
+	return owner == nodeID, err
+}
+
+func (b *redisBroker) Refresh(codes []string, nodeID string) error {
+	for _, code := range codes {
+		owner, err := b.Owner(code)
+		if err != nil {
+			return err
+		}
+		if owner == "" {
+			// Expired while we were unreachable; take it back if nobody else has
+			if _, err := b.Claim(code, nodeID); err != nil {
+				return err
+			}
+			continue
+		}
+		if owner != nodeID {
+			slog.Warn("room claim lost to another node", logKeyComponent, "broker", logKeyRoom, code, "owner", owner)
+			continue
+		}
+		if _, err := b.do("EVAL", redisRefreshScript, "1", roomKey(code), nodeID, b.ttlSeconds()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *redisBroker) Release(code, nodeID string) error {
+	_, err := b.do("EVAL", redisReleaseScript, "1", roomKey(code), nodeID)
+	return err
+}
+
+func (b *redisBroker) Owner(code string) (string, error) {
+	reply, err := b.do("GET", roomKey(code))
+	if err != nil || reply == nil {
+		return "", err
+	}
+	s, _ := reply.(string)
+	return s, nil
+}
+
+func (b *redisBroker) Advertise(nodeID, url string) error {
+	_, err := b.do("SET", nodeKey(nodeID), url, "EX", b.ttlSeconds())
+	return err
+}
+
+func (b *redisBroker) NodeURL(nodeID string) (string, error) {
+	reply, err := b.do("GET", nodeKey(nodeID))
+	if err != nil || reply == nil {
+		return "", err
+	}
+	s, _ := reply.(string)
+	return s, nil
+}
+
+func (b *redisBroker) ShareRooms(nodeID string, rooms []RoomInfo) error {
+	if len(rooms) == 0 {
+		_, err := b.do("HDEL", sharedRoomsKey, nodeID)
+		return err
+	}
+	data, err := json.Marshal(sharedRooms{UpdatedAt: time.Now().UnixMilli(), Rooms: rooms})
+	if err != nil {
+		return err
+	}
+	_, err = b.do("HSET", sharedRoomsKey, nodeID, string(data))
+	return err
+}
+
+func (b *redisBroker) SharedRooms() (map[string][]RoomInfo, error) {
+	reply, err := b.do("HGETALL", sharedRoomsKey)
+	if err != nil {
+		return nil, err
+	}
+	fields, _ := reply.([]interface{})
+	shared := make(map[string][]RoomInfo)
+	cutoff := time.Now().Add(-b.claimTTL).UnixMilli()
+	for i := 0; i+1 < len(fields); i += 2 {
+		node, _ := fields[i].(string)
+		raw, _ := fields[i+1].(string)
+		var entry sharedRooms
+		if err := json.Unmarshal([]byte(raw), &entry); err != nil || entry.UpdatedAt < cutoff {
+			// A node that crashed without clearing its entry
+			if _, err := b.do("HDEL", sharedRoomsKey, node); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		shared[node] = entry.Rooms
+	}
+	return shared, nil
+}
+
+func (b *redisBroker) Publish(nodeID string, msg BrokerMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	receivers, err := b.do("PUBLISH", inboxKey(nodeID), string(data))
+	if err != nil {
+		return err
+	}
+	if n, _ := receivers.(int64); n == 0 {
+		return fmt.Errorf("node %s is not subscribed", nodeID)
+	}
+	return nil
+}
+
+// Subscribe opens a dedicated connection in subscriber mode. The returned
+// channel closes when that connection drops.
+func (b *redisBroker) Subscribe(nodeID string) (<-chan BrokerMessage, error) {
+	conn, err := b.dial()
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.write("SUBSCRIBE", inboxKey(nodeID)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if _, err := conn.read(); err != nil { // subscription confirmation
+		conn.Close()
+		return nil, err
+	}
+	b.mu.Lock()
+	b.subs = append(b.subs, conn)
+	b.mu.Unlock()
+
+	out := make(chan BrokerMessage, 1024)
+	go func() {
+		defer close(out)
+		for {
+			reply, err := conn.read()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					slog.Error("broker subscription lost", logKeyComponent, "broker", "error", err)
+				}
+				return
+			}
+			parts, ok := reply.([]interface{})
+			if !ok || len(parts) != 3 || parts[0] != "message" {
+				continue
+			}
+			payload, _ := parts[2].(string)
+			var msg BrokerMessage
+			if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+				continue
+			}
+			out <- msg
+		}
+	}()
+	return out, nil
+}
+
+func (b *redisBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, c := range b.subs {
+		c.Close()
+	}
+	b.subs = nil
+	if b.cmd != nil {
+		b.cmd.Close()
+		b.cmd = nil
+	}
+	return nil
+}
+
+// do runs one command on the shared command connection, redialling once if
+// the connection has gone away.
+func (b *redisBroker) do(args ...string) (interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for attempt := 0; attempt < 2; attempt++ {
+		if b.cmd == nil {
+			conn, err := b.dial()
+			if err != nil {
+				return nil, err
+			}
+			b.cmd = conn
+		}
+		reply, err := b.cmd.roundTrip(args...)
+		var respErr respError
+		if err == nil || errors.As(err, &respErr) {
+			return reply, err
+		}
+		b.cmd.Close()
+		b.cmd = nil
+	}
+	return nil, fmt.Errorf("broker %s unreachable", b.addr)
+}
+
+func (b *redisBroker) dial() (*respConn, error) {
+	nc, err := net.DialTimeout("tcp", b.addr, redisDialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	conn := &respConn{Conn: nc, r: bufio.NewReader(nc)}
+	if b.password != "" {
+		if _, err := conn.roundTrip("AUTH", b.password); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if b.db != 0 {
+		if _, err := conn.roundTrip("SELECT", strconv.Itoa(b.db)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// ============================================================
+// Minimal RESP2 client
+// ============================================================
+
+type respError string
+
+func (e respError) Error() string { return "redis: " + string(e) }
+
+type respConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *respConn) roundTrip(args ...string) (interface{}, error) {
+	c.SetDeadline(time.Now().Add(redisDialTimeout))
+	defer c.SetDeadline(time.Time{})
+	if err := c.write(args...); err != nil {
+		return nil, err
+	}
+	return c.read()
+}
+
+func (c *respConn) write(args ...string) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "*%d\r\n", len(args))
+	for _, a := range args {
+		fmt.Fprintf(&sb, "$%d\r\n%s\r\n", len(a), a)
+	}
+	_, err := io.WriteString(c.Conn, sb.String())
+	return err
+}
+
+// read parses one reply: simple strings and bulk strings become string,
+// integers int64, arrays []interface{}, nil replies nil.
+func (c *respConn) read() (interface{}, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("redis: empty reply")
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, respError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = c.read(); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("redis: unexpected reply %q", line)
+	}
+}
@@ -0,0 +1,439 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// redisStandIn is a tiny in-process server speaking enough of the Redis
+// protocol for redisBroker: PING, AUTH, SELECT, SET (NX, EX), GET, DEL,
+// EXPIRE, HSET, HGETALL, HDEL, EVAL of the broker's own scripts, PUBLISH
+// and SUBSCRIBE.
+type redisStandIn struct {
+	ln net.Listener
+
+	mu      sync.Mutex
+	values  map[string]string
+	expires map[string]time.Time
+	hashes  map[string]map[string]string
+	subs    map[string][]net.Conn // channel → subscribed connections
+}
+
+func newRedisStandIn(t *testing.T) *redisStandIn {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &redisStandIn{
+		ln:      ln,
+		values:  make(map[string]string),
+		expires: make(map[string]time.Time),
+		hashes:  make(map[string]map[string]string),
+		subs:    make(map[string][]net.Conn),
+	}
+	go s.serve()
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *redisStandIn) url() string {
+	return "redis://" + s.ln.Addr().String()
+}
+
+// expire drops a key as if its TTL had run out.
+func (s *redisStandIn) expire(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	delete(s.expires, key)
+}
+
+// dropSubscribers closes every subscriber connection, as a broker restart
+// or network blip would.
+func (s *redisStandIn) dropSubscribers() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch, conns := range s.subs {
+		for _, c := range conns {
+			c.Close()
+		}
+		delete(s.subs, ch)
+	}
+}
+
+func (s *redisStandIn) subscribers(channel string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subs[channel])
+}
+
+func (s *redisStandIn) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *redisStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		reply := s.exec(conn, args)
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "*"), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if line, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "$"), "\r\n"))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+func bulk(s string) string { return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s) }
+
+const nilBulk = "$-1\r\n"
+
+func (s *redisStandIn) exec(conn net.Conn, args []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "AUTH", "SELECT":
+		return "+OK\r\n"
+	case "SET":
+		key, value := args[1], args[2]
+		var nx bool
+		var ttl time.Duration
+		for i := 3; i < len(args); i++ {
+			switch strings.ToUpper(args[i]) {
+			case "NX":
+				nx = true
+			case "EX":
+				secs, _ := strconv.Atoi(args[i+1])
+				ttl = time.Duration(secs) * time.Second
+				i++
+			}
+		}
+		if _, ok := s.get(key); ok && nx {
+			return nilBulk
+		}
+		s.values[key] = value
+		delete(s.expires, key)
+		if ttl > 0 {
+			s.expires[key] = time.Now().Add(ttl)
+		}
+		return "+OK\r\n"
+	case "GET":
+		if v, ok := s.get(args[1]); ok {
+			return bulk(v)
+		}
+		return nilBulk
+	case "DEL":
+		return ":" + strconv.Itoa(s.del(args[1])) + "\r\n"
+	case "EXPIRE":
+		return ":" + strconv.Itoa(s.setExpiry(args[1], args[2])) + "\r\n"
+	case "HSET":
+		h := s.hashes[args[1]]
+		if h == nil {
+			h = make(map[string]string)
+			s.hashes[args[1]] = h
+		}
+		_, existed := h[args[2]]
+		h[args[2]] = args[3]
+		if existed {
+			return ":0\r\n"
+		}
+		return ":1\r\n"
+	case "HGETALL":
+		h := s.hashes[args[1]]
+		reply := fmt.Sprintf("*%d\r\n", 2*len(h))
+		for field, value := range h {
+			reply += bulk(field) + bulk(value)
+		}
+		return reply
+	case "HDEL":
+		if _, ok := s.hashes[args[1]][args[2]]; !ok {
+			return ":0\r\n"
+		}
+		delete(s.hashes[args[1]], args[2])
+		return ":1\r\n"
+	case "EVAL":
+		// Only the broker's scripts; args are script, 1, key, node[, ttl]
+		key, node := args[3], args[4]
+		if v, ok := s.get(key); !ok || v != node {
+			return ":0\r\n"
+		}
+		switch args[1] {
+		case redisReleaseScript:
+			return ":" + strconv.Itoa(s.del(key)) + "\r\n"
+		case redisRefreshScript:
+			return ":" + strconv.Itoa(s.setExpiry(key, args[5])) + "\r\n"
+		}
+		return "-ERR unknown script\r\n"
+	case "PUBLISH":
+		channel, msg := args[1], args[2]
+		push := "*3\r\n" + bulk("message") + bulk(channel) + bulk(msg)
+		for _, c := range s.subs[channel] {
+			io.WriteString(c, push)
+		}
+		return ":" + strconv.Itoa(len(s.subs[channel])) + "\r\n"
+	case "SUBSCRIBE":
+		s.subs[args[1]] = append(s.subs[args[1]], conn)
+		return "*3\r\n" + bulk("subscribe") + bulk(args[1]) + ":1\r\n"
+	}
+	return "-ERR unknown command '" + args[0] + "'\r\n"
+}
+
+// get returns a live value. Must be called with s.mu held.
+func (s *redisStandIn) get(key string) (string, bool) {
+	if at, ok := s.expires[key]; ok && time.Now().After(at) {
+		delete(s.values, key)
+		delete(s.expires, key)
+	}
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// del removes a key, returning how many were removed. Must be called with s.mu held.
+func (s *redisStandIn) del(key string) int {
+	if _, ok := s.get(key); !ok {
+		return 0
+	}
+	delete(s.values, key)
+	delete(s.expires, key)
+	return 1
+}
+
+// setExpiry sets a key's TTL in seconds. Must be called with s.mu held.
+func (s *redisStandIn) setExpiry(key, secs string) int {
+	if _, ok := s.get(key); !ok {
+		return 0
+	}
+	n, _ := strconv.Atoi(secs)
+	s.expires[key] = time.Now().Add(time.Duration(n) * time.Second)
+	return 1
+}
+
+func TestMain(m *testing.M) {
+	brokerRetryDelay = 10 * time.Millisecond // Resubscribe quickly after a dropped inbox
+	os.Exit(m.Run())
+}
+
+func newTestRedisBroker(t *testing.T, s *redisStandIn) *redisBroker {
+	t.Helper()
+	b, err := newRedisBroker(s.url(), 30*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+func mustOwner(t *testing.T, b Broker, code, want string) {
+	t.Helper()
+	owner, err := b.Owner(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != want {
+		t.Fatalf("Owner(%s) = %q, want %q", code, owner, want)
+	}
+}
+
+func TestRedisBrokerClaimAndRelease(t *testing.T) {
+	s := newRedisStandIn(t)
+	a, b := newTestRedisBroker(t, s), newTestRedisBroker(t, s)
+
+	mustOwner(t, a, "ROOM", "")
+	if ok, err := a.Claim("ROOM", "node-a"); err != nil || !ok {
+		t.Fatalf("first claim = %v, %v; want true", ok, err)
+	}
+	if ok, err := b.Claim("ROOM", "node-b"); err != nil || ok {
+		t.Fatalf("claim of a taken code = %v, %v; want false", ok, err)
+	}
+	if ok, err := a.Claim("ROOM", "node-a"); err != nil || !ok {
+		t.Fatalf("reclaim by the owner = %v, %v; want true", ok, err)
+	}
+	mustOwner(t, b, "ROOM", "node-a")
+
+	if err := b.Release("ROOM", "node-b"); err != nil {
+		t.Fatal(err)
+	}
+	mustOwner(t, a, "ROOM", "node-a")
+
+	if err := a.Release("ROOM", "node-a"); err != nil {
+		t.Fatal(err)
+	}
+	mustOwner(t, a, "ROOM", "")
+	if ok, err := b.Claim("ROOM", "node-b"); err != nil || !ok {
+		t.Fatalf("claim after release = %v, %v; want true", ok, err)
+	}
+}
+
+func TestRedisBrokerKeepsClaimTakenAfterExpiry(t *testing.T) {
+	s := newRedisStandIn(t)
+	a, b := newTestRedisBroker(t, s), newTestRedisBroker(t, s)
+
+	if ok, _ := a.Claim("ROOM", "node-a"); !ok {
+		t.Fatal("claim failed")
+	}
+	// node-a's claim lapses and node-b takes the code
+	s.expire(roomKey("ROOM"))
+	if ok, _ := b.Claim("ROOM", "node-b"); !ok {
+		t.Fatal("claim after expiry failed")
+	}
+
+	if err := a.Release("ROOM", "node-a"); err != nil {
+		t.Fatal(err)
+	}
+	mustOwner(t, b, "ROOM", "node-b")
+	if err := a.Refresh([]string{"ROOM"}, "node-a"); err != nil {
+		t.Fatal(err)
+	}
+	mustOwner(t, b, "ROOM", "node-b")
+}
+
+func TestRedisBrokerSharedRooms(t *testing.T) {
+	s := newRedisStandIn(t)
+	a, b := newTestRedisBroker(t, s), newTestRedisBroker(t, s)
+
+	if err := a.ShareRooms("node-a", []RoomInfo{{Code: "ROOM-A", Phase: PhaseLobby}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.ShareRooms("node-b", []RoomInfo{{Code: "ROOM-B", Phase: PhaseDayPhase}}); err != nil {
+		t.Fatal(err)
+	}
+	// An entry left behind by a node that crashed long ago
+	stale, _ := json.Marshal(sharedRooms{UpdatedAt: time.Now().Add(-time.Hour).UnixMilli(), Rooms: []RoomInfo{{Code: "GONE"}}})
+	if _, err := a.do("HSET", sharedRoomsKey, "node-c", string(stale)); err != nil {
+		t.Fatal(err)
+	}
+
+	shared, err := a.SharedRooms()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shared) != 2 || shared["node-a"][0].Code != "ROOM-A" || shared["node-b"][0].Code != "ROOM-B" {
+		t.Fatalf("shared rooms = %+v, want ROOM-A on node-a and ROOM-B on node-b", shared)
+	}
+
+	if err := b.ShareRooms("node-b", nil); err != nil {
+		t.Fatal(err)
+	}
+	if shared, _ = a.SharedRooms(); len(shared) != 1 {
+		t.Fatalf("shared rooms after node-b cleared its entry = %+v", shared)
+	}
+}
+
+func TestRedisBrokerPublishSubscribe(t *testing.T) {
+	s := newRedisStandIn(t)
+	a, b := newTestRedisBroker(t, s), newTestRedisBroker(t, s)
+
+	inbox, err := a.Subscribe("node-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := BrokerMessage{Kind: BrokerSessionClient, Session: "s1", From: "node-b", Data: json.RawMessage(`{"type":"VOTE"}`)}
+	if err := b.Publish("node-a", sent); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-inbox:
+		if got.Kind != sent.Kind || got.Session != sent.Session || got.From != sent.From || string(got.Data) != string(sent.Data) {
+			t.Fatalf("received %+v, want %+v", got, sent)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("message not delivered")
+	}
+
+	if err := b.Publish("node-c", sent); err == nil {
+		t.Fatal("publish to a node nobody subscribed as succeeded")
+	}
+
+	s.dropSubscribers()
+	select {
+	case _, ok := <-inbox:
+		if ok {
+			t.Fatal("unexpected message after the subscription dropped")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("inbox not closed when the subscription dropped")
+	}
+}
+
+func TestClusterInboxResubscribes(t *testing.T) {
+	s := newRedisStandIn(t)
+	h := newTestHub(t)
+	if err := h.joinCluster(newTestRedisBroker(t, s), "node-a", "", RoutingProxy, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	defer h.leaveCluster()
+
+	// A local socket proxied to a room on node-b
+	c := &Client{hub: h, send: make(chan []byte, 4)}
+	h.mu.Lock()
+	h.proxied["s1"] = c
+	h.mu.Unlock()
+
+	s.dropSubscribers()
+	other := newTestRedisBroker(t, s)
+	msg := BrokerMessage{Kind: BrokerSessionServer, Session: "s1", From: "node-b", Data: json.RawMessage(`{"type":"STATE_UPDATE"}`)}
+	deadline := time.Now().Add(2 * time.Second)
+	for other.Publish("node-a", msg) != nil {
+		if time.Now().After(deadline) {
+			t.Fatalf("inbox not resubscribed (subscribers: %d)", s.subscribers(inboxKey("node-a")))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	select {
+	case data := <-c.send:
+		if string(data) != string(msg.Data) {
+			t.Fatalf("proxied client got %s, want %s", data, msg.Data)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("message not routed after resubscribing")
+	}
+}
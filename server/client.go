@@ -2,7 +2,8 @@ package main
 
 import (
 	"encoding/json"
-	"log"
+	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -17,17 +18,37 @@ const (
 
 type Client struct {
 	hub      *Hub
-	room     *Room
-	conn     *websocket.Conn
+	room     atomic.Pointer[Room] // set and cleared from room and admin goroutines too
+	conn     *websocket.Conn      // nil for stand-ins of players on another node
 	send     chan []byte
 	playerID string
+
+	// agent is the name of the external bot agent on this connection, if
+	// any; lastCommand is when it last sent a message (Unix ms).
+	agent       string
+	lastCommand atomic.Int64
+
+	// Cluster routing: proxy is set on the node holding the socket while
+	// its room lives on another node, and is guarded by hub.mu. remote is
+	// set on the owner node's stand-in for that player when it's created,
+	// and never changes.
+	proxy  *sessionLink
+	remote *sessionLink
 }
 
 func (c *Client) readPump() {
 	defer func() {
-		if c.room != nil {
-			c.room.removeClient(c)
+		if link := c.hub.proxyLink(c); link != nil {
+			c.hub.forward(link, BrokerSessionClose, nil)
+			c.hub.dropProxy(link.id)
+		}
+		if room := c.room.Load(); room != nil {
+			room.removeClient(c)
+		}
+		if c.agent != "" {
+			c.hub.unregisterAgent(c)
 		}
+		c.hub.unregister(c)
 		c.conn.Close()
 	}()
 
@@ -42,18 +63,30 @@ func (c *Client) readPump() {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
-				log.Printf("WebSocket read error: %v", err)
+				c.logger().Warn("websocket read error", "error", err)
 			}
 			break
 		}
 
-		var msg ClientMessage
-		if err := json.Unmarshal(message, &msg); err != nil {
-			c.sendError("Invalid message format")
+		// While proxied, the room's owner node interprets every message
+		if link := c.hub.proxyLink(c); link != nil {
+			c.hub.forward(link, BrokerSessionClient, message)
 			continue
 		}
-		c.handleMessage(msg)
+		c.handleRaw(message)
+	}
+}
+
+// handleRaw decodes and dispatches one client message.
+func (c *Client) handleRaw(message []byte) {
+	var msg ClientMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		c.sendError("Invalid message format")
+		return
 	}
+	metrics.messagesIn.inc(messageTypeLabel(msg.Type))
+	c.logger().Debug("message received", logKeyMsgType, msg.Type)
+	c.handleMessage(msg)
 }
 
 func (c *Client) writePump() {
@@ -83,7 +116,50 @@ func (c *Client) writePump() {
 	}
 }
 
+// logger returns a logger carrying the client's player ID and room code.
+func (c *Client) logger() *slog.Logger {
+	l := slog.Default().With(logKeyComponent, "client", logKeyPlayer, c.playerID)
+	if c.agent != "" {
+		l = l.With("agent", c.agent)
+	}
+	if room := c.room.Load(); room != nil {
+		l = l.With(logKeyRoom, room.code)
+	}
+	return l
+}
+
+// clientMessageTypes are the message types handleMessage understands. Only
+// these are used as metric labels, since msg.Type comes from the client.
+var clientMessageTypes = map[string]bool{
+	"JOIN_GAME": true, "LIST_ROOMS": true, "TOGGLE_READY": true, "TOGGLE_WANTS_MAYOR": true,
+	"START_GAME": true, "ADD_BOT": true, "REMOVE_BOT": true, "CONFIGURE_BOT": true,
+	"AUTO_FILL_BOTS": true, "SUBMIT_GUESS": true, "CHOOSE_WORD": true, "SUBMIT_TOKEN": true,
+	"VOTE": true, "RESET_GAME": true, "SEND_REACTION": true, "REVEAL_HINT": true,
+	"SET_DIFFICULTY": true, "SET_LANGUAGE": true, "SET_CUSTOM_WORDS": true, "SET_WORD_SOURCE": true,
+	"SET_WORD_OPTIONS": true, "REROLL_WORDS": true,
+}
+
+// messageTypeLabel is msg.Type for known types and "OTHER" for anything else.
+func messageTypeLabel(msgType string) string {
+	if clientMessageTypes[msgType] {
+		return msgType
+	}
+	return "OTHER"
+}
+
 func (c *Client) handleMessage(msg ClientMessage) {
+	room := c.room.Load()
+	if room != nil {
+		room.touch()
+	}
+	if c.agent != "" {
+		if !agentMessageTypes[msg.Type] {
+			c.sendError("Agents can only play their own seat, not send " + msg.Type)
+			return
+		}
+		c.lastCommand.Store(time.Now().UnixMilli())
+	}
+
 	switch msg.Type {
 	case "JOIN_GAME":
 		var payload JoinGamePayload
@@ -102,35 +178,78 @@ func (c *Client) handleMessage(msg ClientMessage) {
 		c.sendRoomList(rooms)
 
 	case "TOGGLE_READY":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
-		c.room.handleToggleReady(c)
+		room.handleToggleReady(c)
 
 	case "TOGGLE_WANTS_MAYOR":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
-		c.room.handleToggleWantsMayor(c)
+		room.handleToggleWantsMayor(c)
 
 	case "START_GAME":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
-		c.room.handleStartGame(c)
+		room.handleStartGame(c)
 
 	case "ADD_BOT":
-		if c.room == nil {
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload AddBotPayload
+		if len(msg.Payload) > 0 {
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				c.sendError("Invalid ADD_BOT payload")
+				return
+			}
+		}
+		room.handleAddBot(c, payload)
+
+	case "REMOVE_BOT":
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
-		c.room.handleAddBot()
+		var payload RemoveBotPayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.sendError("Invalid REMOVE_BOT payload")
+			return
+		}
+		room.handleRemoveBot(c, payload)
+
+	case "CONFIGURE_BOT":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload ConfigureBotPayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.sendError("Invalid CONFIGURE_BOT payload")
+			return
+		}
+		room.handleConfigureBot(c, payload)
+
+	case "AUTO_FILL_BOTS":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload AutoFillBotsPayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.sendError("Invalid AUTO_FILL_BOTS payload")
+			return
+		}
+		room.handleAutoFillBots(c, payload)
 
 	case "SUBMIT_GUESS":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
@@ -139,10 +258,10 @@ func (c *Client) handleMessage(msg ClientMessage) {
 			c.sendError("Invalid SUBMIT_GUESS payload")
 			return
 		}
-		c.room.handleSubmitGuess(c, payload)
+		room.handleSubmitGuess(c, payload)
 
 	case "CHOOSE_WORD":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
@@ -151,10 +270,10 @@ func (c *Client) handleMessage(msg ClientMessage) {
 			c.sendError("Invalid CHOOSE_WORD payload")
 			return
 		}
-		c.room.handleChooseWord(c, payload)
+		room.handleChooseWord(c, payload)
 
 	case "SUBMIT_TOKEN":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
@@ -163,10 +282,10 @@ func (c *Client) handleMessage(msg ClientMessage) {
 			c.sendError("Invalid SUBMIT_TOKEN payload")
 			return
 		}
-		c.room.handleSubmitToken(c, payload)
+		room.handleSubmitToken(c, payload)
 
 	case "VOTE":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
@@ -175,17 +294,17 @@ func (c *Client) handleMessage(msg ClientMessage) {
 			c.sendError("Invalid VOTE payload")
 			return
 		}
-		c.room.handleVote(c, payload)
+		room.handleVote(c, payload)
 
 	case "RESET_GAME":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
-		c.room.handleResetGame(c)
+		room.handleResetGame(c)
 
 	case "SEND_REACTION":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
@@ -194,17 +313,24 @@ func (c *Client) handleMessage(msg ClientMessage) {
 			c.sendError("Invalid SEND_REACTION payload")
 			return
 		}
-		c.room.handleSendReaction(c, payload)
+		room.handleSendReaction(c, payload)
 
 	case "REVEAL_HINT":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
-		c.room.handleRevealHint(c)
+		var payload RevealHintPayload
+		if len(msg.Payload) > 0 {
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				c.sendError("Invalid REVEAL_HINT payload")
+				return
+			}
+		}
+		room.handleRevealHint(c, payload)
 
 	case "SET_DIFFICULTY":
-		if c.room == nil {
+		if room == nil {
 			c.sendError("You are not in a room")
 			return
 		}
@@ -213,54 +339,135 @@ func (c *Client) handleMessage(msg ClientMessage) {
 			c.sendError("Invalid SET_DIFFICULTY payload")
 			return
 		}
-		c.room.handleSetDifficulty(c, payload)
+		room.handleSetDifficulty(c, payload)
 
-	default:
-		c.sendError("Unknown message type: " + msg.Type)
-	}
-}
+	case "SET_LANGUAGE":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload SetLanguagePayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.sendError("Invalid SET_LANGUAGE payload")
+			return
+		}
+		room.handleSetLanguage(c, payload)
+
+	case "SET_CUSTOM_WORDS":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload SetCustomWordsPayload
+		if len(msg.Payload) > 0 {
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				c.sendError("Invalid SET_CUSTOM_WORDS payload")
+				return
+			}
+		}
+		room.handleSetCustomWords(c, payload)
+
+	case "SET_WORD_SOURCE":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload SetWordSourcePayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.sendError("Invalid SET_WORD_SOURCE payload")
+			return
+		}
+		room.handleSetWordSource(c, payload)
+
+	case "SET_WORD_OPTIONS":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		var payload SetWordOptionsPayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.sendError("Invalid SET_WORD_OPTIONS payload")
+			return
+		}
+		room.handleSetWordOptions(c, payload)
+
+	case "REROLL_WORDS":
+		if room == nil {
+			c.sendError("You are not in a room")
+			return
+		}
+		room.handleRerollWords(c)
 
-func (c *Client) sendError(message string) {
-	msg := ServerMessage{Type: "ERROR", Payload: ErrorPayload{Message: message}}
-	data, _ := json.Marshal(msg)
-	select {
-	case c.send <- data:
 	default:
+		c.sendError("Unknown message type: " + msg.Type)
 	}
 }
 
-func (c *Client) sendState(state GameState) {
-	msg := ServerMessage{Type: "STATE_UPDATE", Payload: state}
-	data, err := json.Marshal(msg)
+// sendMessage queues a message for writePump. If the client's buffer is
+// full the message is dropped rather than blocking the room.
+func (c *Client) sendMessage(msgType string, payload interface{}) {
+	data, err := json.Marshal(ServerMessage{Type: msgType, Payload: payload})
 	if err != nil {
 		return
 	}
 	select {
 	case c.send <- data:
+		metrics.messagesOut.inc(msgType)
 	default:
+		metrics.sendsDropped.inc(msgType)
 	}
 }
 
+func (c *Client) sendError(message string) {
+	c.sendMessage("ERROR", ErrorPayload{Message: message})
+}
+
+func (c *Client) sendState(state GameState) {
+	c.sendMessage("STATE_UPDATE", state)
+}
+
 func (c *Client) sendReaction(reaction ReactionBroadcast) {
-	msg := ServerMessage{Type: "REACTION", Payload: reaction}
-	data, err := json.Marshal(msg)
-	if err != nil {
-		return
-	}
+	c.sendMessage("REACTION", reaction)
+}
+
+func (c *Client) sendRoomList(rooms []RoomInfo) {
+	c.sendMessage("ROOM_LIST", RoomListPayload{Rooms: rooms})
+}
+
+func (c *Client) sendCountdown(msgType string, notice CountdownPayload) {
+	c.sendMessage(msgType, notice)
+}
+
+func (c *Client) sendNotice(msgType, message string) {
+	c.sendMessage(msgType, NoticePayload{Message: message})
+}
+
+// sendRaw queues an already-encoded message relayed from another node.
+func (c *Client) sendRaw(data []byte) {
 	select {
 	case c.send <- data:
 	default:
+		metrics.sendsDropped.inc("PROXIED")
 	}
 }
 
-func (c *Client) sendRoomList(rooms []RoomInfo) {
-	msg := ServerMessage{Type: "ROOM_LIST", Payload: RoomListPayload{Rooms: rooms}}
-	data, err := json.Marshal(msg)
-	if err != nil {
-		return
+// leaveRoom detaches the client from its room. A stand-in for a player on
+// another node also ends its session, so the origin node stops proxying.
+// Must be called with the room's lock held.
+func (c *Client) leaveRoom() {
+	c.room.Store(nil)
+	if c.remote != nil {
+		c.hub.endRemoteSession(c, "")
 	}
-	select {
-	case c.send <- data:
-	default:
+}
+
+// closeWithReason sends a close frame; readPump then sees the peer's reply
+// (or times out) and tears the connection down as usual.
+func (c *Client) closeWithReason(code int, reason string) {
+	if c.remote != nil {
+		c.hub.endRemoteSession(c, reason) // The origin node keeps the socket open
+		return
 	}
+	frame := websocket.FormatCloseMessage(code, reason)
+	_ = c.conn.WriteControl(websocket.CloseMessage, frame, time.Now().Add(writeWait))
 }
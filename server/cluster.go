@@ -0,0 +1,400 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// Cluster routing modes for clients that land on a node that doesn't own
+// the room they want to join.
+const (
+	RoutingProxy    = "proxy"    // relay the session through the broker to the owner
+	RoutingRedirect = "redirect" // tell the client which node to reconnect to
+)
+
+// brokerRetryDelay is how long to wait between resubscribe attempts. A var so
+// tests can shorten it.
+var brokerRetryDelay = 2 * time.Second
+
+// roomSyncInterval is how often a node shares its rooms and picks up other
+// nodes' rooms for the room browser.
+const roomSyncInterval = 3 * time.Second
+
+// sessionLink ties a client on one node to its counterpart on another.
+type sessionLink struct {
+	id   string
+	node string // the node on the other end
+
+	// Owner side only: closed when the session ends; closeReason is sent
+	// to the origin node along with the CLOSE message.
+	done        chan struct{}
+	closeReason string
+}
+
+// RedirectPayload tells a client to reconnect to another node.
+type RedirectPayload struct {
+	RoomCode string `json:"roomCode"`
+	URL      string `json:"url"`
+}
+
+// joinCluster connects the hub to a shared broker and starts routing
+// messages for this node. Without it the hub runs as a single node.
+func (h *Hub) joinCluster(b Broker, nodeID, nodeURL, routing string, claimTTL time.Duration) error {
+	h.mu.Lock()
+	h.broker = b
+	h.nodeID = nodeID
+	h.nodeURL = nodeURL
+	h.routing = routing
+	h.mu.Unlock()
+
+	if nodeURL != "" {
+		if err := b.Advertise(nodeID, nodeURL); err != nil {
+			return err
+		}
+	}
+	inbox, err := b.Subscribe(nodeID)
+	if err != nil {
+		return err
+	}
+	go h.runInbox(inbox)
+	go h.heartbeat(claimTTL / 3)
+	go h.runRoomSync(roomSyncInterval)
+
+	hubLog().Info("joined cluster", "node", nodeID, "routing", routing)
+	return nil
+}
+
+// leaveCluster stops the heartbeat, releases this node's room claims and
+// closes the broker. Call it after shutdown has closed every client.
+func (h *Hub) leaveCluster() {
+	h.mu.Lock()
+	close(h.clusterStop)
+	codes := make([]string, 0, len(h.rooms))
+	for code := range h.rooms {
+		codes = append(codes, code)
+	}
+	h.mu.Unlock()
+
+	for _, code := range codes {
+		h.releaseClaim(code)
+	}
+	if err := h.broker.ShareRooms(h.nodeID, nil); err != nil {
+		hubLog().Warn("clearing shared rooms", "error", err)
+	}
+	h.broker.Close()
+}
+
+// heartbeat keeps this node's room claims and advertised URL alive.
+func (h *Hub) heartbeat(every time.Duration) {
+	if every <= 0 {
+		return
+	}
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			codes := make([]string, 0)
+			for _, room := range h.roomList() {
+				codes = append(codes, room.code)
+			}
+			if err := h.broker.Refresh(codes, h.nodeID); err != nil {
+				hubLog().Warn("refreshing room claims", "error", err)
+			}
+			if h.nodeURL != "" {
+				if err := h.broker.Advertise(h.nodeID, h.nodeURL); err != nil {
+					hubLog().Warn("advertising node URL", "error", err)
+				}
+			}
+		case <-h.clusterStop:
+			return
+		}
+	}
+}
+
+// ============================================================
+// Cluster-wide room list
+// ============================================================
+
+// runRoomSync keeps the room browser's view of other nodes' rooms fresh.
+func (h *Hub) runRoomSync(every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.syncRooms()
+		case <-h.clusterStop:
+			return
+		}
+	}
+}
+
+// syncRooms shares this node's rooms through the broker and replaces the
+// cached rooms of other nodes, pushing the differences to the room feed.
+func (h *Hub) syncRooms() {
+	local := make([]RoomInfo, 0)
+	for _, room := range h.roomList() {
+		room.mu.Lock()
+		local = append(local, room.info())
+		room.mu.Unlock()
+	}
+	if err := h.broker.ShareRooms(h.nodeID, local); err != nil {
+		hubLog().Warn("sharing room list", "error", err)
+	}
+	shared, err := h.broker.SharedRooms()
+	if err != nil {
+		hubLog().Warn("fetching other nodes' rooms", "error", err)
+		return
+	}
+
+	remote := make(map[string]RoomInfo)
+	for node, rooms := range shared {
+		if node == h.nodeID {
+			continue
+		}
+		for _, info := range rooms {
+			remote[info.Code] = info
+		}
+	}
+	h.mu.Lock()
+	previous := h.remoteRooms
+	h.remoteRooms = remote
+	h.mu.Unlock()
+
+	for code, info := range remote {
+		if prev, ok := previous[code]; !ok {
+			h.roomFeed.publish(RoomEvent{Type: RoomEventCreated, Room: info})
+		} else if !reflect.DeepEqual(prev, info) {
+			h.roomFeed.publish(RoomEvent{Type: RoomEventUpdated, Room: info})
+		}
+	}
+	for code := range previous {
+		if _, ok := remote[code]; !ok {
+			h.roomFeed.publish(RoomEvent{Type: RoomEventRemoved, Room: RoomInfo{Code: code}})
+		}
+	}
+}
+
+// remoteRoomList returns the rooms other nodes host, as last synced.
+func (h *Hub) remoteRoomList() []RoomInfo {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	rooms := make([]RoomInfo, 0, len(h.remoteRooms))
+	for _, info := range h.remoteRooms {
+		rooms = append(rooms, info)
+	}
+	return rooms
+}
+
+// ============================================================
+// Origin side: the node holding the client's socket
+// ============================================================
+
+// routeToOwner handles a JOIN_GAME for a room this node doesn't have.
+func (h *Hub) routeToOwner(c *Client, payload JoinGamePayload) {
+	owner, err := h.broker.Owner(normalizeRoomCode(payload.RoomCode))
+	if err != nil {
+		hubLog().Error("room owner lookup failed", logKeyRoom, payload.RoomCode, "error", err)
+		c.sendError("Couldn't look up that room right now. Please try again")
+		return
+	}
+	if owner == "" || owner == h.nodeID || c.remote != nil {
+		if payload.RejoinToken != "" {
+			c.sendNotice("REJOIN_FAILED", "That game has ended")
+			return
+		}
+		c.sendError("Room not found: " + payload.RoomCode)
+		return
+	}
+
+	if h.routing == RoutingRedirect {
+		url, err := h.broker.NodeURL(owner)
+		if err != nil || url == "" {
+			hubLog().Error("owner node has no URL", "node", owner, "error", err)
+			c.sendError("That room is hosted on another server that can't be reached right now")
+			return
+		}
+		c.sendMessage("REDIRECT", RedirectPayload{RoomCode: normalizeRoomCode(payload.RoomCode), URL: url})
+		return
+	}
+
+	raw, _ := json.Marshal(payload)
+	join, _ := json.Marshal(ClientMessage{Type: "JOIN_GAME", Payload: raw})
+	link := &sessionLink{id: newUUID(), node: owner}
+
+	h.mu.Lock()
+	c.proxy = link
+	h.proxied[link.id] = c
+	h.mu.Unlock()
+
+	if err := h.broker.Publish(owner, BrokerMessage{Kind: BrokerSessionOpen, Session: link.id, From: h.nodeID, Data: join}); err != nil {
+		h.dropProxy(link.id)
+		hubLog().Error("opening proxied session", "node", owner, "error", err)
+		c.sendError("That room is hosted on another server that can't be reached right now")
+		return
+	}
+	hubLog().Info("proxying client to room owner", logKeyRoom, payload.RoomCode, "node", owner, "session", link.id)
+}
+
+// proxyLink returns the client's proxied session, if any.
+func (h *Hub) proxyLink(c *Client) *sessionLink {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return c.proxy
+}
+
+// forward relays a raw client message over the client's proxied session.
+func (h *Hub) forward(link *sessionLink, kind string, data []byte) {
+	msg := BrokerMessage{Kind: kind, Session: link.id, From: h.nodeID, Data: data}
+	if err := h.broker.Publish(link.node, msg); err != nil {
+		hubLog().Warn("forwarding to room owner", "node", link.node, "session", link.id, "error", err)
+	}
+}
+
+// dropProxy forgets a proxied session and returns its client.
+func (h *Hub) dropProxy(session string) *Client {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c := h.proxied[session]
+	if c != nil {
+		c.proxy = nil
+		delete(h.proxied, session)
+	}
+	return c
+}
+
+// ============================================================
+// Owner side: the node hosting the room
+// ============================================================
+
+// openRemoteSession creates a stand-in client for a player connected to
+// another node. Its outgoing messages are relayed back over the broker.
+func (h *Hub) openRemoteSession(msg BrokerMessage) *Client {
+	c := &Client{
+		hub:    h,
+		send:   make(chan []byte, 256),
+		remote: &sessionLink{id: msg.Session, node: msg.From, done: make(chan struct{})},
+	}
+	h.mu.Lock()
+	h.remotes[msg.Session] = c
+	h.clients[c] = true
+	h.mu.Unlock()
+
+	go h.pumpRemote(c)
+	return c
+}
+
+// pumpRemote relays a stand-in client's outgoing messages to its origin node.
+func (h *Hub) pumpRemote(c *Client) {
+	link := c.remote
+	relay := func(data []byte) {
+		h.forward(link, BrokerSessionServer, data)
+	}
+	for {
+		select {
+		case data := <-c.send:
+			relay(data)
+		case <-link.done:
+			// Flush whatever was queued (e.g. a KICKED notice), then hang up
+			for {
+				select {
+				case data := <-c.send:
+					relay(data)
+				default:
+					reason, _ := json.Marshal(link.closeReason)
+					h.forward(link, BrokerSessionClose, reason)
+					return
+				}
+			}
+		}
+	}
+}
+
+// endRemoteSession stops relaying a stand-in client. It is safe to call
+// more than once.
+func (h *Hub) endRemoteSession(c *Client, reason string) {
+	h.mu.Lock()
+	if _, ok := h.remotes[c.remote.id]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(h.remotes, c.remote.id)
+	delete(h.clients, c)
+	c.remote.closeReason = reason
+	close(c.remote.done)
+	h.mu.Unlock()
+}
+
+// ============================================================
+// Broker inbox
+// ============================================================
+
+// runInbox routes broker messages, resubscribing if the broker connection
+// drops, until the hub leaves the cluster.
+func (h *Hub) runInbox(inbox <-chan BrokerMessage) {
+	for {
+		h.routeBrokerMessages(inbox)
+		for {
+			select {
+			case <-h.clusterStop:
+				return
+			case <-time.After(brokerRetryDelay):
+			}
+			var err error
+			if inbox, err = h.broker.Subscribe(h.nodeID); err == nil {
+				hubLog().Info("broker inbox resubscribed", "node", h.nodeID)
+				break
+			}
+			hubLog().Warn("broker resubscribe failed", "node", h.nodeID, "error", err)
+		}
+	}
+}
+
+func (h *Hub) routeBrokerMessages(inbox <-chan BrokerMessage) {
+	for msg := range inbox {
+		switch msg.Kind {
+		case BrokerSessionOpen:
+			c := h.openRemoteSession(msg)
+			c.handleRaw(msg.Data)
+
+		case BrokerSessionClient:
+			h.mu.RLock()
+			c := h.remotes[msg.Session]
+			h.mu.RUnlock()
+			if c != nil {
+				c.handleRaw(msg.Data)
+			}
+
+		case BrokerSessionServer:
+			h.mu.RLock()
+			c := h.proxied[msg.Session]
+			h.mu.RUnlock()
+			if c != nil {
+				c.sendRaw(msg.Data)
+			}
+
+		case BrokerSessionClose:
+			h.mu.RLock()
+			remote := h.remotes[msg.Session]
+			h.mu.RUnlock()
+			if remote != nil {
+				// The player's socket closed on the origin node
+				if room := remote.room.Load(); room != nil {
+					room.removeClient(remote)
+				}
+				h.endRemoteSession(remote, "")
+				continue
+			}
+			if c := h.dropProxy(msg.Session); c != nil {
+				var reason string
+				_ = json.Unmarshal(msg.Data, &reason)
+				if reason != "" {
+					c.sendNotice("ROOM_CLOSED", reason)
+				}
+			}
+		}
+	}
+	hubLog().Warn("broker inbox closed", "node", h.nodeID)
+}
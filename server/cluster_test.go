@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestHub(t *testing.T) *Hub {
+	t.Helper()
+	codes, err := newCodeGenerator("words")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newHub(codes, 10)
+}
+
+func TestRoomBrowserListsOtherNodesRooms(t *testing.T) {
+	broker := newMemoryBroker()
+	a, b := newTestHub(t), newTestHub(t)
+	if err := a.joinCluster(broker, "node-a", "", RoutingProxy, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	defer a.leaveCluster()
+	if err := b.joinCluster(broker, "node-b", "", RoutingProxy, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	defer b.leaveCluster()
+
+	room, err := a.createRoom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := b.roomFeed.subscribe()
+	defer b.roomFeed.unsubscribe(events)
+
+	a.syncRooms()
+	b.syncRooms()
+	rooms, total := b.queryRooms(RoomQuery{})
+	if total != 1 || rooms[0].Code != room.code {
+		t.Fatalf("node-b lists %+v, want node-a's room %s", rooms, room.code)
+	}
+	if evt := <-events; evt.Type != RoomEventCreated || evt.Room.Code != room.code {
+		t.Fatalf("feed event = %+v, want %s created", evt, room.code)
+	}
+
+	a.removeRoom(room.code)
+	a.syncRooms()
+	b.syncRooms()
+	if _, total := b.queryRooms(RoomQuery{}); total != 0 {
+		t.Fatalf("node-b still lists %d rooms after node-a's room closed", total)
+	}
+	if evt := <-events; evt.Type != RoomEventRemoved || evt.Room.Code != room.code {
+		t.Fatalf("feed event = %+v, want %s removed", evt, room.code)
+	}
+}
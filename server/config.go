@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds server settings read from the environment at startup.
+type Config struct {
+	Port           string
+	StaticDir      string
+	AllowedOrigins []string
+	TLSCertFile    string
+	TLSKeyFile     string
+
+	ShutdownGrace        time.Duration
+	ShutdownSnapshotFile string
+
+	LogFormat     string
+	LogLevel      string
+	LogDebugRooms []string
+
+	AdminToken string
+
+	RoomTTLs        RoomTTLs
+	JanitorInterval time.Duration
+
+	RoomCodeStyle string
+	MaxRooms      int
+
+	Broker         string
+	BrokerClaimTTL time.Duration
+	NodeID         string
+	NodeURL        string
+	Routing        string
+
+	AgentTokens  []string
+	AgentTimeout time.Duration
+
+	WordPacksDir  string
+	DictionaryDir string
+
+	WordStatsFile         string
+	AdaptiveTargetWinRate float64
+
+	HintCosts  []string
+	HintLimits []string
+}
+
+func loadConfig() Config {
+	return Config{
+		Port:           envString("PORT", "8080"),
+		StaticDir:      envString("STATIC_DIR", "./static"),
+		AllowedOrigins: envList("ALLOWED_ORIGINS"),
+		TLSCertFile:    os.Getenv("TLS_CERT_FILE"),
+		TLSKeyFile:     os.Getenv("TLS_KEY_FILE"),
+
+		ShutdownGrace:        envDuration("SHUTDOWN_GRACE", 60*time.Second),
+		ShutdownSnapshotFile: os.Getenv("SHUTDOWN_SNAPSHOT_FILE"),
+
+		LogFormat:     envString("LOG_FORMAT", "text"),
+		LogLevel:      envString("LOG_LEVEL", "info"),
+		LogDebugRooms: envList("LOG_DEBUG_ROOMS"),
+
+		AdminToken: os.Getenv("ADMIN_TOKEN"),
+
+		RoomTTLs: RoomTTLs{
+			ByPhase: map[string]time.Duration{
+				PhaseLobby:    envDuration("ROOM_TTL_LOBBY", 30*time.Minute),
+				PhaseVoting:   envDuration("ROOM_TTL_VOTING", 5*time.Minute),
+				PhaseGameOver: envDuration("ROOM_TTL_GAME_OVER", 15*time.Minute),
+			},
+			Default: envDuration("ROOM_TTL_IN_GAME", 15*time.Minute),
+			Warning: envDuration("ROOM_TTL_WARNING", 1*time.Minute),
+		},
+		JanitorInterval: envDuration("JANITOR_INTERVAL", 15*time.Second),
+
+		RoomCodeStyle: envString("ROOM_CODE_STYLE", "words"),
+		MaxRooms:      envInt("MAX_ROOMS", 1000),
+
+		Broker:         envString("BROKER", "memory"),
+		BrokerClaimTTL: envDuration("BROKER_CLAIM_TTL", 30*time.Second),
+		NodeID:         envString("NODE_ID", defaultNodeID()),
+		NodeURL:        os.Getenv("NODE_URL"),
+		Routing:        strings.ToLower(envString("CLUSTER_ROUTING", RoutingProxy)),
+
+		AgentTokens:  envList("AGENT_TOKENS"),
+		AgentTimeout: envDuration("AGENT_TIMEOUT", 8*time.Second),
+
+		WordPacksDir:  os.Getenv("WORD_PACKS_DIR"),
+		DictionaryDir: os.Getenv("DICTIONARY_DIR"),
+
+		WordStatsFile:         os.Getenv("WORD_STATS_FILE"),
+		AdaptiveTargetWinRate: envFloat("ADAPTIVE_TARGET_WIN_RATE", 0.5),
+
+		HintCosts:  envList("HINT_COSTS"),
+		HintLimits: envList("HINT_LIMITS"),
+	}
+}
+
+// defaultNodeID is the hostname plus a random suffix, so restarted
+// containers never collide with their previous incarnation's claims.
+func defaultNodeID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "node"
+	}
+	return host + "-" + newUUID()[:8]
+}
+
+// tlsEnabled reports whether both a certificate and a key were configured.
+func (c Config) tlsEnabled() bool {
+	return c.TLSCertFile != "" && c.TLSKeyFile != ""
+}
+
+// checkTLS rejects a certificate without a key or a key without a
+// certificate, which would otherwise quietly serve plain HTTP.
+func (c Config) checkTLS() error {
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	return nil
+}
+
+// --- Environment helpers ---
+
+func envString(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// envList splits a comma-separated variable, dropping empty entries.
+func envList(key string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// envInt parses an integer variable.
+func envInt(key string, fallback int) int {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		slog.Warn("invalid integer in environment, using default", "key", key, "value", v, "default", fallback, "error", err)
+		return fallback
+	}
+	return n
+}
+
+// envFloat parses a decimal variable such as "0.55".
+func envFloat(key string, fallback float64) float64 {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		slog.Warn("invalid number in environment, using default", "key", key, "value", v, "default", fallback, "error", err)
+		return fallback
+	}
+	return f
+}
+
+// envDuration parses a Go duration such as "90s" or "2m".
+func envDuration(key string, fallback time.Duration) time.Duration {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		slog.Warn("invalid duration in environment, using default", "key", key, "value", v, "default", fallback, "error", err)
+		return fallback
+	}
+	return d
+}
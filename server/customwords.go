@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A room can draw its secret words from a list the players supply — in-jokes,
+// project names — instead of, or mixed in with, the built-in word packs. The
+// list stays with the room across games until someone replaces or clears it.
+
+const (
+	maxCustomWords      = 300
+	maxCustomWordLength = 30 // runes
+	maxRejectedReported = 10 // rejected entries echoed back to the uploader
+)
+
+// CustomWordsPayload is sent back to whoever set the list: the words kept
+// and what was dropped, so they can fix up their paste or file.
+type CustomWordsPayload struct {
+	Words      []string `json:"words"`
+	Duplicates int      `json:"duplicates"`
+	Rejected   []string `json:"rejected,omitempty"`
+	Truncated  bool     `json:"truncated,omitempty"` // more than maxCustomWords were given
+}
+
+// parseCustomWords cleans up a pasted or uploaded list. Words are separated
+// by newlines or commas and normalized with normalizeText. Entries that are too long or contain anything other than letters,
+// digits, spaces, hyphens and apostrophes are rejected, and case-insensitive
+// duplicates are dropped.
+func parseCustomWords(text string, words []string) CustomWordsPayload {
+	fields := strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == '\r' || r == ',' })
+	fields = append(fields, words...)
+
+	result := CustomWordsPayload{Words: make([]string, 0, len(fields))}
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		w := normalizeText(f)
+		if w == "" {
+			continue
+		}
+		if !validCustomWord(w) {
+			if len(result.Rejected) < maxRejectedReported {
+				rejected := limitRunes(w, maxCustomWordLength)
+				if rejected != w {
+					rejected += "…"
+				}
+				result.Rejected = append(result.Rejected, rejected)
+			}
+			continue
+		}
+		key := foldWord(w)
+		if seen[key] {
+			result.Duplicates++
+			continue
+		}
+		if len(result.Words) == maxCustomWords {
+			result.Truncated = true
+			break
+		}
+		seen[key] = true
+		result.Words = append(result.Words, w)
+	}
+	return result
+}
+
+func validCustomWord(w string) bool {
+	if !utf8.ValidString(w) || utf8.RuneCountInString(w) > maxCustomWordLength {
+		return false
+	}
+	letters := 0
+	for _, r := range w {
+		switch {
+		case unicode.IsLetter(r):
+			letters++
+		case unicode.IsDigit(r), r == ' ', r == '-', r == '\'':
+		default:
+			return false
+		}
+	}
+	return letters > 0
+}
+
+func (r *Room) handleSetCustomWords(c *Client, payload SetCustomWordsPayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseLobby {
+		c.sendError("Custom words can only be changed in the lobby")
+		return
+	}
+
+	result := parseCustomWords(payload.Text, payload.Words)
+	if len(result.Words) == 0 && (payload.Text != "" || len(payload.Words) > 0) {
+		c.sendError("None of those words can be used — words may only contain letters, digits, spaces, hyphens and apostrophes")
+		return
+	}
+	r.customWords = result.Words
+	if len(r.customWords) == 0 {
+		r.wordSource = WordSourceBuiltin
+	}
+	r.staleFreshWords()
+
+	r.logger().Info("custom words set", logKeyPlayer, c.playerID, "words", len(result.Words),
+		"duplicates", result.Duplicates, "rejected", len(result.Rejected), "truncated", result.Truncated)
+	c.sendMessage("CUSTOM_WORDS", result)
+	r.broadcastState()
+}
+
+func (r *Room) handleSetWordSource(c *Client, payload SetWordSourcePayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseLobby {
+		c.sendError("Word source can only be changed in the lobby")
+		return
+	}
+
+	switch payload.Source {
+	case WordSourceBuiltin:
+	case WordSourceCustom, WordSourceMix:
+		if len(r.customWords) == 0 {
+			c.sendError("Add some custom words first")
+			return
+		}
+	default:
+		c.sendError("Invalid word source")
+		return
+	}
+	r.wordSource = payload.Source
+	r.staleFreshWords()
+	r.broadcastState()
+}
+
+// checkWordSource reports why a game can't start with the room's word
+// source and language, or "" if it can. Must be called with lock held.
+func (r *Room) checkWordSource() string {
+	if r.wordSource == WordSourceCustom {
+		if len(r.customWords) < r.optionCount {
+			return fmt.Sprintf("Need at least %d custom words to play with only custom words", r.optionCount)
+		}
+		return ""
+	}
+	if !currentWordBank().hasLanguage(r.language) {
+		return "The word packs for this room's language have been removed — choose another language"
+	}
+	if r.difficulty == DifficultyPhrase && !r.mixedDifficulty && len(currentWordBank().pool(r.language, DifficultyPhrase)) == 0 {
+		return "There are no phrases in this room's language — choose another difficulty"
+	}
+	return ""
+}
+
+// wordPool returns every word this game's secret word can be drawn from.
+// Must be called with lock held.
+func (r *Room) wordPool() []string {
+	switch r.wordSource {
+	case WordSourceCustom:
+		return r.customWords
+	case WordSourceMix:
+		pool := append([]string(nil), r.customWords...)
+		seen := make(map[string]bool, len(pool))
+		for _, w := range pool {
+			seen[foldWord(w)] = true
+		}
+		for _, w := range r.packPool() {
+			if !seen[foldWord(w)] {
+				pool = append(pool, w)
+			}
+		}
+		return pool
+	default:
+		return r.packPool()
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// In free-choice mode the Mayor can make up the secret word instead of
+// picking an option. The word has to be a real one: it's checked against
+// the room language's word packs and an offline dictionary compiled in from
+// data/dictionary, one <language>.txt list per language, and mustn't be in
+// that language's blocked/<language>.txt. Blocked words are matched whole,
+// so "con" blocked in French doesn't refuse "chili con carne" elsewhere.
+// DICTIONARY_DIR adds to these lists, and may also have a blocked.txt of
+// words refused in every language.
+
+//go:embed data/dictionary/*.txt data/dictionary/blocked/*.txt
+var builtinDictionary embed.FS
+
+const (
+	minFreeWordLength = 3 // runes
+	maxFreeWordLength = 24
+)
+
+// Dictionary holds folded words and blocked words by language. Words
+// blocked in every language are under "".
+type Dictionary struct {
+	words   map[string]map[string]bool
+	blocked map[string]map[string]bool
+}
+
+// dictionary is loaded from the built-in lists, and again in main once
+// DICTIONARY_DIR is known.
+var dictionary *Dictionary
+
+func init() {
+	d, err := loadDictionary("")
+	if err != nil {
+		panic(err) // Built-in lists are static data; a bad one is a build mistake
+	}
+	dictionary = d
+}
+
+// loadDictionary reads the built-in lists, then any *.txt lists in dir.
+func loadDictionary(dir string) (*Dictionary, error) {
+	d := &Dictionary{
+		words:   make(map[string]map[string]bool),
+		blocked: make(map[string]map[string]bool),
+	}
+	if err := d.readLists(builtinDictionary, "data/dictionary"); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := d.readLists(os.DirFS(dir), "."); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// readLists reads the <language>.txt word lists and any blocked.txt in dir,
+// then the <language>.txt lists in dir/blocked.
+func (d *Dictionary) readLists(fsys fs.FS, dir string) error {
+	names, err := fs.Glob(fsys, filepath.ToSlash(filepath.Join(dir, "*.txt")))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		list := strings.TrimSuffix(filepath.Base(name), ".txt")
+		switch {
+		case list == "blocked":
+			err = readList(fsys, name, listSet(d.blocked, ""))
+		case validLanguageCode(list):
+			err = readList(fsys, name, listSet(d.words, list))
+		default:
+			err = fmt.Errorf("%s: dictionary files are named for a language code, or blocked.txt", name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	names, err = fs.Glob(fsys, filepath.ToSlash(filepath.Join(dir, "blocked", "*.txt")))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		list := strings.TrimSuffix(filepath.Base(name), ".txt")
+		if !validLanguageCode(list) {
+			return fmt.Errorf("%s: blocked word files are named for a language code", name)
+		}
+		if err := readList(fsys, name, listSet(d.blocked, list)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listSet returns the set for a language, adding it if it's new.
+func listSet(sets map[string]map[string]bool, language string) map[string]bool {
+	if sets[language] == nil {
+		sets[language] = make(map[string]bool)
+	}
+	return sets[language]
+}
+
+// readList adds the folded words of a list file to set.
+func readList(fsys fs.FS, name string, set map[string]bool) error {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			set[foldWord(line)] = true
+		}
+	}
+	return nil
+}
+
+// wordCount is the number of words listed for each language.
+func (d *Dictionary) wordCount() int {
+	return countWords(d.words)
+}
+
+// blockedCount is the number of words blocked for each language.
+func (d *Dictionary) blockedCount() int {
+	return countWords(d.blocked)
+}
+
+func countWords(sets map[string]map[string]bool) int {
+	n := 0
+	for _, words := range sets {
+		n += len(words)
+	}
+	return n
+}
+
+// isBlocked reports whether a folded word or phrase, or any whole word of
+// it, is blocked in language.
+func (d *Dictionary) isBlocked(key, language string) bool {
+	for _, p := range append(phraseWords(key), key) {
+		if d.blocked[language][p] || d.blocked[""][p] {
+			return true
+		}
+	}
+	return false
+}
+
+// listed reports whether a folded word, or its singular, is in set.
+func listed(set map[string]bool, word, language string) bool {
+	if set[word] {
+		return true
+	}
+	if language == "en" && set[singular(word)] {
+		return true
+	}
+	for _, suffix := range pluralSuffixes[language] {
+		if stem, ok := strings.CutSuffix(word, suffix); ok && stem != "" && set[stem] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFreeWord validates a word the Mayor made up, returning it tidied up,
+// or an error message if it can't be used.
+// Must be called with lock held.
+func (r *Room) checkFreeWord(word string) (string, string) {
+	w := normalizeText(word)
+	if n := utf8.RuneCountInString(w); n < minFreeWordLength || n > maxFreeWordLength {
+		return "", fmt.Sprintf("Words must be %d to %d letters long", minFreeWordLength, maxFreeWordLength)
+	}
+	if !validCustomWord(w) {
+		return "", "Words may only contain letters, digits, spaces, hyphens and apostrophes"
+	}
+
+	key := foldWord(w)
+	parts := phraseWords(key)
+	if dictionary.isBlocked(key, r.language) {
+		return "", "That word isn't allowed"
+	}
+
+	if _, ok := r.words.lookupIn(r.language, w); ok {
+		return w, ""
+	}
+	for _, p := range parts {
+		if _, ok := r.words.lookupIn(r.language, p); ok {
+			continue
+		}
+		if !listed(dictionary.words[r.language], p, r.language) {
+			return "", fmt.Sprintf("%q isn't in the dictionary", p)
+		}
+	}
+	return w, ""
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckFreeWordBlocksWholeWordsPerLanguage(t *testing.T) {
+	tests := []struct {
+		language, word string
+		ok             bool
+	}{
+		{"es", "chili con carne", true},
+		{"fr", "chili con carne", false},
+		{"en", "cocktail", true},
+		{"en", "fucks", false},
+		{"en", "blorptastic", false}, // not a dictionary word
+		{"de", "Schneemann", true},
+		{"fr", "arc en ciel", true},
+	}
+	r := newRoom("ROOM", newTestHub(t))
+	for _, tt := range tests {
+		r.language = tt.language
+		_, msg := r.checkFreeWord(tt.word)
+		if (msg == "") != tt.ok {
+			t.Errorf("checkFreeWord(%q) in %s = %q, want ok %v", tt.word, tt.language, msg, tt.ok)
+		}
+	}
+}
+
+func TestDictionaryDirBlockedInEveryLanguage(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "blocked.txt"), []byte("# house rules\nbanana\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	d, err := loadDictionary(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, language := range []string{"en", "es"} {
+		if !d.isBlocked("banana split", language) {
+			t.Errorf("banana isn't blocked in %s", language)
+		}
+	}
+	if d.isBlocked("con", "es") || !d.isBlocked("con", "fr") {
+		t.Error(`"con" should be blocked in French only`)
+	}
+}
@@ -2,4 +2,7 @@ module werewords-server
 
 go 1.22
 
-require github.com/gorilla/websocket v1.5.3
+require (
+	github.com/gorilla/websocket v1.5.3
+	golang.org/x/text v0.21.0
+)
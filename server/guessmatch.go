@@ -0,0 +1,162 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Guesses rarely match the secret word letter for letter. A guess wins if it
+// names the word once articles, plurals, aliases, punctuation and spacing are
+// allowed for ("the penguins" for Penguin, "icecream" for Ice Cream); a guess
+// a typo or two away ("Pengiun") doesn't win on its own but is flagged to the
+// Mayor as a likely SO_CLOSE.
+
+type guessMatch int
+
+const (
+	guessMiss  guessMatch = iota
+	guessClose            // a near miss the Mayor may want to call SO_CLOSE
+	guessExact
+)
+
+// leadingArticles are dropped from the front of a guess, by language.
+var leadingArticles = map[string][]string{
+	"en": {"the", "a", "an", "some"},
+	"es": {"el", "la", "los", "las", "un", "una", "unos", "unas"},
+	"de": {"der", "die", "das", "den", "dem", "des", "ein", "eine", "einen"},
+	"fr": {"le", "la", "les", "l'", "un", "une", "des", "du"},
+}
+
+// pluralSuffixes are endings that turn a singular into a plural, by language.
+// English plurals also go through singular.
+var pluralSuffixes = map[string][]string{
+	"en": {"s", "es"},
+	"es": {"s", "es"},
+	"de": {"e", "en", "n", "er", "s"},
+	"fr": {"s", "x"},
+}
+
+// matchGuess compares a guess with the secret word and its aliases.
+// Must be called with lock held.
+func (r *Room) matchGuess(text string) guessMatch {
+	return r.matchGuessTo(text, r.secretWord)
+}
+
+// matchGuessTo compares a guess with a word and its aliases, so bots can
+// predict how the room would match a guess if that word were the secret.
+// Must be called with lock held.
+func (r *Room) matchGuessTo(text, word string) guessMatch {
+	names := []string{word}
+	if e, ok := r.words.lookupIn(r.language, word); ok {
+		names = append(names, e.Aliases...)
+	}
+	best := guessMiss
+	for _, name := range names {
+		if m := matchWord(text, name, r.language); m > best {
+			best = m
+		}
+	}
+	return best
+}
+
+// matchWord compares a guess with one name for the secret word.
+func matchWord(guess, word, language string) guessMatch {
+	g, w := guessForm(guess, language), guessForm(word, language)
+	if g == "" || w == "" {
+		return guessMiss
+	}
+	if g == w || samePlural(g, w, language) {
+		return guessExact
+	}
+	if editDistance(g, w) <= typoAllowance(w) {
+		return guessClose
+	}
+	return guessMiss
+}
+
+// guessForm folds a guess or word for comparison, dropping a leading article
+// and then all punctuation and spacing, so "the hot-air balloon!" and "Hot Air
+// Balloon" compare equal.
+func guessForm(s, language string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			return r
+		}
+		return -1
+	}, guessPhrase(s, language))
+}
+
+// guessPhrase folds a guess and drops a leading article, keeping the words
+// apart so it can be looked up in the word packs.
+func guessPhrase(s, language string) string {
+	s = strings.ReplaceAll(foldWord(s), "-", " ")
+	s = strings.TrimFunc(s, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSpace(r) })
+	for _, article := range leadingArticles[language] {
+		rest, ok := strings.CutPrefix(s, article)
+		if !ok || rest == "" {
+			continue
+		}
+		// "l'" runs into its noun; other articles are separate words
+		if strings.HasSuffix(article, "'") || rest[0] == ' ' {
+			s = rest
+			break
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// samePlural reports whether guess is the plural of word. English also
+// allows the other way round, for words that are themselves plural.
+func samePlural(guess, word, language string) bool {
+	if language == "en" && singular(guess) == singular(word) {
+		return true
+	}
+	for _, suffix := range pluralSuffixes[language] {
+		if guess == word+suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// typoAllowance is how many edits still count as a near miss: none for
+// short words, where one letter makes a different word, more for long ones.
+func typoAllowance(word string) int {
+	switch n := utf8.RuneCountInString(word); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// editDistance is the number of single-letter insertions, deletions,
+// substitutions and swaps of neighbouring letters between a and b.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	// Three rows of the optimal string alignment table
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(t)]
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The Mayor can buy hints for the village with their own score. Each kind
+// of hint has a cost and a per-game limit, set with HINT_COSTS and
+// HINT_LIMITS; hints that need word metadata (category, clue) are only
+// offered for words from a word pack.
+
+const (
+	HintLetter      = "LETTER" // reveal one letter of the word
+	HintFirstLetter = "FIRST_LETTER"
+	HintLength      = "LENGTH"
+	HintSyllables   = "SYLLABLES"
+	HintCategory    = "CATEGORY"
+	HintClue        = "CLUE" // the word pack's own hint
+)
+
+// hintTypes lists the hint types in the order they're offered.
+var hintTypes = []string{HintLetter, HintFirstLetter, HintLength, HintSyllables, HintCategory, HintClue}
+
+const TimelineHint = "HINT"
+
+// hintPhrases word the hints that aren't taken from the word pack, in one
+// language. The "one" forms are used when the count is 1.
+type hintPhrases struct {
+	letter, firstLetter     string
+	phraseLength            string // word count, then letters per word
+	letters, lettersOne     string
+	syllables, syllablesOne string
+}
+
+// hintFormats are the hint phrasings by language.
+var hintFormats = map[string]hintPhrases{
+	"en": {
+		letter: "Letter %d is %q", firstLetter: "It starts with %q",
+		phraseLength: "%d words: %s letters", letters: "%d letters", lettersOne: "%d letter",
+		syllables: "About %d syllables", syllablesOne: "About %d syllable",
+	},
+	"es": {
+		letter: "La letra %d es %q", firstLetter: "Empieza por %q",
+		phraseLength: "%d palabras: %s letras", letters: "%d letras", lettersOne: "%d letra",
+		syllables: "Unas %d sílabas", syllablesOne: "Más o menos %d sílaba",
+	},
+	"de": {
+		letter: "Buchstabe %d ist %q", firstLetter: "Es beginnt mit %q",
+		phraseLength: "%d Wörter: %s Buchstaben", letters: "%d Buchstaben", lettersOne: "%d Buchstabe",
+		syllables: "Etwa %d Silben", syllablesOne: "Etwa %d Silbe",
+	},
+	"fr": {
+		letter: "La lettre %d est %q", firstLetter: "Ça commence par %q",
+		phraseLength: "%d mots : %s lettres", letters: "%d lettres", lettersOne: "%d lettre",
+		syllables: "Environ %d syllabes", syllablesOne: "Environ %d syllabe",
+	},
+}
+
+// hintPhrasesFor returns the hint phrasings for language, falling back to
+// the default language.
+func hintPhrasesFor(language string) hintPhrases {
+	if p, ok := hintFormats[language]; ok {
+		return p
+	}
+	return hintFormats[defaultLanguage]
+}
+
+// countPhrase picks the singular or plural format for n.
+func countPhrase(n int, one, many string) string {
+	if n == 1 {
+		return fmt.Sprintf(one, n)
+	}
+	return fmt.Sprintf(many, n)
+}
+
+// HintRule is what a hint type costs the Mayor and how often it can be used
+// in a game. A LETTER limit of 0 means half the letters of the word.
+type HintRule struct {
+	Cost  int
+	Limit int
+}
+
+// hintRules is set once at startup from HINT_COSTS and HINT_LIMITS.
+var hintRules = defaultHintRules()
+
+func defaultHintRules() map[string]HintRule {
+	return map[string]HintRule{
+		HintLetter:      {Cost: 1, Limit: 0},
+		HintFirstLetter: {Cost: 1, Limit: 1},
+		HintLength:      {Cost: 1, Limit: 1},
+		HintSyllables:   {Cost: 1, Limit: 1},
+		HintCategory:    {Cost: 2, Limit: 1},
+		HintClue:        {Cost: 3, Limit: 1},
+	}
+}
+
+// parseHintRules overrides the default rules with "TYPE:n" entries.
+func parseHintRules(costs, limits []string) (map[string]HintRule, error) {
+	rules := defaultHintRules()
+	apply := func(entries []string, what string, set func(r *HintRule, n int)) error {
+		for _, entry := range entries {
+			name, value, ok := strings.Cut(entry, ":")
+			name = strings.ToUpper(strings.TrimSpace(name))
+			rule, known := rules[name]
+			if !ok || !known {
+				return fmt.Errorf("invalid hint %s %q, want TYPE:n with TYPE one of %s", what, entry, strings.Join(hintTypes, ", "))
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid hint %s %q: not a whole number", what, entry)
+			}
+			set(&rule, n)
+			rules[name] = rule
+		}
+		return nil
+	}
+	if err := apply(costs, "cost", func(r *HintRule, n int) { r.Cost = n }); err != nil {
+		return nil, err
+	}
+	if err := apply(limits, "limit", func(r *HintRule, n int) { r.Limit = n }); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// TimelineEvent is something that happened during a game that isn't a
+// token, shown to every player. Only hints are recorded so far.
+type TimelineEvent struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Timestamp int64  `json:"timestamp"`
+	HintType  string `json:"hintType,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Cost      int    `json:"cost,omitempty"`
+}
+
+// HintOption tells the Mayor which hints they can still buy.
+type HintOption struct {
+	Type      string `json:"type"`
+	Cost      int    `json:"cost"`
+	Remaining int    `json:"remaining"`
+}
+
+// hintLimit is how many hints of a type this game's word allows.
+// Must be called with lock held.
+func (r *Room) hintLimit(hintType string) int {
+	rule := hintRules[hintType]
+	switch hintType {
+	case HintLetter:
+		if rule.Limit == 0 {
+			return letterCount(r.secretWord) / 2
+		}
+	case HintFirstLetter:
+		// Nothing to tell once a LETTER hint has shown it
+		if slices.Contains(r.hintIndices, 0) {
+			return 0
+		}
+	case HintCategory, HintClue:
+		e, ok := r.words.lookupIn(r.language, r.secretWord)
+		if !ok || (hintType == HintClue && e.Hint == "") {
+			return 0
+		}
+	}
+	return rule.Limit
+}
+
+// hintsUsed counts the hints of a type revealed this game.
+// Must be called with lock held.
+func (r *Room) hintsUsed(hintType string) int {
+	n := 0
+	for _, ev := range r.timeline {
+		if ev.Type == TimelineHint && ev.HintType == hintType {
+			n++
+		}
+	}
+	return n
+}
+
+// hintOptions lists the hints the Mayor can still buy.
+// Must be called with lock held.
+func (r *Room) hintOptions() []HintOption {
+	options := make([]HintOption, 0, len(hintTypes))
+	for _, t := range hintTypes {
+		if left := r.hintLimit(t) - r.hintsUsed(t); left > 0 {
+			options = append(options, HintOption{Type: t, Cost: hintRules[t].Cost, Remaining: left})
+		}
+	}
+	return options
+}
+
+// revealHint works out a hint of the given type and records it, or returns
+// an error message if it can't be given.
+// Must be called with lock held.
+func (r *Room) revealHint(hintType string) string {
+	rule, ok := hintRules[hintType]
+	if !ok {
+		return "Unknown hint type"
+	}
+	if r.hintsUsed(hintType) >= r.hintLimit(hintType) {
+		return "No more hints of that kind available"
+	}
+
+	word := []rune(r.secretWord)
+	phrases := hintPhrasesFor(r.language)
+	var text string
+	switch hintType {
+	case HintLetter:
+		// Pick a random unrevealed letter index
+		revealed := make(map[int]bool)
+		for _, idx := range r.hintIndices {
+			revealed[idx] = true
+		}
+		candidates := make([]int, 0)
+		for i, ch := range word {
+			if isHintable(ch) && !revealed[i] {
+				candidates = append(candidates, i)
+			}
+		}
+		if len(candidates) == 0 {
+			return "No more hints of that kind available"
+		}
+		chosen := candidates[rand.Intn(len(candidates))]
+		r.hintIndices = append(r.hintIndices, chosen)
+		r.hintsRevealed++
+		text = fmt.Sprintf(phrases.letter, chosen+1, string(word[chosen]))
+	case HintFirstLetter:
+		text = fmt.Sprintf(phrases.firstLetter, string(word[0]))
+		r.revealLetter(0)
+	case HintLength:
+		text = lengthHint(r.secretWord, r.language)
+	case HintSyllables:
+		n := syllableCount(r.secretWord, r.language)
+		text = countPhrase(n, phrases.syllablesOne, phrases.syllables)
+	case HintCategory:
+		e, _ := r.words.lookupIn(r.language, r.secretWord)
+		text = categoryHint(e.Category, r.language)
+	case HintClue:
+		e, _ := r.words.lookupIn(r.language, r.secretWord)
+		text = e.Hint
+	}
+
+	r.timeline = append(r.timeline, TimelineEvent{
+		ID:        newUUID(),
+		Type:      TimelineHint,
+		Timestamp: time.Now().UnixMilli(),
+		HintType:  hintType,
+		Text:      text,
+		Cost:      rule.Cost,
+	})
+	return ""
+}
+
+// revealLetter shows a letter in the hint string without counting it as a
+// LETTER hint. Must be called with lock held.
+func (r *Room) revealLetter(idx int) {
+	for _, i := range r.hintIndices {
+		if i == idx {
+			return
+		}
+	}
+	r.hintIndices = append(r.hintIndices, idx)
+}
+
+// lengthHint describes a word's length in letters, word by word for phrases,
+// in language.
+func lengthHint(word, language string) string {
+	phrases := hintPhrasesFor(language)
+	parts := strings.Fields(word)
+	counts := make([]string, len(parts))
+	total := 0
+	for i, p := range parts {
+		n := letterCount(p)
+		counts[i] = strconv.Itoa(n)
+		total += n
+	}
+	if len(parts) > 1 {
+		return fmt.Sprintf(phrases.phraseLength, len(parts), strings.Join(counts, " + "))
+	}
+	return countPhrase(total, phrases.lettersOne, phrases.letters)
+}
+
+// isHintable reports whether a character of the word is hidden in the hint
+// pattern; spaces, hyphens and apostrophes are always shown.
+func isHintable(ch rune) bool {
+	return unicode.IsLetter(ch) || unicode.IsDigit(ch)
+}
+
+// letterCount is the number of letters in s, not counting spaces or
+// punctuation.
+func letterCount(s string) int {
+	n := 0
+	for _, ch := range s {
+		if unicode.IsLetter(ch) {
+			n++
+		}
+	}
+	return n
+}
+
+// syllableCount estimates syllables by counting groups of vowels, less a
+// silent final "e" in English and French. It's a guide, not a dictionary.
+func syllableCount(word, language string) int {
+	silentE := language == "en" || language == "fr"
+	total := 0
+	for _, w := range phraseWords(foldWord(word)) {
+		n, inVowel := 0, false
+		for _, ch := range w {
+			v := isVowel(ch)
+			if v && !inVowel {
+				n++
+			}
+			inVowel = v
+		}
+		if silentE && n > 1 && strings.HasSuffix(w, "e") && !strings.HasSuffix(w, "le") && !strings.HasSuffix(w, "ee") {
+			n--
+		}
+		total += max(n, 1)
+	}
+	return total
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiouyàáâäãåæèéêëìíîïòóôöõøœùúûüý", r)
+}
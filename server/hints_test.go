@@ -0,0 +1,52 @@
+package main
+
+import "testing"
+
+func TestHintFormatsCoverEveryLanguage(t *testing.T) {
+	for language := range categoryHintFormats {
+		p, ok := hintFormats[language]
+		if !ok {
+			t.Errorf("%s has category hints but no other hint phrasings", language)
+			continue
+		}
+		for _, f := range []string{p.letter, p.firstLetter, p.phraseLength, p.letters, p.lettersOne, p.syllables, p.syllablesOne} {
+			if f == "" {
+				t.Errorf("%s is missing a hint phrasing: %+v", language, p)
+				break
+			}
+		}
+	}
+}
+
+func TestLengthHint(t *testing.T) {
+	tests := []struct{ word, language, want string }{
+		{"Horse", "en", "5 letters"},
+		{"Hot Dog", "en", "2 words: 3 + 3 letters"},
+		{"Perro caliente", "es", "2 palabras: 5 + 8 letras"},
+		{"Hund", "de", "4 Buchstaben"},
+		{"Y", "fr", "1 lettre"},
+		{"Cane", "it", "4 letters"},
+	}
+	for _, tt := range tests {
+		if got := lengthHint(tt.word, tt.language); got != tt.want {
+			t.Errorf("lengthHint(%q, %q) = %q, want %q", tt.word, tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestFirstLetterHintNotOfferedOnceRevealed(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	r.secretWord = "Horse"
+	r.hintIndices = []int{0}
+	for _, o := range r.hintOptions() {
+		if o.Type == HintFirstLetter {
+			t.Errorf("FIRST_LETTER offered after letter 1 was revealed: %+v", o)
+		}
+	}
+	if msg := r.revealHint(HintFirstLetter); msg == "" {
+		t.Error("FIRST_LETTER hint given after letter 1 was revealed")
+	}
+	if r.hintsUsed(HintFirstLetter) != 0 {
+		t.Error("FIRST_LETTER hint charged after letter 1 was revealed")
+	}
+}
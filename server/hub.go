@@ -1,25 +1,131 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"log"
-	"math/rand"
 	"sync"
+	"time"
 )
 
 type Hub struct {
-	rooms map[string]*Room
-	mu    sync.RWMutex
+	rooms      map[string]*Room
+	clients    map[*Client]bool // every open connection, in a room or not
+	draining   bool             // set on shutdown; no new rooms or games
+	debugRooms map[string]bool  // room codes that log at debug level from creation
+	roomFeed   *roomFeed        // live room list changes for subscribers
+	reaped     []ReapRecord     // recent janitor closures, oldest first
+	codes      CodeGenerator
+	maxRooms   int
+
+	// Cluster membership; a lone node uses an in-memory broker
+	broker      Broker
+	nodeID      string
+	nodeURL     string
+	routing     string
+	proxied     map[string]*Client  // session → local socket proxied to another node
+	remotes     map[string]*Client  // session → stand-in for a player on another node
+	remoteRooms map[string]RoomInfo // code → room hosted on another node, as last synced
+	clusterStop chan struct{}
+
+	// External bot agents
+	agentTokens  map[string]string // token → agent name
+	agentTimeout time.Duration
+	agents       map[string]*Client // agent name → its connection
+	seatedAgents map[*Client]bool   // agents currently driving a bot
+
+	mu sync.RWMutex
 }
 
-func newHub() *Hub {
+func newHub(codes CodeGenerator, maxRooms int) *Hub {
+	// Keep the code space at most half full so random draws stay cheap
+	if limit := codes.Capacity() / 2; maxRooms <= 0 || maxRooms > limit {
+		hubLog().Warn("max rooms capped by room code capacity", "requested", maxRooms, "max_rooms", limit)
+		maxRooms = limit
+	}
 	return &Hub{
-		rooms: make(map[string]*Room),
+		codes:      codes,
+		maxRooms:   maxRooms,
+		rooms:      make(map[string]*Room),
+		clients:    make(map[*Client]bool),
+		debugRooms: make(map[string]bool),
+		roomFeed:   newRoomFeed(),
+
+		broker:      newMemoryBroker(),
+		nodeID:      "local",
+		routing:     RoutingProxy,
+		proxied:     make(map[string]*Client),
+		remotes:     make(map[string]*Client),
+		remoteRooms: make(map[string]RoomInfo),
+		clusterStop: make(chan struct{}),
+
+		agentTokens:  make(map[string]string),
+		agentTimeout: 8 * time.Second,
+		agents:       make(map[string]*Client),
+		seatedAgents: make(map[*Client]bool),
+	}
+}
+
+// setRoomDebug toggles debug-level logging for one room. If the room does
+// not exist yet, the setting is applied when it is created.
+func (h *Hub) setRoomDebug(code string, on bool) {
+	code = normalizeRoomCode(code)
+	h.mu.Lock()
+	if on {
+		h.debugRooms[code] = true
+	} else {
+		delete(h.debugRooms, code)
+	}
+	room := h.rooms[code]
+	h.mu.Unlock()
+
+	if room != nil {
+		room.debugLog.Store(on)
+	}
+}
+
+func (h *Hub) register(c *Client) {
+	h.mu.Lock()
+	h.clients[c] = true
+	h.mu.Unlock()
+}
+
+func (h *Hub) unregister(c *Client) {
+	h.mu.Lock()
+	delete(h.clients, c)
+	h.mu.Unlock()
+}
+
+func (h *Hub) isDraining() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.draining
+}
+
+// roomList returns the current rooms without holding the hub lock afterwards,
+// so callers can lock each room without risking lock-order inversions.
+func (h *Hub) roomList() []*Room {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	rooms := make([]*Room, 0, len(h.rooms))
+	for _, room := range h.rooms {
+		rooms = append(rooms, room)
+	}
+	return rooms
+}
+
+// clientList returns every open connection.
+func (h *Hub) clientList() []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	clients := make([]*Client, 0, len(h.clients))
+	for c := range h.clients {
+		clients = append(clients, c)
 	}
+	return clients
 }
 
 func (h *Hub) handleJoinGame(c *Client, payload JoinGamePayload) {
-	if c.room != nil {
+	if c.room.Load() != nil {
 		c.sendError("You are already in a room")
 		return
 	}
@@ -27,71 +133,144 @@ func (h *Hub) handleJoinGame(c *Client, payload JoinGamePayload) {
 	c.playerID = newUUID()
 
 	if payload.RoomCode != "" {
-		h.mu.RLock()
-		room, exists := h.rooms[payload.RoomCode]
-		h.mu.RUnlock()
-
-		if !exists {
-			c.sendError("Room not found: " + payload.RoomCode)
+		room := h.getRoom(payload.RoomCode)
+		if room == nil {
+			h.routeToOwner(c, payload)
+			return
+		}
+		if payload.RejoinToken != "" {
+			if !room.rejoin(c, payload.PlayerID, payload.RejoinToken) {
+				c.sendNotice("REJOIN_FAILED", "Your seat in that game is no longer available")
+			}
 			return
 		}
 
 		room.addClient(c, payload.Name, payload.AvatarURL)
-		log.Printf("[Hub] Player %q joined room %s", payload.Name, payload.RoomCode)
+		hubLog().Info("player joined room", logKeyRoom, room.code, logKeyPlayer, c.playerID, logKeyName, payload.Name)
 	} else {
-		code := h.generateRoomCode()
-		room := newRoom(code, h)
+		room, err := h.createRoom()
+		if err != nil {
+			c.sendError(err.Error())
+			return
+		}
+
+		room.addClient(c, payload.Name, payload.AvatarURL)
+		hubLog().Info("player created room", logKeyRoom, room.code, logKeyPlayer, c.playerID, logKeyName, payload.Name)
+	}
+}
+
+// createRoom registers a new room under a fresh code, refusing when the
+// server is draining or already holds maxRooms rooms.
+func (h *Hub) createRoom() (*Room, error) {
+	h.mu.RLock()
+	err := h.checkCanCreateRoom()
+	h.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// Claiming a code can take a broker round trip, so it's done without
+	// the hub lock and the checks are repeated once the lock is taken
+	for i := 0; i < maxCodeAttempts; i++ {
+		code, err := h.generateRoomCode()
+		if err != nil {
+			hubLog().Error("room code generation failed", "error", err)
+			return nil, errors.New("Couldn't create a room right now. Please try again")
+		}
 
 		h.mu.Lock()
+		if _, exists := h.rooms[code]; exists {
+			h.mu.Unlock()
+			continue // Drawn and claimed by a creation running alongside this one
+		}
+		if err := h.checkCanCreateRoom(); err != nil {
+			h.mu.Unlock()
+			h.releaseClaim(code)
+			return nil, err
+		}
+		room := newRoom(code, h)
 		h.rooms[code] = room
+		room.debugLog.Store(h.debugRooms[code])
 		h.mu.Unlock()
+		return room, nil
+	}
+	hubLog().Error("room code generation failed", "error", errNoRoomCode)
+	return nil, errors.New("Couldn't create a room right now. Please try again")
+}
 
-		room.addClient(c, payload.Name, payload.AvatarURL)
-		log.Printf("[Hub] Player %q created room %s", payload.Name, code)
+// checkCanCreateRoom returns the error to show a player when no room can be
+// created. Must be called with h.mu held.
+func (h *Hub) checkCanCreateRoom() error {
+	if h.draining {
+		return errors.New("Server is restarting — new rooms can't be created right now")
 	}
+	if len(h.rooms) >= h.maxRooms {
+		metrics.roomsRejected.inc("")
+		hubLog().Warn("room limit reached", "max_rooms", h.maxRooms)
+		return fmt.Errorf("The server is full (%d rooms). Please try again in a few minutes", h.maxRooms)
+	}
+	return nil
 }
 
 // listRooms returns info about all rooms currently in lobby phase.
 func (h *Hub) listRooms() []RoomInfo {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-
-	rooms := make([]RoomInfo, 0)
-	for _, room := range h.rooms {
-		room.mu.Lock()
-		names := make([]string, 0)
-		for _, id := range room.order {
-			if p := room.players[id]; p != nil {
-				names = append(names, p.Name)
-			}
-		}
-		rooms = append(rooms, RoomInfo{
-			Code:        room.code,
-			PlayerCount: len(room.players),
-			MaxPlayers:  maxPlayers,
-			Phase:       room.phase,
-			PlayerNames: names,
-		})
-		room.mu.Unlock()
-	}
+	rooms, _ := h.queryRooms(RoomQuery{JoinableOnly: true})
 	return rooms
 }
 
+// removeRoom drops a room from the registry. It's called with the room's
+// lock held, so the claim is released in the background rather than making
+// a broker round trip under it.
 func (h *Hub) removeRoom(code string) {
 	h.mu.Lock()
 	delete(h.rooms, code)
 	h.mu.Unlock()
-	log.Printf("[Hub] Room %s removed", code)
+	go h.releaseClaim(code)
+	h.roomFeed.publish(RoomEvent{Type: RoomEventRemoved, Room: RoomInfo{Code: code}})
+	hubLog().Info("room removed", logKeyRoom, code)
+}
+
+// releaseClaim gives up this node's cluster-wide claim on a room code.
+func (h *Hub) releaseClaim(code string) {
+	if err := h.broker.Release(code, h.nodeID); err != nil {
+		hubLog().Warn("releasing room claim", logKeyRoom, code, "error", err)
+	}
 }
 
-func (h *Hub) generateRoomCode() string {
-	for {
-		code := fmt.Sprintf("WOLF-%04d", rand.Intn(10000))
-		h.mu.RLock()
-		_, exists := h.rooms[code]
-		h.mu.RUnlock()
-		if !exists {
-			return code
+// generateRoomCode draws codes until it finds one that is unused here and
+// can be claimed cluster-wide. Must be called without h.mu held, since a
+// claim can be a broker round trip.
+func (h *Hub) generateRoomCode() (string, error) {
+	for i := 0; i < maxCodeAttempts; i++ {
+		code := normalizeRoomCode(h.codes.Generate())
+		if h.getRoom(code) != nil {
+			continue
+		}
+		claimed, err := h.broker.Claim(code, h.nodeID)
+		if err != nil {
+			return "", err
+		}
+		if claimed {
+			return code, nil
 		}
 	}
+	return "", errNoRoomCode
+}
+
+// getRoom looks a room up by code, ignoring case and separator style.
+func (h *Hub) getRoom(code string) *Room {
+	code = normalizeRoomCode(code)
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.rooms[code]
+}
+
+// announce sends a server-wide notice to every connected client.
+func (h *Hub) announce(message string) int {
+	clients := h.clientList()
+	for _, c := range clients {
+		c.sendNotice("ANNOUNCEMENT", message)
+	}
+	hubLog().Info("announcement broadcast", "clients", len(clients))
+	return len(clients)
 }
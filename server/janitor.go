@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxReapRecords bounds how many reaped rooms the hub remembers for the admin API.
+const maxReapRecords = 100
+
+// RoomTTLs are how long a room may sit idle in each phase before it is
+// reaped. Phases without an entry use Default.
+type RoomTTLs struct {
+	ByPhase map[string]time.Duration
+	Default time.Duration
+	Warning time.Duration // how long before expiry clients are warned
+}
+
+func (t RoomTTLs) forPhase(phase string) time.Duration {
+	if ttl, ok := t.ByPhase[phase]; ok {
+		return ttl
+	}
+	return t.Default
+}
+
+// ReapRecord explains why the janitor closed a room.
+type ReapRecord struct {
+	Code     string `json:"code"`
+	Phase    string `json:"phase"`
+	Players  int    `json:"players"`
+	IdleFor  string `json:"idleFor"`
+	TTL      string `json:"ttl"`
+	Reason   string `json:"reason"`
+	ReapedAt int64  `json:"reapedAt"`
+}
+
+// touch records player activity, postponing expiry.
+func (r *Room) touch() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.markActive()
+}
+
+// markActive resets the idle clock. Must be called with lock held.
+func (r *Room) markActive() {
+	r.lastActivity = time.Now()
+	r.expiryWarned = false
+}
+
+// runJanitor sweeps rooms every interval until stop is closed.
+// A non-positive interval disables reaping.
+func (h *Hub) runJanitor(ttls RoomTTLs, interval time.Duration, stop <-chan struct{}) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.reapIdleRooms(ttls, time.Now())
+		case <-stop:
+			return
+		}
+	}
+}
+
+// reapIdleRooms warns rooms nearing their TTL and closes those past it.
+func (h *Hub) reapIdleRooms(ttls RoomTTLs, now time.Time) {
+	for _, room := range h.roomList() {
+		room.mu.Lock()
+		phase := room.phase
+		ttl := ttls.forPhase(phase)
+		idle := now.Sub(room.lastActivity)
+
+		if ttl <= 0 || idle < ttl-ttls.Warning {
+			room.mu.Unlock()
+			continue
+		}
+		if idle < ttl {
+			if !room.expiryWarned {
+				room.expiryWarned = true
+				notice := CountdownPayload{
+					SecondsRemaining: int((ttl - idle).Round(time.Second).Seconds()),
+					Message:          "This room has been idle for a while and will close soon. Do anything to keep it open.",
+				}
+				for _, c := range room.clients {
+					c.sendCountdown("ROOM_EXPIRING", notice)
+				}
+				room.logger().Info("room expiry warning sent", "idle", idle.Round(time.Second).String(), "ttl", ttl.String())
+			}
+			room.mu.Unlock()
+			continue
+		}
+
+		rec := ReapRecord{
+			Code:     room.code,
+			Phase:    phase,
+			Players:  len(room.players),
+			IdleFor:  idle.Round(time.Second).String(),
+			TTL:      ttl.String(),
+			Reason:   fmt.Sprintf("idle in %s for %s (limit %s)", phase, idle.Round(time.Second), ttl),
+			ReapedAt: now.UnixMilli(),
+		}
+		// Close while still holding the lock, so a player acting now
+		// can't be caught between the idle check and the close
+		room.closeLocked("This room was closed after being idle for too long")
+		room.mu.Unlock()
+		h.recordReap(rec)
+	}
+}
+
+func (h *Hub) recordReap(rec ReapRecord) {
+	h.mu.Lock()
+	h.reaped = append(h.reaped, rec)
+	if len(h.reaped) > maxReapRecords {
+		h.reaped = h.reaped[len(h.reaped)-maxReapRecords:]
+	}
+	h.mu.Unlock()
+
+	metrics.roomsReaped.inc(rec.Phase)
+	hubLog().Info("room reaped", logKeyRoom, rec.Code, logKeyPhase, rec.Phase, "idle", rec.IdleFor, "ttl", rec.TTL, "players", rec.Players)
+}
+
+// reapHistory returns the most recent reap records, newest first.
+func (h *Hub) reapHistory() []ReapRecord {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	out := make([]ReapRecord, len(h.reaped))
+	for i, rec := range h.reaped {
+		out[len(h.reaped)-1-i] = rec
+	}
+	return out
+}
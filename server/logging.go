@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Log attribute keys, used consistently so one game can be traced end to end.
+const (
+	logKeyComponent = "component"
+	logKeyRoom      = "room"
+	logKeyEpoch     = "epoch"
+	logKeyPhase     = "phase"
+	logKeyPlayer    = "player"
+	logKeyName      = "name"
+	logKeyMsgType   = "msg_type"
+)
+
+// setupLogging installs the default slog logger. format is "json" or "text".
+func setupLogging(format, level string) {
+	opts := &slog.HandlerOptions{Level: parseLogLevel(level)}
+	var handler slog.Handler
+	if strings.EqualFold(format, "json") {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	}
+	slog.SetDefault(slog.New(handler))
+}
+
+func parseLogLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// debugOverrideHandler lets a single room log at debug level while the
+// rest of the server stays at the configured level.
+type debugOverrideHandler struct {
+	slog.Handler
+	debug *atomic.Bool
+}
+
+func (h debugOverrideHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.debug.Load() && level >= slog.LevelDebug {
+		return true
+	}
+	return h.Handler.Enabled(ctx, level)
+}
+
+func (h debugOverrideHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return debugOverrideHandler{Handler: h.Handler.WithAttrs(attrs), debug: h.debug}
+}
+
+func (h debugOverrideHandler) WithGroup(name string) slog.Handler {
+	return debugOverrideHandler{Handler: h.Handler.WithGroup(name), debug: h.debug}
+}
+
+// hubLog is the logger for hub-level events.
+func hubLog() *slog.Logger {
+	return slog.Default().With(logKeyComponent, "hub")
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -12,26 +16,135 @@ import (
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		return true // Allow all origins (fine for dev; restrict in production if needed)
-	},
 }
 
 func main() {
-	hub := newHub()
+	if len(os.Args) > 1 && os.Args[1] == "validate-wordpacks" {
+		os.Exit(validateWordPacksCommand(os.Args[2:]))
+	}
+
+	cfg := loadConfig()
+	setupLogging(cfg.LogFormat, cfg.LogLevel)
+	if err := cfg.checkTLS(); err != nil {
+		slog.Error("invalid TLS settings", "error", err)
+		os.Exit(1)
+	}
+
+	// --- Word Packs (reloaded on SIGHUP) ---
+	wordPacksDir = cfg.WordPacksDir
+	bank, err := reloadWordPacks(wordPacksDir)
+	if err != nil {
+		slog.Error("loading word packs", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("word packs loaded", "packs", len(bank.packs), "entries", bank.entryCount(), "dir", wordPacksDir)
+	watchWordPacksSIGHUP()
+
+	if dictionary, err = loadDictionary(cfg.DictionaryDir); err != nil {
+		slog.Error("loading dictionary", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("dictionary loaded", "words", dictionary.wordCount(), "blocked", dictionary.blockedCount(), "dir", cfg.DictionaryDir)
+
+	if hintRules, err = parseHintRules(cfg.HintCosts, cfg.HintLimits); err != nil {
+		slog.Error("invalid hint settings", "error", err)
+		os.Exit(1)
+	}
+
+	// --- Word Stats (saved periodically and at shutdown) ---
+	if cfg.AdaptiveTargetWinRate <= 0 || cfg.AdaptiveTargetWinRate >= 1 {
+		slog.Error("invalid ADAPTIVE_TARGET_WIN_RATE, want a fraction between 0 and 1", "value", cfg.AdaptiveTargetWinRate)
+		os.Exit(1)
+	}
+	adaptiveTargetWinRate = cfg.AdaptiveTargetWinRate
+	if cfg.WordStatsFile != "" {
+		if err := wordStats.load(cfg.WordStatsFile); err != nil {
+			slog.Error("loading word stats", "file", cfg.WordStatsFile, "error", err)
+			os.Exit(1)
+		}
+		slog.Info("word stats loaded", "words", len(wordStats.list("")), "file", cfg.WordStatsFile)
+		watchWordStats()
+	}
+
+	codes, err := newCodeGenerator(cfg.RoomCodeStyle)
+	if err != nil {
+		slog.Error("invalid room code style", "error", err)
+		os.Exit(1)
+	}
+	hub := newHub(codes, cfg.MaxRooms)
+
+	broker, err := newBroker(cfg.Broker, cfg.BrokerClaimTTL)
+	if err != nil {
+		slog.Error("connecting to broker", "error", err)
+		os.Exit(1)
+	}
+	if cfg.Routing != RoutingProxy && cfg.Routing != RoutingRedirect {
+		slog.Error("invalid CLUSTER_ROUTING, want proxy or redirect", "routing", cfg.Routing)
+		os.Exit(1)
+	}
+	if err := hub.joinCluster(broker, cfg.NodeID, cfg.NodeURL, cfg.Routing, cfg.BrokerClaimTTL); err != nil {
+		slog.Error("joining cluster", "error", err)
+		os.Exit(1)
+	}
+	agentTokens, err := parseAgentTokens(cfg.AgentTokens)
+	if err != nil {
+		slog.Error("invalid AGENT_TOKENS", "error", err)
+		os.Exit(1)
+	}
+	hub.setAgents(agentTokens, cfg.AgentTimeout)
+	if len(agentTokens) > 0 {
+		slog.Info("external bot agents enabled", "agents", len(agentTokens), "timeout", cfg.AgentTimeout)
+	}
+	for _, code := range cfg.LogDebugRooms {
+		hub.setRoomDebug(code, true)
+	}
+
+	// Empty ALLOWED_ORIGINS keeps the old allow-all behaviour for local dev
+	origins := newOriginAllowlist(cfg.AllowedOrigins)
+	upgrader.CheckOrigin = origins.check
+	if len(cfg.AllowedOrigins) > 0 {
+		slog.Info("websocket origins restricted", "origins", cfg.AllowedOrigins)
+	}
+
+	// --- Idle Room Janitor ---
+	janitorStop := make(chan struct{})
+	go hub.runJanitor(cfg.RoomTTLs, cfg.JanitorInterval, janitorStop)
 
 	// --- WebSocket Endpoint ---
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		agent, ok := hub.agentFromRequest(r)
+		if !ok {
+			slog.Warn("rejected agent with unknown token", "remote", r.RemoteAddr)
+			http.Error(w, "invalid agent token", http.StatusUnauthorized)
+			return
+		}
+		client := &Client{
+			hub:   hub,
+			send:  make(chan []byte, 256),
+			agent: agent,
+		}
+		if agent != "" {
+			// Agents get their player ID up front so a host can seat them
+			client.playerID = newUUID()
+			if err := hub.registerAgent(client); err != nil {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+		}
+
 		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
-			log.Printf("WebSocket upgrade error: %v", err)
+			slog.Warn("websocket upgrade failed", "remote", r.RemoteAddr, "error", err)
+			if agent != "" {
+				hub.unregisterAgent(client)
+			}
 			return
 		}
-
-		client := &Client{
-			hub:  hub,
-			conn: conn,
-			send: make(chan []byte, 256),
+		client.conn = conn
+		hub.register(client)
+		if agent != "" {
+			client.sendMessage("AGENT_READY", AgentReadyPayload{Name: agent, PlayerID: client.playerID})
+			client.logger().Info("agent connected")
 		}
 
 		go client.writePump()
@@ -44,30 +157,74 @@ func main() {
 		fmt.Fprint(w, "OK")
 	})
 
+	// --- Room Browser API ---
+	http.HandleFunc("GET /api/rooms", hub.handleListRooms)
+	http.HandleFunc("GET /api/rooms/events", hub.handleRoomEvents)
+
+	// --- Prometheus Metrics ---
+	http.HandleFunc("/metrics", metrics.handler(hub))
+
+	// --- Admin API (disabled unless ADMIN_TOKEN is set) ---
+	if cfg.AdminToken != "" {
+		http.Handle("/admin/", newAdminHandler(hub, cfg.AdminToken))
+		slog.Info("admin API enabled", "path", "/admin/")
+	}
+
 	// --- Serve Static Frontend Build ---
-	staticDir := "./static"
+	staticDir := cfg.StaticDir
 	if info, err := os.Stat(staticDir); err == nil && info.IsDir() {
 		fs := http.FileServer(http.Dir(staticDir))
 		http.Handle("/", fs)
-		log.Printf("Serving static files from %s", staticDir)
+		slog.Info("serving static files", "dir", staticDir)
 	} else {
-		log.Printf("No static directory found at %s — only WebSocket endpoint is active", staticDir)
+		slog.Info("no static directory found, only the websocket endpoint is active", "dir", staticDir)
 		http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprint(w, "Werewords Server is running. Connect via WebSocket at /ws")
 		})
 	}
 
 	// --- Start Server ---
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	addr := fmt.Sprintf(":%s", cfg.Port)
+	server := &http.Server{Addr: addr}
+
+	serveErr := make(chan error, 1)
+	if cfg.tlsEnabled() {
+		certs, err := newCertReloader(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			slog.Error("loading TLS certificate", "error", err)
+			os.Exit(1)
+		}
+		certs.watchSIGHUP()
+		server.TLSConfig = certs.tlsConfig()
+
+		slog.Info("werewords server starting", "url", "https://localhost"+addr, "websocket", "wss://localhost"+addr+"/ws", "tls", true)
+		// Certificates come from TLSConfig.GetCertificate, so no files are passed here
+		go func() { serveErr <- server.ListenAndServeTLS("", "") }()
+	} else {
+		slog.Info("werewords server starting", "url", "http://localhost"+addr, "websocket", "ws://localhost"+addr+"/ws", "tls", false)
+		go func() { serveErr <- server.ListenAndServe() }()
+	}
+
+	// --- Graceful Shutdown ---
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-serveErr:
+		slog.Error("listen and serve", "error", err)
+		os.Exit(1)
+	case sig := <-sigCh:
+		slog.Info("signal received, draining rooms", "signal", sig.String())
 	}
 
-	addr := fmt.Sprintf(":%s", port)
-	log.Printf("Werewords server starting on http://localhost%s", addr)
-	log.Printf("WebSocket endpoint: ws://localhost%s/ws", addr)
+	close(janitorStop)
+	hub.shutdown(cfg.ShutdownGrace, cfg.ShutdownSnapshotFile)
+	hub.leaveCluster()
+	wordStats.save()
 
-	if err := http.ListenAndServe(addr, nil); err != nil {
-		log.Fatal("ListenAndServe: ", err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Error("http server shutdown", "error", err)
 	}
 }
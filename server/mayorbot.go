@@ -0,0 +1,391 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// The bot Mayor reads each player's pending question or guess and answers it
+// from the secret word's facts, the way a careful human Mayor would.
+
+// factQuestion answers one kind of yes/no question. answer returns
+// TokenYes, TokenNo or TokenMaybe.
+type factQuestion struct {
+	pattern *regexp.Regexp
+	answer  func(f WordFacts) string
+}
+
+func yesNo(b bool) string {
+	if b {
+		return TokenYes
+	}
+	return TokenNo
+}
+
+func hasTrait(trait string) func(WordFacts) string {
+	return func(f WordFacts) string { return yesNo(f.Traits[trait]) }
+}
+
+func inCategory(categories ...string) func(WordFacts) string {
+	return func(f WordFacts) string {
+		for _, c := range categories {
+			if f.Category == c {
+				return TokenYes
+			}
+		}
+		return TokenNo
+	}
+}
+
+// Order matters: the first matching question wins, so specific phrasings
+// ("live in water") come before general ones ("alive").
+var factQuestions = []factQuestion{
+	{regexp.MustCompile(`\b(water|swim|swims|sea|ocean|lake|river|wet)\b`), hasTrait("water")},
+	{regexp.MustCompile(`\b(fly|flies|flying|wings?)\b`), hasTrait("flies")},
+	{regexp.MustCompile(`\bsky\b`), func(f WordFacts) string { return yesNo(f.Traits["sky"] || f.Traits["flies"]) }},
+	{regexp.MustCompile(`\b(inside|indoors?|in (a|the) house|at home)\b`), func(f WordFacts) string {
+		return insideOutside(f.Traits["indoors"], f.Traits["outdoors"])
+	}},
+	{regexp.MustCompile(`\b(outside|outdoors?)\b`), func(f WordFacts) string {
+		return insideOutside(f.Traits["outdoors"], f.Traits["indoors"])
+	}},
+	{regexp.MustCompile(`\bpets?\b`), hasTrait("pet")},
+	{regexp.MustCompile(`\bfarm\b`), hasTrait("farm")},
+	{regexp.MustCompile(`\b(wild|zoo)\b`), hasTrait("wild")},
+	{regexp.MustCompile(`\b(animal|mammal|beast)s?\b`), func(f WordFacts) string {
+		if f.Category == "creature" {
+			return TokenMaybe
+		}
+		return inCategory("animal")(f)
+	}},
+	{regexp.MustCompile(`\b(person|people|human|job|profession|occupation|someone)\b`), func(f WordFacts) string {
+		if f.Category == "creature" {
+			return TokenMaybe
+		}
+		return inCategory("person")(f)
+	}},
+	{regexp.MustCompile(`\b(magic|magical|myth|mythical|imaginary|fictional|fantasy|made up)\b`), hasTrait("imaginary")},
+	{regexp.MustCompile(`\b(real|exists?)\b`), func(f WordFacts) string { return yesNo(!f.Traits["imaginary"]) }},
+	{regexp.MustCompile(`\b(alive|living|breathe|breathes)\b`), func(f WordFacts) string {
+		if f.Traits["imaginary"] && f.Traits["living"] {
+			return TokenMaybe
+		}
+		return hasTrait("living")(f)
+	}},
+	{regexp.MustCompile(`\b(drink|beverage|liquid)\b`), inCategory("drink")},
+	{regexp.MustCompile(`\bfruits?\b`), hasTrait("fruit")},
+	{regexp.MustCompile(`\b(sweet|dessert|sugar|sugary)\b`), hasTrait("sweet")},
+	{regexp.MustCompile(`\b(food|eat|eats|edible|eaten|snack|meal|taste|tasty)\b`), hasTrait("edible")},
+	{regexp.MustCompile(`\b(plant|plants|grow|grows)\b`), func(f WordFacts) string {
+		if f.Traits["fruit"] {
+			return TokenMaybe
+		}
+		return inCategory("plant")(f)
+	}},
+	{regexp.MustCompile(`\bweather\b`), inCategory("weather")},
+	{regexp.MustCompile(`\b(space|planet|universe|galaxy)\b`), inCategory("space")},
+	{regexp.MustCompile(`\b(science|scientific)\b`), inCategory("science", "space")},
+	{regexp.MustCompile(`\b(place|location|building|somewhere|visit|go to)\b`), func(f WordFacts) string {
+		if f.Category == "nature" && f.Size == sizeHuge {
+			return TokenMaybe
+		}
+		return inCategory("place")(f)
+	}},
+	{regexp.MustCompile(`\b(nature|natural)\b`), func(f WordFacts) string {
+		switch {
+		case f.Traits["manmade"]:
+			return TokenNo
+		case f.Category == "animal":
+			return TokenMaybe
+		default:
+			return inCategory("nature", "plant", "weather", "space")(f)
+		}
+	}},
+	{regexp.MustCompile(`\b(man-?made|made|built|invented|manufactured|artificial)\b`), hasTrait("manmade")},
+	{regexp.MustCompile(`\b(toy|toys|play with)\b`), inCategory("toy")},
+	{regexp.MustCompile(`\b(sport|sports)\b`), hasTrait("sport")},
+	{regexp.MustCompile(`\b(game|games)\b`), func(f WordFacts) string { return yesNo(f.Traits["game"] || f.Traits["sport"]) }},
+	{regexp.MustCompile(`\b(jewel|jewels|jewelry|jewellery)\b`), inCategory("jewelry")},
+	{regexp.MustCompile(`\b(wear|wearable|clothes|clothing|put on)\b`), hasTrait("wearable")},
+	{regexp.MustCompile(`\b(instrument|music|musical)\b`), func(f WordFacts) string {
+		if f.Category != "instrument" && f.Traits["noisy"] {
+			return TokenMaybe
+		}
+		return inCategory("instrument")(f)
+	}},
+	{regexp.MustCompile(`\b(vehicle|ride|drive|transport|transportation)\b`), inCategory("vehicle")},
+	{regexp.MustCompile(`\b(holiday|celebration|event|party)\b`), inCategory("event")},
+	{regexp.MustCompile(`\b(body|organ)\b`), inCategory("body")},
+	{regexp.MustCompile(`\b(pattern|design)\b`), inCategory("pattern")},
+	{regexp.MustCompile(`\b(electric|electronic|electricity|battery|plug|technology|tech|machine)\b`), hasTrait("electric")},
+	{regexp.MustCompile(`\b(noise|noisy|sound|sounds|loud|hear)\b`), hasTrait("noisy")},
+	{regexp.MustCompile(`\b(dangerous|danger|hurt|harm|harmful|deadly|scary|kill)\b`), hasTrait("dangerous")},
+	{regexp.MustCompile(`\bsharp\b`), hasTrait("sharp")},
+	{regexp.MustCompile(`\b(fast|quick|speed|speedy)\b`), hasTrait("fast")},
+	{regexp.MustCompile(`\b(hot|warm|heat)\b`), hasTrait("hot")},
+	{regexp.MustCompile(`\b(cold|ice|icy|freezing|frozen|winter)\b`), hasTrait("cold")},
+	{regexp.MustCompile(`\b(round|circle|circular|sphere|spherical)\b`), hasTrait("round")},
+	{regexp.MustCompile(`\b(feeling|emotion|idea|concept|abstract)\b`), hasTrait("abstract")},
+	{regexp.MustCompile(`\b(touch|physical|tangible|see it)\b`), func(f WordFacts) string {
+		if f.Traits["abstract"] {
+			return TokenNo
+		}
+		if f.Size == sizeNone {
+			return TokenMaybe
+		}
+		return TokenYes
+	}},
+	{regexp.MustCompile(`\b(hold|carry|pick up|hand|hands|pocket)\b`), hasTrait("holdable")},
+	{regexp.MustCompile(`\b(big|bigger|large|larger|huge|giant|tall)\b`), func(f WordFacts) string {
+		return sizeAnswer(f.Size >= sizeLarge, f.Size)
+	}},
+	{regexp.MustCompile(`\b(small|smaller|tiny|little|fit in)\b`), func(f WordFacts) string {
+		return sizeAnswer(f.Size <= sizeSmall, f.Size)
+	}},
+	{regexp.MustCompile(`\b(object|thing|item)\b`), func(f WordFacts) string {
+		switch {
+		case f.Traits["abstract"]:
+			return TokenNo
+		case f.Traits["manmade"] && f.Category != "place" && f.Category != "vehicle":
+			return TokenYes
+		default:
+			return TokenMaybe
+		}
+	}},
+}
+
+// insideOutside answers "is it found inside?" (or outside) for a word that
+// is found here (yes) and/or there (other).
+func insideOutside(here, other bool) string {
+	switch {
+	case here && !other:
+		return TokenYes
+	case other && !here:
+		return TokenNo
+	default:
+		return TokenMaybe
+	}
+}
+
+func sizeAnswer(matches bool, size int) string {
+	if size == sizeNone || size == sizeMedium {
+		return TokenMaybe
+	}
+	return yesNo(matches)
+}
+
+// botQuestionLanguage is the only language bots ask questions in and the
+// bot Mayor understands them in: botQuestions and the patterns here are
+// English. In other rooms bots only guess words, and a bot Mayor answers
+// questions MAYBE.
+const botQuestionLanguage = "en"
+
+var (
+	questionStart = regexp.MustCompile(`^(is|are|does|do|can|could|would|will|has|have|was|were|did|should|am|what|where|who|which|how|why|when)\b`)
+	negation      = regexp.MustCompile(`\bnot\b`)
+	// "is it a duck?", "could it be the moon", "maybe a dolphin"
+	guessInQuestion = regexp.MustCompile(`^(?:is it|it's|it is|could it be|would it be|maybe|perhaps)(?: an?| the| some)? (\pL[\pL\pM -]*?)\s*$`)
+	nonLetters      = regexp.MustCompile(`[^\pL\pM' -]+`)
+)
+
+// answerAsMayor picks the token a truthful Mayor would give in response to
+// a player's message about the secret word.
+// Must be called with lock held.
+func (r *Room) answerAsMayor(secret, text string) string {
+	return r.parseMessage(text).answer(r, secret)
+}
+
+// playerMessage is a question or guess parsed once, so bots can predict
+// the answer for many candidate words cheaply.
+type playerMessage struct {
+	text       string // lower-case, letters only
+	isQuestion bool
+	// "Is it a dolphin?" is a guess in question form; known reports
+	// whether the named word is in the room's word packs.
+	named      string
+	namedKnown bool
+	rule       *factQuestion // nil if no fact question matched
+	negated    bool
+}
+
+// Must be called with lock held.
+func (r *Room) parseMessage(text string) playerMessage {
+	msg := foldWord(text)
+	msg = strings.TrimSpace(nonLetters.ReplaceAllString(msg, " "))
+	m := playerMessage{
+		text:       msg,
+		isQuestion: strings.Contains(text, "?") || questionStart.MatchString(msg),
+	}
+	if !m.isQuestion || r.language != botQuestionLanguage {
+		return m
+	}
+	m.negated = negation.MatchString(msg)
+	if g := guessInQuestion.FindStringSubmatch(msg); g != nil {
+		m.named = g[1]
+		m.namedKnown = r.isKnownWord(g[1])
+	}
+	for i := range factQuestions {
+		if factQuestions[i].pattern.MatchString(msg) {
+			m.rule = &factQuestions[i]
+			break
+		}
+	}
+	return m
+}
+
+// answer is the token for the message if the secret word were secret.
+// Must be called with lock held.
+func (m playerMessage) answer(r *Room, secret string) string {
+	if m.text == "" {
+		return TokenMaybe
+	}
+	if r.matchGuessTo(m.text, secret) == guessExact {
+		return TokenCorrect
+	}
+	if !m.isQuestion {
+		return r.judgeGuess(secret, m.text)
+	}
+	if m.named != "" && (m.namedKnown || r.matchGuessTo(m.named, secret) == guessExact) {
+		return r.judgeGuess(secret, m.named)
+	}
+
+	facts, ok := r.factsFor(secret)
+	if !ok {
+		return TokenMaybe
+	}
+	if m.rule != nil {
+		answer := m.rule.answer(facts)
+		if m.negated {
+			switch answer {
+			case TokenYes:
+				answer = TokenNo
+			case TokenNo:
+				answer = TokenYes
+			}
+		}
+		return answer
+	}
+
+	// A question we can't parse, but it may still name something related
+	for _, w := range strings.Fields(m.text) {
+		if isCloseWord(w, secret) {
+			return TokenSoClose
+		}
+	}
+	return TokenMaybe
+}
+
+// judgeGuess rates a word guess the way the room matches guesses: CORRECT,
+// SO_CLOSE for a near miss, WAY_OFF for something of a different kind
+// entirely, NO otherwise. A guess spelled like the word ("Horse" for
+// House) is only SO_CLOSE if it isn't known to be way off.
+// Must be called with lock held.
+func (r *Room) judgeGuess(secret, guess string) string {
+	match := r.matchGuessTo(guess, secret)
+	if match == guessExact {
+		return TokenCorrect
+	}
+	if isCloseWord(guess, secret) {
+		return TokenSoClose
+	}
+	answer := TokenNo
+	want, ok1 := r.factsFor(secret)
+	got, ok2 := r.guessFacts(guess)
+	if ok1 && ok2 {
+		similarity := traitSimilarity(want, got)
+		switch {
+		case want.Category == got.Category && similarity >= 0.6:
+			answer = TokenSoClose
+		case want.Category != got.Category && similarity < 0.2:
+			answer = TokenWayOff
+		}
+	}
+	if answer == TokenNo && match == guessClose {
+		return TokenSoClose
+	}
+	return answer
+}
+
+// isCloseWord reports whether a guess shares a stem with the secret word,
+// such as "duck" for "Duckling" or "cake" for "Cheesecake".
+func isCloseWord(guess, secret string) bool {
+	g := singular(foldWord(guess))
+	s := foldWord(secret)
+	if utf8.RuneCountInString(g) < 3 || g == s {
+		return false
+	}
+	return strings.Contains(s, g) || (utf8.RuneCountInString(s) >= 3 && strings.Contains(g, s))
+}
+
+// traitSimilarity is the Jaccard index of two words' traits.
+func traitSimilarity(a, b WordFacts) float64 {
+	union, shared := 0, 0
+	for t := range a.Traits {
+		union++
+		if b.Traits[t] {
+			shared++
+		}
+	}
+	for t := range b.Traits {
+		if !a.Traits[t] {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// isKnownWord reports whether a guessed word is in the room's word packs.
+// Must be called with lock held.
+func (r *Room) isKnownWord(w string) bool {
+	_, ok := r.guessFacts(w)
+	return ok
+}
+
+// factsFor returns the facts for a word or alias in the room's language,
+// and whether any are known. Must be called with lock held.
+func (r *Room) factsFor(word string) (WordFacts, bool) {
+	if e, ok := r.words.lookupIn(r.language, word); ok {
+		return e.facts, true
+	}
+	return WordFacts{}, false
+}
+
+// guessFacts looks up a guessed word as typed and without a leading
+// article, each also as the singular of a plural.
+// Must be called with lock held.
+func (r *Room) guessFacts(guess string) (WordFacts, bool) {
+	for _, w := range []string{foldWord(guess), guessPhrase(guess, r.language)} {
+		forms := []string{w}
+		if r.language == "en" {
+			forms = append(forms, singular(w))
+		}
+		for _, suffix := range pluralSuffixes[r.language] {
+			if stem, ok := strings.CutSuffix(w, suffix); ok && stem != "" {
+				forms = append(forms, stem)
+			}
+		}
+		for _, form := range forms {
+			if facts, ok := r.factsFor(form); ok {
+				return facts, true
+			}
+		}
+	}
+	return WordFacts{}, false
+}
+
+// singular strips a plural "s"/"es" well enough for word-bank lookups.
+func singular(w string) string {
+	switch {
+	case strings.HasSuffix(w, "ies") && len(w) > 4:
+		return strings.TrimSuffix(w, "ies") + "y"
+	case strings.HasSuffix(w, "ches") || strings.HasSuffix(w, "shes") || strings.HasSuffix(w, "xes"):
+		return strings.TrimSuffix(w, "es")
+	case strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") && len(w) > 3:
+		return strings.TrimSuffix(w, "s")
+	}
+	return w
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A small in-tree implementation of the Prometheus text exposition format.
+// It covers only what the server needs: counters (optionally with one label),
+// and histograms, plus gauges computed from the hub at scrape time.
+
+// maxLabelValues caps how many distinct label values a counter tracks, so
+// clients sending junk message types can't grow the series set unboundedly.
+const maxLabelValues = 64
+
+type counterVec struct {
+	name   string
+	help   string
+	label  string
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+func newCounterVec(name, help, label string) *counterVec {
+	c := &counterVec{name: name, help: help, label: label, values: make(map[string]float64)}
+	if label == "" {
+		c.values[""] = 0 // Unlabelled counters are exported from the start
+	}
+	return c
+}
+
+func (c *counterVec) inc(labelValue string) {
+	c.add(labelValue, 1)
+}
+
+func (c *counterVec) add(labelValue string, v float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.values[labelValue]; !ok && len(c.values) >= maxLabelValues {
+		labelValue = "OTHER"
+	}
+	c.values[labelValue] += v
+}
+
+func (c *counterVec) write(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+	for _, k := range sortedKeys(c.values) {
+		if c.label == "" {
+			fmt.Fprintf(w, "%s %s\n", c.name, formatFloat(c.values[k]))
+		} else {
+			fmt.Fprintf(w, "%s{%s=\"%s\"} %s\n", c.name, c.label, escapeLabel(k), formatFloat(c.values[k]))
+		}
+	}
+}
+
+type histogram struct {
+	name    string
+	help    string
+	buckets []float64
+	mu      sync.Mutex
+	counts  []uint64 // per bucket, non-cumulative
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(name, help string, buckets []float64) *histogram {
+	return &histogram{name: name, help: help, buckets: buckets, counts: make([]uint64, len(buckets))}
+}
+
+func (h *histogram) observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+func (h *histogram) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+	var cumulative uint64
+	for i, upper := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(w, "%s_bucket{le=%q} %d\n", h.name, formatFloat(upper), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n", h.name, h.count)
+	fmt.Fprintf(w, "%s_sum %s\n", h.name, formatFloat(h.sum))
+	fmt.Fprintf(w, "%s_count %d\n", h.name, h.count)
+}
+
+// Metrics holds every metric the server exports.
+type Metrics struct {
+	gamesStarted      *counterVec
+	gamesFinished     *counterVec
+	messagesIn        *counterVec
+	messagesOut       *counterVec
+	sendsDropped      *counterVec
+	roomsReaped       *counterVec
+	roomsRejected     *counterVec
+	broadcastDuration *histogram
+}
+
+var metrics = newMetrics()
+
+func newMetrics() *Metrics {
+	return &Metrics{
+		gamesStarted:  newCounterVec("werewords_games_started_total", "Games started.", ""),
+		gamesFinished: newCounterVec("werewords_games_finished_total", "Games finished, by winning team.", "winner"),
+		messagesIn:    newCounterVec("werewords_messages_received_total", "Client messages received, by type.", "type"),
+		messagesOut:   newCounterVec("werewords_messages_sent_total", "Server messages queued to clients, by type.", "type"),
+		sendsDropped:  newCounterVec("werewords_send_dropped_total", "Server messages dropped because a client's send buffer was full, by type.", "type"),
+		roomsReaped:   newCounterVec("werewords_rooms_reaped_total", "Rooms closed by the idle janitor, by phase.", "phase"),
+		roomsRejected: newCounterVec("werewords_rooms_rejected_total", "Room creations refused because the server was at its room limit.", ""),
+		broadcastDuration: newHistogram("werewords_broadcast_duration_seconds", "Time to build and queue a room state broadcast.",
+			[]float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1}),
+	}
+}
+
+// handler serves /metrics. Gauges are computed from the hub on each scrape.
+func (m *Metrics) handler(h *Hub) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+		roomsByPhase := map[string]float64{
+			PhaseLobby: 0, PhaseRoleReveal: 0, PhaseWordSelection: 0, PhaseDayPhase: 0,
+			PhaseVoting: 0, PhaseWerewolfGuess: 0, PhaseGameOver: 0,
+		}
+		bots := 0
+		for _, room := range h.roomList() {
+			room.mu.Lock()
+			roomsByPhase[room.phase]++
+			for _, p := range room.players {
+				if p.IsBot {
+					bots++
+				}
+			}
+			room.mu.Unlock()
+		}
+
+		fmt.Fprintf(w, "# HELP werewords_rooms Active rooms, by phase.\n# TYPE werewords_rooms gauge\n")
+		for _, phase := range sortedKeys(roomsByPhase) {
+			fmt.Fprintf(w, "werewords_rooms{phase=\"%s\"} %s\n", escapeLabel(phase), formatFloat(roomsByPhase[phase]))
+		}
+		fmt.Fprintf(w, "# HELP werewords_clients_connected Open WebSocket connections.\n# TYPE werewords_clients_connected gauge\n")
+		connected := 0
+		for _, c := range h.clientList() {
+			if c.conn != nil {
+				connected++ // Stand-ins for players on other nodes don't count
+			}
+		}
+		fmt.Fprintf(w, "werewords_clients_connected %d\n", connected)
+		fmt.Fprintf(w, "# HELP werewords_bots Bots seated in rooms.\n# TYPE werewords_bots gauge\n")
+		fmt.Fprintf(w, "werewords_bots %d\n", bots)
+
+		m.gamesStarted.write(w)
+		m.gamesFinished.write(w)
+		m.messagesIn.write(w)
+		m.messagesOut.write(w)
+		m.sendsDropped.write(w)
+		m.roomsReaped.write(w)
+		m.roomsRejected.write(w)
+		m.broadcastDuration.write(w)
+	}
+}
+
+// --- Helpers ---
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// labelEscaper escapes a label value as the exposition format specifies:
+// only backslash, double quote and newline.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
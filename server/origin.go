@@ -0,0 +1,85 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// originAllowlist decides which browser origins may open a WebSocket.
+// Entries are either a bare host ("game.example.com"), a full origin
+// ("https://game.example.com") or a wildcard subdomain ("*.example.com").
+// An empty allowlist accepts every origin.
+type originAllowlist struct {
+	entries []originEntry
+}
+
+type originEntry struct {
+	scheme   string // empty matches any scheme
+	host     string // lower-case host, with port if one was given
+	wildcard bool   // host is a suffix; matches subdomains only
+}
+
+func newOriginAllowlist(patterns []string) *originAllowlist {
+	a := &originAllowlist{}
+	for _, p := range patterns {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if p == "" {
+			continue
+		}
+		var e originEntry
+		if i := strings.Index(p, "://"); i >= 0 {
+			e.scheme = p[:i]
+			p = p[i+3:]
+		}
+		p = strings.TrimSuffix(p, "/")
+		if strings.HasPrefix(p, "*.") {
+			e.wildcard = true
+			p = p[1:] // keep the leading dot
+		}
+		e.host = p
+		a.entries = append(a.entries, e)
+	}
+	return a
+}
+
+// check is used as the websocket.Upgrader CheckOrigin hook.
+func (a *originAllowlist) check(r *http.Request) bool {
+	if len(a.entries) == 0 {
+		return true
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true // Non-browser clients don't send an Origin header
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return a.allows(strings.ToLower(u.Scheme), strings.ToLower(u.Host))
+}
+
+func (a *originAllowlist) allows(scheme, host string) bool {
+	hostname := host
+	if i := strings.LastIndex(host, ":"); i >= 0 && !strings.Contains(host[i:], "]") {
+		hostname = host[:i]
+	}
+	for _, e := range a.entries {
+		if e.scheme != "" && e.scheme != scheme {
+			continue
+		}
+		// Entries with a port must match exactly; entries without one match any port.
+		candidate := hostname
+		if strings.Contains(e.host, ":") {
+			candidate = host
+		}
+		if e.wildcard {
+			if strings.HasSuffix(candidate, e.host) && len(candidate) > len(e.host) {
+				return true
+			}
+		} else if candidate == e.host {
+			return true
+		}
+	}
+	return false
+}
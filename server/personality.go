@@ -0,0 +1,92 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+//go:embed data/personalities.json
+var personalitiesJSON []byte
+
+// BotPersonality shapes how a bot plays, independent of its skill: how
+// often it speaks up, how quickly it votes and how much it follows the crowd.
+type BotPersonality struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	GuessDelay  [2]int  `json:"guessDelay"` // seconds between questions, min and max
+	VoteDelay   [2]int  `json:"voteDelay"`  // seconds before voting, min and max
+	Bandwagon   float64 `json:"bandwagon"`  // weight of each vote a target already has
+}
+
+const defaultPersonality = "steady"
+
+var (
+	personalities  map[string]BotPersonality
+	personalityIDs []string // in file order
+)
+
+func init() {
+	list, err := parsePersonalities(personalitiesJSON)
+	if err != nil {
+		panic(err) // Static data; a bad file is a build mistake
+	}
+	personalities = make(map[string]BotPersonality, len(list))
+	for _, p := range list {
+		personalities[p.ID] = p
+		personalityIDs = append(personalityIDs, p.ID)
+	}
+}
+
+func parsePersonalities(data []byte) ([]BotPersonality, error) {
+	var list []BotPersonality
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("personalities: %w", err)
+	}
+	seen := make(map[string]bool, len(list))
+	for _, p := range list {
+		switch {
+		case p.ID == "" || p.ID != strings.ToLower(p.ID):
+			return nil, fmt.Errorf("personalities: id %q must be non-empty and lowercase", p.ID)
+		case seen[p.ID]:
+			return nil, fmt.Errorf("personalities: %q is listed twice", p.ID)
+		case p.GuessDelay[0] < 1 || p.GuessDelay[1] < p.GuessDelay[0]:
+			return nil, fmt.Errorf("personalities: %q has an invalid guessDelay", p.ID)
+		case p.VoteDelay[0] < 1 || p.VoteDelay[1] < p.VoteDelay[0]:
+			return nil, fmt.Errorf("personalities: %q has an invalid voteDelay", p.ID)
+		}
+		seen[p.ID] = true
+	}
+	if !seen[defaultPersonality] {
+		return nil, fmt.Errorf("personalities: default %q is missing", defaultPersonality)
+	}
+	return list, nil
+}
+
+// parseBotPersonality validates a personality ID; an empty one picks a
+// personality at random so a table of bots doesn't all act alike.
+func parseBotPersonality(id string) (string, bool) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if id == "" {
+		return personalityIDs[rand.Intn(len(personalityIDs))], true
+	}
+	_, ok := personalities[id]
+	return id, ok
+}
+
+// personalityOf returns a bot's personality, falling back to the default.
+func personalityOf(p *Player) BotPersonality {
+	if pers, ok := personalities[p.BotPersonality]; ok {
+		return pers
+	}
+	return personalities[defaultPersonality]
+}
+
+// randomDelay picks a whole number of seconds within bounds.
+func randomDelay(bounds [2]int) time.Duration {
+	return time.Duration(bounds[0]+rand.Intn(bounds[1]-bounds[0]+1)) * time.Second
+}
@@ -2,10 +2,11 @@ package main
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
 	"math/rand"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -17,11 +18,6 @@ const (
 	maxPlayers        = 10
 )
 
-var botNames = []string{
-	"Luna", "Felix", "Shadow", "Maple", "Coco", "Mochi",
-	"Pepper", "Honey", "Biscuit", "Pumpkin", "Stormy", "Hazel",
-}
-
 type Room struct {
 	code    string
 	hub     *Hub
@@ -29,22 +25,51 @@ type Room struct {
 	players map[string]*Player
 	order   []string
 
+	strategy BotStrategy               // plays for bots without an agent
+	agents   map[string]*agentStrategy // bot ID → external agent driving it
+
 	phase         string
 	secretWord    string
 	timeRemaining int
 	tokensUsed    int
 	tokenHistory  []TokenAction
 	guesses       map[string]*GuessEntry
+	answered      []AnsweredQuestion // questions the Mayor has answered this game
 	wordOptions   []string
+	wordChoices   []WordOption // wordOptions with their difficulty tags
+	rerollsUsed   int
+	words         *WordBank // word packs in use, fixed for the length of a game
 	winner        string
 	votes         map[string]string
 	scores        map[string]int // persistent scores keyed by player ID
 
 	// New features
-	difficulty    string
-	hintsRevealed int
-	hintIndices   []int // indices of revealed letters
-	achievements  map[string][]string // persistent achievements per player
+	autoFillBots    bool // keep the lobby topped up to minPlayers with bots
+	difficulty      string
+	language        string          // word pack language
+	wordSource      string          // where secret words come from; see WordSource constants
+	customWords     []string        // the players' own words, kept across games
+	optionCount     int             // words offered to the Mayor
+	rerolls         int             // times per game the Mayor may ask for new options
+	mixedDifficulty bool            // options drawn across all difficulties
+	freeChoice      bool            // the Mayor may choose a word of their own
+	recentWins      []bool          // whether the village won each of the last few games
+	seenWords       map[string]bool // folded words already offered to a Mayor
+	freshWords      int             // cached freshWordCount, -1 when stale
+	hintsRevealed   int
+	hintIndices     []int               // rune indices of revealed letters
+	timeline        []TimelineEvent     // hints and other non-token events this game
+	achievements    map[string][]string // persistent achievements per player
+
+	debugLog atomic.Bool // per-room debug logging toggle
+
+	createdAt     time.Time
+	publishedInfo *RoomInfo // last RoomInfo sent to the room feed
+
+	// Idle tracking for the hub janitor
+	lastActivity time.Time // last player message or phase change
+	lastPhase    string
+	expiryWarned bool
 
 	gameEpoch int
 	ticker    *time.Ticker
@@ -57,6 +82,8 @@ func newRoom(code string, hub *Hub) *Room {
 		code:         code,
 		hub:          hub,
 		clients:      make(map[string]*Client),
+		strategy:     builtinStrategy{},
+		agents:       make(map[string]*agentStrategy),
 		players:      make(map[string]*Player),
 		order:        make([]string, 0),
 		phase:        PhaseLobby,
@@ -65,10 +92,31 @@ func newRoom(code string, hub *Hub) *Room {
 		votes:        make(map[string]string),
 		scores:       make(map[string]int),
 		difficulty:   DifficultyMedium,
+		language:     defaultLanguage,
+		wordSource:   WordSourceBuiltin,
+		optionCount:  wordOptionCount,
+		rerolls:      defaultRerolls,
+		seenWords:    make(map[string]bool),
+		freshWords:   -1,
+		words:        currentWordBank(),
 		achievements: make(map[string][]string),
+		createdAt:    time.Now(),
+		lastActivity: time.Now(),
 	}
 }
 
+// logger returns a logger carrying the room's code, game epoch and phase.
+// Must be called with lock held.
+func (r *Room) logger() *slog.Logger {
+	base := slog.Default().Handler()
+	return slog.New(debugOverrideHandler{Handler: base, debug: &r.debugLog}).With(
+		logKeyComponent, "room",
+		logKeyRoom, r.code,
+		logKeyEpoch, r.gameEpoch,
+		logKeyPhase, r.phase,
+	)
+}
+
 // ============================================================
 // Player Management
 // ============================================================
@@ -98,14 +146,18 @@ func (r *Room) addClient(c *Client, name string, avatarURL string) {
 		IsReady:   false,
 		AvatarURL: avatarURL,
 		IsBot:     false,
+
+		rejoinToken: newUUID(),
 	}
 
 	r.clients[c.playerID] = c
 	r.players[c.playerID] = player
 	r.order = append(r.order, c.playerID)
-	c.room = r
+	c.room.Store(r)
 
-	log.Printf("[Room %s] %s joined (%d players)", r.code, name, len(r.players))
+	r.logger().Info("player joined", logKeyPlayer, c.playerID, logKeyName, name, "players", len(r.players))
+	r.sendJoined(c, player)
+	r.balanceAutoBots()
 	r.broadcastState()
 }
 
@@ -113,181 +165,62 @@ func (r *Room) removeClient(c *Client) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if a := r.agents[c.playerID]; a != nil && a.conn == c {
+		r.detachAgent(c.playerID, "") // The bot plays on without it
+		return
+	}
+	if r.clients[c.playerID] != c {
+		return // Replaced by a newer connection for the same seat
+	}
+	if r.inProgress() && len(r.clients) > 1 {
+		r.takeOverSeat(c)
+		return
+	}
+
 	playerName := ""
 	if p := r.players[c.playerID]; p != nil {
 		playerName = p.Name
 	}
 
-	delete(r.clients, c.playerID)
-	delete(r.players, c.playerID)
-	for i, id := range r.order {
-		if id == c.playerID {
-			r.order = append(r.order[:i], r.order[i+1:]...)
-			break
-		}
-	}
-	c.room = nil
+	r.removePlayer(c.playerID)
+	r.logger().Info("player disconnected", logKeyPlayer, c.playerID, logKeyName, playerName, "remaining", len(r.clients))
+}
 
-	log.Printf("[Room %s] %s disconnected (%d remaining)", r.code, playerName, len(r.clients))
+// removePlayer drops a player (human or bot) from the room, detaching their
+// client if they have one, and removes the room once no humans remain.
+// Must be called with lock held.
+func (r *Room) removePlayer(playerID string) {
+	r.dropPlayer(playerID)
 
 	if len(r.clients) == 0 {
 		r.stopTimers()
+		r.detachAllAgents("Every player has left the room")
 		r.hub.removeRoom(r.code)
 		return
 	}
 
+	r.balanceAutoBots()
 	if r.phase == PhaseVoting {
 		r.checkVotingComplete()
 	}
 	r.broadcastState()
 }
 
-// ============================================================
-// Bot Management
-// ============================================================
-
-func (r *Room) handleAddBot() {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	if r.phase != PhaseLobby {
-		return
-	}
-	if len(r.players) >= maxPlayers {
-		return
-	}
-
-	botID := newUUID()
-	name := r.pickBotName()
-
-	player := &Player{
-		ID:        botID,
-		Name:      name,
-		Role:      RoleVillager,
-		IsMayor:   false,
-		IsReady:   true,
-		AvatarURL: fmt.Sprintf("https://api.dicebear.com/7.x/adventurer/svg?seed=bot-%s&backgroundColor=b6e3f4,c0aede,d1d4f9,ffd5dc,ffdfbf", botID),
-		IsBot:     true,
-	}
-
-	// Bots go in players + order, but NOT in clients (no WS connection)
-	r.players[botID] = player
-	r.order = append(r.order, botID)
-
-	log.Printf("[Room %s] Bot %q added (%d players)", r.code, name, len(r.players))
-	r.broadcastState()
-}
-
-func (r *Room) pickBotName() string {
-	usedNames := make(map[string]bool)
-	for _, p := range r.players {
-		usedNames[p.Name] = true
-	}
-	for _, n := range botNames {
-		if !usedNames[n] {
-			return n
-		}
-	}
-	return fmt.Sprintf("Bot-%d", rand.Intn(999))
-}
-
-// scheduleBotActions starts goroutines for bots to act in the current phase.
+// dropPlayer takes a player out of the room's bookkeeping without any of
+// the follow-up removePlayer does.
 // Must be called with lock held.
-func (r *Room) scheduleBotActions(epoch int) {
-	switch r.phase {
-	case PhaseDayPhase:
-		for _, id := range r.order {
-			if p := r.players[id]; p != nil && p.IsBot && p.IsMayor {
-				go r.runBotMayor(id, epoch)
-			}
-		}
-	case PhaseVoting:
-		for _, id := range r.order {
-			if p := r.players[id]; p != nil && p.IsBot {
-				go r.runBotVote(id, epoch, PhaseVoting)
-			}
-		}
-	case PhaseWerewolfGuess:
-		for _, id := range r.order {
-			if p := r.players[id]; p != nil && p.IsBot && p.Role == RoleWerewolf {
-				go r.runBotVote(id, epoch, PhaseWerewolfGuess)
-			}
-		}
-	}
-}
-
-func (r *Room) runBotMayor(botID string, epoch int) {
-	tokenTypes := []string{TokenYes, TokenNo, TokenMaybe, TokenSoClose, TokenWayOff}
-	for {
-		delay := time.Duration(3+rand.Intn(3)) * time.Second
-		select {
-		case <-time.After(delay):
-			r.mu.Lock()
-			if r.gameEpoch != epoch || r.phase != PhaseDayPhase {
-				r.mu.Unlock()
-				return
-			}
-			nonMayors := make([]string, 0)
-			for _, id := range r.order {
-				if p := r.players[id]; p != nil && !p.IsMayor {
-					nonMayors = append(nonMayors, id)
-				}
-			}
-			var targetID string
-			if len(nonMayors) > 0 {
-				targetID = nonMayors[rand.Intn(len(nonMayors))]
-			}
-			token := TokenAction{
-				ID:             newUUID(),
-				Type:           tokenTypes[rand.Intn(len(tokenTypes))],
-				Timestamp:      time.Now().UnixMilli(),
-				TargetPlayerID: targetID,
-			}
-			r.tokenHistory = append([]TokenAction{token}, r.tokenHistory...)
-			r.tokensUsed++
-			r.broadcastState()
-			r.mu.Unlock()
-		case <-r.stopCh:
-			return
-		}
+func (r *Room) dropPlayer(playerID string) {
+	if c := r.clients[playerID]; c != nil {
+		c.leaveRoom()
 	}
-}
-
-func (r *Room) runBotVote(botID string, epoch int, phase string) {
-	delay := time.Duration(2+rand.Intn(4)) * time.Second
-	select {
-	case <-time.After(delay):
-		r.mu.Lock()
-		defer r.mu.Unlock()
-		if r.gameEpoch != epoch || r.phase != phase {
-			return
-		}
-		if _, hasVoted := r.votes[botID]; hasVoted {
-			return
-		}
-		targets := make([]string, 0)
-		for _, id := range r.order {
-			if id != botID {
-				targets = append(targets, id)
-			}
-		}
-		if len(targets) == 0 {
-			return
-		}
-		targetID := targets[rand.Intn(len(targets))]
-		r.votes[botID] = targetID
-		r.players[targetID].VotesReceived++
-
-		log.Printf("[Room %s] Bot %s voted for %s", r.code, botID, targetID)
-
-		if phase == PhaseWerewolfGuess {
-			r.checkWerewolfGuessComplete()
-		} else {
-			r.checkVotingComplete()
+	r.detachAgent(playerID, "")
+	delete(r.clients, playerID)
+	delete(r.players, playerID)
+	for i, id := range r.order {
+		if id == playerID {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
 		}
-		r.broadcastState()
-	case <-r.stopCh:
-		return
 	}
 }
 
@@ -335,6 +268,10 @@ func (r *Room) handleStartGame(c *Client) {
 		c.sendError("Game already started")
 		return
 	}
+	if r.hub.isDraining() {
+		c.sendError("Server is restarting — no new games can start")
+		return
+	}
 	if len(r.players) < minPlayers {
 		c.sendError(fmt.Sprintf("Need at least %d players to start", minPlayers))
 		return
@@ -345,6 +282,10 @@ func (r *Room) handleStartGame(c *Client) {
 			return
 		}
 	}
+	if msg := r.checkWordSource(); msg != "" {
+		c.sendError(msg)
+		return
+	}
 	r.startGame()
 }
 
@@ -359,17 +300,27 @@ func (r *Room) startGame() {
 	roles := r.generateRoles(len(r.order))
 	rand.Shuffle(len(roles), func(i, j int) { roles[i], roles[j] = roles[j], roles[i] })
 
-	// Pick mayor: prefer volunteers, then random
+	// Pick mayor: prefer volunteers, then random. Bots only understand
+	// questions in English, so elsewhere a human is Mayor if there is one
+	eligible := make([]int, 0, len(r.order))
 	volunteers := make([]int, 0)
 	for i, playerID := range r.order {
-		if p := r.players[playerID]; p != nil && p.WantsMayor {
+		p := r.players[playerID]
+		if p == nil || (p.IsBot && r.language != botQuestionLanguage) {
+			continue
+		}
+		eligible = append(eligible, i)
+		if p.WantsMayor {
 			volunteers = append(volunteers, i)
 		}
 	}
 	var mayorIdx int
-	if len(volunteers) > 0 {
+	switch {
+	case len(volunteers) > 0:
 		mayorIdx = volunteers[rand.Intn(len(volunteers))]
-	} else {
+	case len(eligible) > 0:
+		mayorIdx = eligible[rand.Intn(len(eligible))]
+	default:
 		mayorIdx = rand.Intn(len(r.order))
 	}
 
@@ -382,13 +333,18 @@ func (r *Room) startGame() {
 	}
 
 	r.secretWord = ""
-	r.wordOptions = getRandomWordsByDifficulty(5, r.difficulty)
+	r.words = currentWordBank()
+	r.staleFreshWords()
+	r.offerWords()
+	r.rerollsUsed = 0
 	r.hintsRevealed = 0
 	r.hintIndices = nil
+	r.timeline = nil
 	r.timeRemaining = initialTime
 	r.tokensUsed = 0
 	r.tokenHistory = make([]TokenAction, 0)
 	r.guesses = make(map[string]*GuessEntry)
+	r.answered = nil
 	r.votes = make(map[string]string)
 	r.winner = ""
 
@@ -406,17 +362,18 @@ func (r *Room) startGame() {
 			}
 			if r.phase == PhaseRoleReveal {
 				r.phase = PhaseWordSelection
-				r.timeRemaining = 30 // 30 seconds to choose
+				r.timeRemaining = wordSelectionTime
 				r.broadcastState()
 				r.startWordSelectionTimer(epoch)
-				r.scheduleBotWordChoice(epoch)
+				r.scheduleBotActions(epoch)
 			}
 		case <-r.stopCh:
 			return
 		}
 	}()
 
-	log.Printf("[Room %s] Game started! Players: %d", r.code, len(r.order))
+	metrics.gamesStarted.inc("")
+	r.logger().Info("game started", "players", len(r.order), "difficulty", r.difficulty, "language", r.language, "wordSource", r.wordSource)
 }
 
 func (r *Room) handleChooseWord(c *Client, payload ChooseWordPayload) {
@@ -441,13 +398,24 @@ func (r *Room) handleChooseWord(c *Client, payload ChooseWordPayload) {
 			break
 		}
 	}
+	word := payload.Word
 	if !valid {
-		c.sendError("Invalid word choice")
-		return
+		if !r.freeChoice {
+			c.sendError("Invalid word choice")
+			return
+		}
+		var problem string
+		if word, problem = r.checkFreeWord(payload.Word); problem != "" {
+			r.logger().Debug("free-choice word rejected", logKeyPlayer, c.playerID, "reason", problem)
+			c.sendError(problem)
+			return
+		}
+		r.logger().Info("free-choice word chosen", logKeyPlayer, c.playerID)
 	}
 
-	r.secretWord = payload.Word
+	r.secretWord = word
 	r.wordOptions = nil
+	r.wordChoices = nil
 	r.transitionToDayPhase()
 }
 
@@ -476,6 +444,7 @@ func (r *Room) startWordSelectionTimer(epoch int) {
 					if r.secretWord == "" && len(r.wordOptions) > 0 {
 						r.secretWord = r.wordOptions[rand.Intn(len(r.wordOptions))]
 						r.wordOptions = nil
+						r.wordChoices = nil
 					}
 					r.transitionToDayPhase()
 					r.mu.Unlock()
@@ -488,31 +457,6 @@ func (r *Room) startWordSelectionTimer(epoch int) {
 	}()
 }
 
-func (r *Room) scheduleBotWordChoice(epoch int) {
-	for _, id := range r.order {
-		if p := r.players[id]; p != nil && p.IsBot && p.IsMayor {
-			go func(botID string) {
-				delay := time.Duration(2+rand.Intn(3)) * time.Second
-				select {
-				case <-time.After(delay):
-					r.mu.Lock()
-					defer r.mu.Unlock()
-					if r.gameEpoch != epoch || r.phase != PhaseWordSelection {
-						return
-					}
-					if r.secretWord == "" && len(r.wordOptions) > 0 {
-						r.secretWord = r.wordOptions[rand.Intn(len(r.wordOptions))]
-						r.wordOptions = nil
-						r.transitionToDayPhase()
-					}
-				case <-r.stopCh:
-					return
-				}
-			}(id)
-		}
-	}
-}
-
 // transitionToDayPhase moves from word selection to the day phase.
 // Must be called with lock held.
 func (r *Room) transitionToDayPhase() {
@@ -522,7 +466,8 @@ func (r *Room) transitionToDayPhase() {
 	r.startDayTimer(epoch)
 	r.scheduleBotActions(epoch)
 	r.broadcastState()
-	log.Printf("[Room %s] Word chosen: %q — Day phase started", r.code, r.secretWord)
+	r.logger().Info("day phase started")
+	r.logger().Debug("secret word chosen", "word", r.secretWord)
 }
 
 func (r *Room) getNumWerewolves(count int) int {
@@ -572,6 +517,7 @@ func (r *Room) startDayTimer(epoch int) {
 					r.broadcastState()
 				} else {
 					r.ticker.Stop()
+					r.recordWordPlay(false)
 					r.startVotingPhase()
 					r.scheduleBotActions(epoch)
 					r.broadcastState()
@@ -595,29 +541,37 @@ func (r *Room) handleSubmitGuess(c *Client, payload SubmitGuessPayload) {
 	if player == nil || player.IsMayor {
 		return // Mayor doesn't guess
 	}
-	text := payload.Text
-	if len(text) > 80 {
-		text = text[:80]
-	}
+	r.submitGuess(c.playerID, payload.Text)
+}
+
+// submitGuess records a player's question or guess for the Mayor.
+// Must be called with lock held.
+func (r *Room) submitGuess(playerID, text string) {
+	text = limitRunes(normalizeText(text), maxGuessLength)
 	if text == "" {
 		return
 	}
 
-	r.guesses[c.playerID] = &GuessEntry{
-		PlayerID:  c.playerID,
+	entry := &GuessEntry{
+		PlayerID:  playerID,
 		Text:      text,
 		Timestamp: time.Now().UnixMilli(),
 	}
+	r.guesses[playerID] = entry
 
-	// Auto-check: if the guess matches the secret word, village guessed correctly
-	if strings.EqualFold(strings.TrimSpace(text), strings.TrimSpace(r.secretWord)) {
-		log.Printf("[Room %s] Player %s guessed the correct word!", r.code, c.playerID)
+	// Auto-check: if the guess names the secret word, village guessed correctly
+	match := r.matchGuess(text)
+	if match == guessClose {
+		entry.SuggestedToken = TokenSoClose
+	}
+	if match == guessExact {
+		r.logger().Info("word guessed", logKeyPlayer, playerID)
 		// Record a CORRECT token targeting this player
 		r.tokenHistory = append(r.tokenHistory, TokenAction{
 			ID:             newUUID(),
 			Type:           TokenCorrect,
 			Timestamp:      time.Now().UnixMilli(),
-			TargetPlayerID: c.playerID,
+			TargetPlayerID: playerID,
 		})
 		r.tokensUsed++
 		r.guesses = make(map[string]*GuessEntry)
@@ -673,14 +627,7 @@ func (r *Room) handleSubmitToken(c *Client, payload SubmitTokenPayload) {
 		}
 	}
 
-	token := TokenAction{
-		ID:             newUUID(),
-		Type:           payload.TokenType,
-		Timestamp:      time.Now().UnixMilli(),
-		TargetPlayerID: targetPlayerID,
-	}
-	r.tokenHistory = append([]TokenAction{token}, r.tokenHistory...)
-	r.tokensUsed++
+	r.recordToken(payload.TokenType, targetPlayerID)
 
 	// Clear all guesses after Mayor responds
 	r.guesses = make(map[string]*GuessEntry)
@@ -692,6 +639,23 @@ func (r *Room) handleSubmitToken(c *Client, payload SubmitTokenPayload) {
 	}
 }
 
+// recordToken adds a Mayor token to the top of the history, and logs the
+// question it answered so bots can reason about it.
+// Must be called with lock held.
+func (r *Room) recordToken(tokenType, targetPlayerID string) {
+	if g := r.guesses[targetPlayerID]; g != nil {
+		r.answered = append(r.answered, AnsweredQuestion{PlayerID: targetPlayerID, Text: g.Text, Token: tokenType})
+	}
+	token := TokenAction{
+		ID:             newUUID(),
+		Type:           tokenType,
+		Timestamp:      time.Now().UnixMilli(),
+		TargetPlayerID: targetPlayerID,
+	}
+	r.tokenHistory = append([]TokenAction{token}, r.tokenHistory...)
+	r.tokensUsed++
+}
+
 // ============================================================
 // Reactions (ephemeral broadcast)
 // ============================================================
@@ -717,13 +681,16 @@ func (r *Room) handleSendReaction(c *Client, payload SendReactionPayload) {
 	for _, client := range r.clients {
 		client.sendReaction(reaction)
 	}
+	for _, agent := range r.agents {
+		agent.conn.sendReaction(reaction)
+	}
 }
 
 // ============================================================
 // Hints (Mayor reveals letters)
 // ============================================================
 
-func (r *Room) handleRevealHint(c *Client) {
+func (r *Room) handleRevealHint(c *Client, payload RevealHintPayload) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -737,62 +704,47 @@ func (r *Room) handleRevealHint(c *Client) {
 		return
 	}
 
-	word := r.secretWord
-	maxHints := len(word) / 2
-	if r.hintsRevealed >= maxHints {
-		c.sendError("No more hints available")
-		return
-	}
-
-	// Pick a random unrevealed letter index
-	revealed := make(map[int]bool)
-	for _, idx := range r.hintIndices {
-		revealed[idx] = true
-	}
-	candidates := make([]int, 0)
-	for i, ch := range word {
-		if ch != ' ' && !revealed[i] {
-			candidates = append(candidates, i)
-		}
+	hintType := payload.Type
+	if hintType == "" {
+		hintType = HintLetter
 	}
-	if len(candidates) == 0 {
+	if msg := r.revealHint(hintType); msg != "" {
+		c.sendError(msg)
 		return
 	}
-	chosen := candidates[rand.Intn(len(candidates))]
-	r.hintIndices = append(r.hintIndices, chosen)
-	r.hintsRevealed++
 
-	// Deduct 1 score from Mayor
-	r.scores[c.playerID]--
+	// The hint's cost comes out of the Mayor's score
+	r.scores[c.playerID] -= hintRules[hintType].Cost
 	if r.scores[c.playerID] < 0 {
 		r.scores[c.playerID] = 0
 	}
 
+	r.logger().Info("hint revealed", logKeyPlayer, c.playerID, "hint", hintType)
 	r.broadcastState()
 }
 
 func (r *Room) buildHintString() string {
-	if r.secretWord == "" || r.hintsRevealed == 0 {
+	if r.secretWord == "" || len(r.hintIndices) == 0 {
 		return ""
 	}
 	revealed := make(map[int]bool)
 	for _, idx := range r.hintIndices {
 		revealed[idx] = true
 	}
-	result := make([]byte, 0, len(r.secretWord)*2)
-	for i, ch := range r.secretWord {
+	var result strings.Builder
+	for i, ch := range []rune(r.secretWord) {
 		if i > 0 {
-			result = append(result, ' ')
+			result.WriteByte(' ')
 		}
 		if ch == ' ' {
-			result = append(result, ' ')
-		} else if revealed[i] {
-			result = append(result, byte(ch))
+			result.WriteByte(' ')
+		} else if revealed[i] || !isHintable(ch) {
+			result.WriteRune(ch)
 		} else {
-			result = append(result, '_')
+			result.WriteByte('_')
 		}
 	}
-	return string(result)
+	return result.String()
 }
 
 // ============================================================
@@ -809,8 +761,9 @@ func (r *Room) handleSetDifficulty(c *Client, payload SetDifficultyPayload) {
 	}
 
 	switch payload.Difficulty {
-	case DifficultyEasy, DifficultyMedium, DifficultyHard:
+	case DifficultyEasy, DifficultyMedium, DifficultyHard, DifficultyPhrase, DifficultyAdaptive:
 		r.difficulty = payload.Difficulty
+		r.staleFreshWords()
 	default:
 		c.sendError("Invalid difficulty")
 		return
@@ -819,6 +772,23 @@ func (r *Room) handleSetDifficulty(c *Client, payload SetDifficultyPayload) {
 	r.broadcastState()
 }
 
+func (r *Room) handleSetLanguage(c *Client, payload SetLanguagePayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseLobby {
+		c.sendError("Can only change language in lobby")
+		return
+	}
+	if !currentWordBank().hasLanguage(payload.Language) {
+		c.sendError("No word packs in that language")
+		return
+	}
+	r.language = payload.Language
+	r.staleFreshWords()
+	r.broadcastState()
+}
+
 // ============================================================
 // Voting
 // ============================================================
@@ -914,6 +884,7 @@ func (r *Room) startWerewolfGuess() {
 	if r.ticker != nil {
 		r.ticker.Stop()
 	}
+	r.recordWordPlay(true)
 	r.phase = PhaseWerewolfGuess
 	r.votes = make(map[string]string)
 	r.timeRemaining = werewolfGuessTime
@@ -926,7 +897,7 @@ func (r *Room) startWerewolfGuess() {
 	r.startWerewolfGuessTimer(epoch)
 	r.scheduleBotActions(epoch)
 
-	log.Printf("[Room %s] Werewolf guess phase started", r.code)
+	r.logger().Info("werewolf guess phase started")
 }
 
 func (r *Room) startWerewolfGuessTimer(epoch int) {
@@ -990,10 +961,10 @@ func (r *Room) resolveWerewolfGuess() {
 		}
 	}
 	if mostVotedID != "" && r.players[mostVotedID].Role == RoleSeer {
-		log.Printf("[Room %s] Werewolves found the Seer!", r.code)
+		r.logger().Info("werewolves found the seer", "target", mostVotedID)
 		r.endGame(WinnerWerewolf)
 	} else {
-		log.Printf("[Room %s] Werewolves guessed wrong", r.code)
+		r.logger().Info("werewolves guessed wrong", "target", mostVotedID)
 		r.endGame(WinnerVillage)
 	}
 }
@@ -1016,6 +987,8 @@ func (r *Room) endGame(winner string) {
 	r.stopTimers()
 	r.winner = winner
 	r.phase = PhaseGameOver
+	metrics.gamesFinished.inc(winner)
+	r.recordResult(winner)
 
 	// Award scores
 	for _, id := range r.order {
@@ -1100,13 +1073,20 @@ func (r *Room) endGame(winner string) {
 	}
 
 	r.broadcastState()
-	log.Printf("[Room %s] Game over! Winner: %s", r.code, winner)
+	r.logger().Info("game over", "winner", winner, "tokens_used", r.tokensUsed)
 }
 
 func (r *Room) handleResetGame(c *Client) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	r.resetToLobby()
+	r.logger().Info("reset to lobby", logKeyPlayer, c.playerID)
+}
+
+// resetToLobby abandons any game state and returns everyone to the lobby.
+// Must be called with lock held.
+func (r *Room) resetToLobby() {
 	r.stopTimers()
 	r.phase = PhaseLobby
 	r.secretWord = ""
@@ -1117,6 +1097,7 @@ func (r *Room) handleResetGame(c *Client) {
 	r.votes = make(map[string]string)
 	r.hintsRevealed = 0
 	r.hintIndices = nil
+	r.timeline = nil
 
 	for _, p := range r.players {
 		p.IsReady = p.IsBot // Bots stay ready
@@ -1126,8 +1107,9 @@ func (r *Room) handleResetGame(c *Client) {
 		p.WantsMayor = false
 	}
 
+	r.dropDisconnected()
+	r.balanceAutoBots()
 	r.broadcastState()
-	log.Printf("[Room %s] Reset to lobby", r.code)
 }
 
 func (r *Room) stopTimers() {
@@ -1143,15 +1125,96 @@ func (r *Room) stopTimers() {
 	}
 }
 
+// ============================================================
+// Moderation (admin API and idle reaping)
+// ============================================================
+
+// forceEnd ends the running game. With a winner it is scored as usual;
+// without one the game is abandoned and the room returns to the lobby.
+func (r *Room) forceEnd(winner string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.inProgress() {
+		return fmt.Errorf("no game in progress (phase %s)", r.phase)
+	}
+	switch winner {
+	case WinnerVillage, WinnerWerewolf:
+		r.endGame(winner)
+	case "":
+		r.resetToLobby()
+	default:
+		return fmt.Errorf("invalid winner %q", winner)
+	}
+	r.logger().Warn("game force-ended by admin", "winner", winner)
+	return nil
+}
+
+// close detaches every client with a notice and removes the room.
+func (r *Room) close(reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closeLocked(reason)
+}
+
+// closeLocked is close for callers that already hold the lock.
+// Must be called with lock held.
+func (r *Room) closeLocked(reason string) {
+	if len(r.clients) == 0 {
+		return // Last player already left and the room is gone
+	}
+	r.stopTimers()
+	for id, c := range r.clients {
+		c.sendNotice("ROOM_CLOSED", reason)
+		c.leaveRoom()
+		delete(r.clients, id)
+	}
+	r.detachAllAgents(reason)
+	r.hub.removeRoom(r.code)
+	r.logger().Warn("room closed", "reason", reason)
+}
+
+// kick removes a player or bot from the room. A kicked human stays
+// connected and can browse or join other rooms.
+func (r *Room) kick(playerID, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.players[playerID]
+	if p == nil {
+		return fmt.Errorf("player %s is not in room %s", playerID, r.code)
+	}
+	if c := r.clients[playerID]; c != nil {
+		c.sendNotice("KICKED", reason)
+	}
+	if a := r.agents[playerID]; a != nil {
+		a.conn.sendNotice("KICKED", reason)
+	}
+	r.logger().Warn("player kicked by admin", logKeyPlayer, playerID, logKeyName, p.Name, "reason", reason)
+	r.removePlayer(playerID)
+	return nil
+}
+
 // ============================================================
 // State Broadcasting
 // ============================================================
 
 func (r *Room) broadcastState() {
+	start := time.Now()
+	defer func() { metrics.broadcastDuration.observe(time.Since(start).Seconds()) }()
+
 	for playerID, client := range r.clients {
 		state := r.buildStateForPlayer(playerID)
 		client.sendState(state)
 	}
+	for botID, agent := range r.agents {
+		agent.conn.sendState(r.buildStateForPlayer(botID))
+	}
+	if r.phase != r.lastPhase {
+		r.lastPhase = r.phase
+		r.markActive()
+	}
+	r.publishInfo()
 }
 
 func (r *Room) buildStateForPlayer(playerID string) GameState {
@@ -1194,23 +1257,43 @@ func (r *Room) buildStateForPlayer(playerID string) GameState {
 
 	guesses := make([]GuessEntry, 0, len(r.guesses))
 	for _, g := range r.guesses {
-		guesses = append(guesses, *g)
+		gc := *g
+		if thisPlayer == nil || !thisPlayer.IsMayor {
+			gc.SuggestedToken = "" // only the Mayor is told a guess is close
+		}
+		guesses = append(guesses, gc)
 	}
 
 	// Word options shown only to the Mayor during word selection
 	var wordOptions []string
+	var wordChoices []WordOption
+	var rerollsLeft int
 	if r.phase == PhaseWordSelection {
 		if thisPlayer != nil && thisPlayer.IsMayor {
 			wordOptions = r.wordOptions
+			wordChoices = r.wordChoices
+			rerollsLeft = r.rerolls - r.rerollsUsed
 		}
 	}
 
 	// Hints: show hint string to non-Mayor players
 	var hintString string
-	if thisPlayer != nil && !thisPlayer.IsMayor && r.hintsRevealed > 0 {
+	if thisPlayer != nil && !thisPlayer.IsMayor && len(r.hintIndices) > 0 {
 		hintString = r.buildHintString()
 	}
 
+	// Hints the Mayor can still buy
+	var hintOptions []HintOption
+	if r.phase == PhaseDayPhase && thisPlayer != nil && thisPlayer.IsMayor {
+		hintOptions = r.hintOptions()
+	}
+
+	// Language choices are only needed while setting up
+	var languages []LanguageInfo
+	if r.phase == PhaseLobby {
+		languages = currentWordBank().languageInfo()
+	}
+
 	return GameState{
 		Phase:           r.phase,
 		RoomCode:        r.code,
@@ -1218,6 +1301,8 @@ func (r *Room) buildStateForPlayer(playerID string) GameState {
 		SecretWord:      secretWord,
 		SecretWordHints: hintString,
 		WordOptions:     wordOptions,
+		WordChoices:     wordChoices,
+		RerollsLeft:     rerollsLeft,
 		TimeRemaining:   r.timeRemaining,
 		TokensUsed:      r.tokensUsed,
 		TokenHistory:    tokenHistory,
@@ -1226,6 +1311,74 @@ func (r *Room) buildStateForPlayer(playerID string) GameState {
 		MyPlayerID:      playerID,
 		Difficulty:      r.difficulty,
 		HintsRevealed:   r.hintsRevealed,
+		HintOptions:     hintOptions,
+		Timeline:        append([]TimelineEvent(nil), r.timeline...),
 		NumWerewolves:   r.getNumWerewolves(len(r.order)),
+		AutoFillBots:    r.autoFillBots,
+		Language:        r.language,
+		Languages:       languages,
+		WordSource:      r.wordSource,
+		CustomWordCount: len(r.customWords),
+		FreshWords:      r.freshWordCount(),
+		WordOptionCount: r.optionCount,
+		Rerolls:         r.rerolls,
+		MixedDifficulty: r.mixedDifficulty,
+		FreeChoice:      r.freeChoice,
+	}
+}
+
+// inProgress reports whether a game is running (anything past the lobby
+// that hasn't reached game over). Must be called with lock held.
+func (r *Room) inProgress() bool {
+	return r.phase != PhaseLobby && r.phase != PhaseGameOver
+}
+
+// snapshot copies the room's full, unfiltered state.
+// Must be called with lock held.
+func (r *Room) snapshot() RoomSnapshot {
+	players := make([]Player, 0, len(r.order))
+	for _, id := range r.order {
+		if p := r.players[id]; p != nil {
+			pc := *p
+			pc.Score = r.scores[id]
+			pc.Achievements = r.achievements[id]
+			players = append(players, pc)
+		}
+	}
+	connected := make([]string, 0, len(r.clients))
+	for _, id := range r.order {
+		if _, ok := r.clients[id]; ok {
+			connected = append(connected, id)
+		}
+	}
+	guesses := make([]GuessEntry, 0, len(r.guesses))
+	for _, g := range r.guesses {
+		guesses = append(guesses, *g)
+	}
+	votes := make(map[string]string, len(r.votes))
+	for voter, target := range r.votes {
+		votes[voter] = target
+	}
+	return RoomSnapshot{
+		Code:          r.code,
+		Phase:         r.phase,
+		GameEpoch:     r.gameEpoch,
+		SecretWord:    r.secretWord,
+		WordOptions:   append([]string(nil), r.wordOptions...),
+		Difficulty:    r.difficulty,
+		Language:      r.language,
+		WordSource:    r.wordSource,
+		CustomWords:   append([]string(nil), r.customWords...),
+		SeenWords:     r.seenWordList(),
+		TimeRemaining: r.timeRemaining,
+		Players:       players,
+		Connected:     connected,
+		TokenHistory:  append([]TokenAction(nil), r.tokenHistory...),
+		Timeline:      append([]TimelineEvent(nil), r.timeline...),
+		Guesses:       guesses,
+		Votes:         votes,
+		Winner:        r.winner,
+		DebugLogging:  r.debugLog.Load(),
+		TakenAt:       time.Now().UnixMilli(),
 	}
 }
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRoomPageSize = 20
+	maxRoomPageSize     = 100
+	roomFeedBuffer      = 64
+	roomFeedHeartbeat   = 25 * time.Second
+)
+
+// RoomQuery filters, sorts and pages the room list.
+type RoomQuery struct {
+	JoinableOnly bool
+	Difficulty   string
+	Language     string
+	MinFreeSeats int
+	Sort         string // "newest" (default), "oldest", "players", "-players", "code"
+	Offset       int
+	Limit        int // 0 means no limit
+}
+
+// info summarises the room for the room browser.
+// Must be called with lock held.
+func (r *Room) info() RoomInfo {
+	names := make([]string, 0, len(r.order))
+	for _, id := range r.order {
+		if p := r.players[id]; p != nil {
+			names = append(names, p.Name)
+		}
+	}
+	free := maxPlayers - len(r.players)
+	if free < 0 {
+		free = 0
+	}
+	return RoomInfo{
+		Code:        r.code,
+		PlayerCount: len(r.players),
+		MaxPlayers:  maxPlayers,
+		FreeSeats:   free,
+		Phase:       r.phase,
+		Joinable:    r.phase == PhaseLobby && free > 0,
+		Difficulty:  r.difficulty,
+		Language:    r.language,
+		PlayerNames: names,
+		CreatedAt:   r.createdAt.UnixMilli(),
+	}
+}
+
+// publishInfo pushes a room feed event if the room's summary changed since
+// the last push. Must be called with lock held.
+func (r *Room) publishInfo() {
+	if len(r.clients) == 0 {
+		return // Room is being torn down; removeRoom publishes the removal
+	}
+	info := r.info()
+	if r.publishedInfo == nil {
+		r.hub.roomFeed.publish(RoomEvent{Type: RoomEventCreated, Room: info})
+	} else if !reflect.DeepEqual(*r.publishedInfo, info) {
+		r.hub.roomFeed.publish(RoomEvent{Type: RoomEventUpdated, Room: info})
+	} else {
+		return
+	}
+	r.publishedInfo = &info
+}
+
+// queryRooms returns one page of matching rooms and the total match count.
+// Rooms on other nodes of a cluster are included as of the last sync.
+func (h *Hub) queryRooms(q RoomQuery) ([]RoomInfo, int) {
+	all := make([]RoomInfo, 0)
+	local := make(map[string]bool)
+	for _, room := range h.roomList() {
+		room.mu.Lock()
+		all = append(all, room.info())
+		room.mu.Unlock()
+		local[room.code] = true
+	}
+	for _, info := range h.remoteRoomList() {
+		if !local[info.Code] {
+			all = append(all, info)
+		}
+	}
+
+	rooms := make([]RoomInfo, 0)
+	for _, info := range all {
+		if q.JoinableOnly && !info.Joinable {
+			continue
+		}
+		if q.Difficulty != "" && info.Difficulty != q.Difficulty {
+			continue
+		}
+		if q.Language != "" && info.Language != q.Language {
+			continue
+		}
+		if info.FreeSeats < q.MinFreeSeats {
+			continue
+		}
+		rooms = append(rooms, info)
+	}
+
+	sort.SliceStable(rooms, func(i, j int) bool {
+		a, b := rooms[i], rooms[j]
+		switch q.Sort {
+		case "oldest":
+			return a.CreatedAt < b.CreatedAt
+		case "players":
+			return a.PlayerCount < b.PlayerCount
+		case "-players":
+			return a.PlayerCount > b.PlayerCount
+		case "code":
+			return a.Code < b.Code
+		default:
+			return a.CreatedAt > b.CreatedAt
+		}
+	})
+
+	total := len(rooms)
+	if q.Offset > total {
+		q.Offset = total
+	}
+	rooms = rooms[q.Offset:]
+	if q.Limit > 0 && q.Limit < len(rooms) {
+		rooms = rooms[:q.Limit]
+	}
+	return rooms, total
+}
+
+// ============================================================
+// Room Feed (live push of room list changes)
+// ============================================================
+
+type roomFeed struct {
+	mu     sync.Mutex
+	subs   map[chan RoomEvent]bool
+	closed bool
+}
+
+func newRoomFeed() *roomFeed {
+	return &roomFeed{subs: make(map[chan RoomEvent]bool)}
+}
+
+func (f *roomFeed) subscribe() chan RoomEvent {
+	ch := make(chan RoomEvent, roomFeedBuffer)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		close(ch)
+		return ch
+	}
+	f.subs[ch] = true
+	return ch
+}
+
+func (f *roomFeed) unsubscribe(ch chan RoomEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.subs[ch] {
+		delete(f.subs, ch)
+		close(ch)
+	}
+}
+
+// publish never blocks: a subscriber whose buffer is full is dropped, and
+// can reconnect to get a fresh snapshot.
+func (f *roomFeed) publish(evt RoomEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range f.subs {
+		select {
+		case ch <- evt:
+		default:
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// close ends every subscription, used on shutdown.
+func (f *roomFeed) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	for ch := range f.subs {
+		delete(f.subs, ch)
+		close(ch)
+	}
+}
+
+// ============================================================
+// HTTP Handlers
+// ============================================================
+
+// handleListRooms serves GET /api/rooms.
+//
+//	?joinable=true     lobby rooms with a free seat only
+//	?difficulty=EASY   rooms at one difficulty
+//	?language=es       rooms playing in one language
+//	?minFreeSeats=2    rooms with at least that many free seats
+//	?sort=newest       newest | oldest | players | -players | code
+//	?offset=0&limit=20 paging (limit capped at 100)
+func (h *Hub) handleListRooms(w http.ResponseWriter, r *http.Request) {
+	q, err := parseRoomQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rooms, total := h.queryRooms(q)
+	writeJSON(w, http.StatusOK, RoomPage{Rooms: rooms, Total: total, Offset: q.Offset, Limit: q.Limit})
+}
+
+// RoomPage is the GET /api/rooms response.
+type RoomPage struct {
+	Rooms  []RoomInfo `json:"rooms"`
+	Total  int        `json:"total"`
+	Offset int        `json:"offset"`
+	Limit  int        `json:"limit"`
+}
+
+func parseRoomQuery(r *http.Request) (RoomQuery, error) {
+	v := r.URL.Query()
+	q := RoomQuery{
+		Difficulty: strings.ToUpper(v.Get("difficulty")),
+		Language:   strings.ToLower(v.Get("language")),
+		Sort:       v.Get("sort"),
+		Limit:      defaultRoomPageSize,
+	}
+	q.JoinableOnly, _ = strconv.ParseBool(v.Get("joinable"))
+
+	switch q.Difficulty {
+	case "", DifficultyEasy, DifficultyMedium, DifficultyHard, DifficultyPhrase, DifficultyAdaptive:
+	default:
+		return q, fmt.Errorf("unknown difficulty %q", q.Difficulty)
+	}
+	switch q.Sort {
+	case "", "newest", "oldest", "players", "-players", "code":
+	default:
+		return q, fmt.Errorf("unknown sort %q", q.Sort)
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  *int
+	}{{"minFreeSeats", &q.MinFreeSeats}, {"offset", &q.Offset}, {"limit", &q.Limit}} {
+		raw := v.Get(p.name)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return q, fmt.Errorf("%s must be a non-negative integer", p.name)
+		}
+		*p.dst = n
+	}
+	if q.Limit == 0 || q.Limit > maxRoomPageSize {
+		q.Limit = maxRoomPageSize
+	}
+	return q, nil
+}
+
+// handleRoomEvents serves GET /api/rooms/events as Server-Sent Events.
+// The stream opens with a "snapshot" event holding every room, followed by
+// ROOM_CREATED, ROOM_UPDATED and ROOM_REMOVED events as they happen.
+func (h *Hub) handleRoomEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	// Subscribe before taking the snapshot so no change falls in between
+	events := h.roomFeed.subscribe()
+	defer h.roomFeed.unsubscribe(events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	rooms, _ := h.queryRooms(RoomQuery{})
+	writeSSE(w, "snapshot", RoomListPayload{Rooms: rooms})
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(roomFeedHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case evt, ok := <-events:
+			if !ok {
+				return // Dropped as a slow consumer, or shutting down
+			}
+			writeSSE(w, evt.Type, evt.Room)
+			flusher.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func writeSSE(w http.ResponseWriter, event string, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+}
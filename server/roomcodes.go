@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"unicode"
+)
+
+// maxCodeAttempts bounds how many random codes are tried before giving up,
+// so a nearly full code space fails cleanly instead of spinning forever.
+const maxCodeAttempts = 100
+
+var errNoRoomCode = errors.New("could not find a free room code")
+
+// CodeGenerator produces candidate room codes. Codes must be upper-case so
+// they match normalizeRoomCode.
+type CodeGenerator interface {
+	Generate() string
+	// Capacity is the number of distinct codes the generator can produce.
+	Capacity() int
+}
+
+// newCodeGenerator returns the generator for a ROOM_CODE_STYLE value.
+func newCodeGenerator(style string) (CodeGenerator, error) {
+	switch strings.ToLower(style) {
+	case "", "words":
+		return newWordCodeGenerator(), nil
+	case "alphabet":
+		return alphabetCodeGenerator{length: 6}, nil
+	case "classic":
+		return classicCodeGenerator{}, nil
+	default:
+		return nil, fmt.Errorf("unknown room code style %q (want words, alphabet or classic)", style)
+	}
+}
+
+// normalizeRoomCode makes codes case-insensitive and forgiving about
+// separators: " fuzzy otter_42 " becomes "FUZZY-OTTER-42".
+func normalizeRoomCode(code string) string {
+	fields := strings.FieldsFunc(strings.ToUpper(code), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '_' || r == '-'
+	})
+	return strings.Join(fields, "-")
+}
+
+// --- Classic: WOLF-0000 ---
+
+type classicCodeGenerator struct{}
+
+func (classicCodeGenerator) Generate() string {
+	return fmt.Sprintf("WOLF-%04d", rand.Intn(10000))
+}
+
+func (classicCodeGenerator) Capacity() int { return 10000 }
+
+// --- Alphabet: unambiguous characters only ---
+
+// codeAlphabet leaves out 0/O, 1/I/L and 5/S, which are easy to confuse
+// when read aloud or copied from a screen.
+const codeAlphabet = "ABCDEFGHJKMNPQRTUVWXYZ2346789"
+
+type alphabetCodeGenerator struct {
+	length int
+}
+
+func (g alphabetCodeGenerator) Generate() string {
+	b := make([]byte, g.length)
+	for i := range b {
+		b[i] = codeAlphabet[rand.Intn(len(codeAlphabet))]
+	}
+	return string(b)
+}
+
+func (g alphabetCodeGenerator) Capacity() int {
+	n := 1
+	for i := 0; i < g.length; i++ {
+		n *= len(codeAlphabet)
+	}
+	return n
+}
+
+// --- Words: FUZZY-OTTER-42 ---
+
+var codeAdjectives = []string{
+	"AMBER", "BOUNCY", "BRAVE", "BRIGHT", "BUBBLY", "CALM", "CHEEKY", "CHEERY",
+	"CLEVER", "COSY", "CRISPY", "CURLY", "DAPPER", "DIZZY", "EAGER", "FANCY",
+	"FLUFFY", "FROSTY", "FUZZY", "GENTLE", "GIDDY", "GOLDEN", "GRUMPY", "HAPPY",
+	"HUNGRY", "JOLLY", "JUMPY", "LUCKY", "MELLOW", "MERRY", "MIGHTY", "MISTY",
+	"NIMBLE", "NOBLE", "PLUCKY", "PLUSH", "PROUD", "QUIET", "QUIRKY", "RAPID",
+	"ROSY", "RUSTY", "SHY", "SILLY", "SLEEPY", "SNEAKY", "SNOWY", "SPARKY",
+	"SPOOKY", "SUNNY", "SWIFT", "TINY", "WARM", "WILD", "WISE", "ZESTY",
+}
+
+// wordCodeGenerator pairs an adjective with a short, letters-only noun from
+// the word bank and a two-digit number.
+type wordCodeGenerator struct {
+	nouns []string
+}
+
+func newWordCodeGenerator() wordCodeGenerator {
+	nouns := make([]string, 0)
+	seen := make(map[string]bool)
+	bank := currentWordBank()
+	for _, w := range append(append([]string{}, bank.pool(defaultLanguage, DifficultyEasy)...), bank.pool(defaultLanguage, DifficultyMedium)...) {
+		upper := strings.ToUpper(w)
+		if len(upper) > 8 || seen[upper] || strings.IndexFunc(upper, func(r rune) bool { return r < 'A' || r > 'Z' }) >= 0 {
+			continue
+		}
+		seen[upper] = true
+		nouns = append(nouns, upper)
+	}
+	return wordCodeGenerator{nouns: nouns}
+}
+
+func (g wordCodeGenerator) Generate() string {
+	adj := codeAdjectives[rand.Intn(len(codeAdjectives))]
+	noun := g.nouns[rand.Intn(len(g.nouns))]
+	return fmt.Sprintf("%s-%s-%02d", adj, noun, 10+rand.Intn(90))
+}
+
+func (g wordCodeGenerator) Capacity() int {
+	return len(codeAdjectives) * len(g.nouns) * 90
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const shutdownNoticeInterval = 5 * time.Second
+
+// shutdown drains the hub: it stops new rooms and games, warns every client
+// with a countdown, waits for running games to finish (up to grace), writes
+// a snapshot of any game still running, and finally closes all connections.
+func (h *Hub) shutdown(grace time.Duration, snapshotFile string) {
+	h.mu.Lock()
+	h.draining = true
+	h.mu.Unlock()
+
+	deadline := time.Now().Add(grace)
+	hubLog().Info("shutting down", "grace", grace.String(), "games_in_progress", h.gamesInProgress())
+
+	h.notifyShutdown(deadline)
+	notice := time.NewTicker(shutdownNoticeInterval)
+	poll := time.NewTicker(1 * time.Second)
+	defer notice.Stop()
+	defer poll.Stop()
+
+drain:
+	for time.Now().Before(deadline) {
+		select {
+		case <-notice.C:
+			h.notifyShutdown(deadline)
+		case <-poll.C:
+			if h.gamesInProgress() == 0 {
+				break drain
+			}
+		}
+	}
+
+	if snapshots := h.snapshotRunningGames(); len(snapshots) > 0 {
+		h.writeSnapshots(snapshots, snapshotFile)
+	}
+
+	h.roomFeed.close()
+	for _, c := range h.clientList() {
+		c.closeWithReason(websocket.CloseGoingAway, "Server shutting down")
+	}
+	hubLog().Info("shutdown complete")
+}
+
+func (h *Hub) notifyShutdown(deadline time.Time) {
+	secs := int(time.Until(deadline).Round(time.Second).Seconds())
+	if secs < 0 {
+		secs = 0
+	}
+	notice := CountdownPayload{
+		SecondsRemaining: secs,
+		Message:          "The server is restarting. Games in progress may finish; no new games can start.",
+	}
+	for _, c := range h.clientList() {
+		c.sendCountdown("SERVER_SHUTDOWN", notice)
+	}
+}
+
+func (h *Hub) gamesInProgress() int {
+	count := 0
+	for _, room := range h.roomList() {
+		room.mu.Lock()
+		if room.inProgress() {
+			count++
+		}
+		room.mu.Unlock()
+	}
+	return count
+}
+
+// snapshotRunningGames stops every game that didn't finish in time and
+// returns its state.
+func (h *Hub) snapshotRunningGames() []RoomSnapshot {
+	snapshots := make([]RoomSnapshot, 0)
+	for _, room := range h.roomList() {
+		room.mu.Lock()
+		if room.inProgress() {
+			snapshots = append(snapshots, room.snapshot())
+			room.stopTimers()
+		}
+		room.mu.Unlock()
+	}
+	return snapshots
+}
+
+func (h *Hub) writeSnapshots(snapshots []RoomSnapshot, path string) {
+	if path == "" {
+		for _, s := range snapshots {
+			hubLog().Warn("game interrupted, no snapshot file set", logKeyRoom, s.Code, logKeyEpoch, s.GameEpoch, logKeyPhase, s.Phase)
+		}
+		return
+	}
+	data, err := json.MarshalIndent(snapshots, "", "  ")
+	if err != nil {
+		hubLog().Error("encoding snapshots", "error", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		hubLog().Error("writing snapshots", "file", path, "error", err)
+		return
+	}
+	hubLog().Info("snapshots of interrupted games written", "count", len(snapshots), "file", path)
+}
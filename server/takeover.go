@@ -0,0 +1,82 @@
+package main
+
+import "crypto/subtle"
+
+// When a human drops out of a running game their seat isn't emptied: a bot
+// takes it over with the same role and score, and they can take it back by
+// rejoining with the token they were given on joining.
+
+// JoinedPayload tells a player which seat is theirs and the token that
+// reclaims it after a disconnect.
+type JoinedPayload struct {
+	RoomCode    string `json:"roomCode"`
+	PlayerID    string `json:"playerId"`
+	RejoinToken string `json:"rejoinToken"`
+}
+
+// sendJoined hands a player their seat and rejoin token.
+// Must be called with lock held.
+func (r *Room) sendJoined(c *Client, p *Player) {
+	c.sendMessage("JOINED", JoinedPayload{RoomCode: r.code, PlayerID: p.ID, RejoinToken: p.rejoinToken})
+}
+
+// takeOverSeat hands a disconnected player's seat to the bot strategy for
+// the rest of the game.
+// Must be called with lock held.
+func (r *Room) takeOverSeat(c *Client) {
+	p := r.players[c.playerID]
+	delete(r.clients, c.playerID)
+	if p == nil {
+		return
+	}
+	p.IsBot = true
+	p.Disconnected = true
+	p.BotSkill = BotSkillNormal
+	p.BotPersonality = defaultPersonality
+	r.startBotTurn(p, r.gameEpoch)
+
+	r.logger().Info("bot took over disconnected player", logKeyPlayer, p.ID, logKeyName, p.Name, "role", p.Role, "mayor", p.IsMayor)
+	r.broadcastState()
+}
+
+// rejoin puts a returning player back in their seat, taking it back from
+// the bot or from an older connection of theirs. It reports false if the
+// seat is gone or the token doesn't match.
+func (r *Room) rejoin(c *Client, playerID, token string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.players[playerID]
+	if p == nil || p.rejoinToken == "" || subtle.ConstantTimeCompare([]byte(p.rejoinToken), []byte(token)) != 1 {
+		return false
+	}
+	if old := r.clients[playerID]; old != nil {
+		old.sendNotice("KICKED", "You joined this game from another connection")
+		old.leaveRoom()
+	}
+
+	c.playerID = playerID
+	c.room.Store(r)
+	r.clients[playerID] = c
+	if p.Disconnected {
+		p.IsBot = false
+		p.Disconnected = false
+		p.BotSkill = ""
+		p.BotPersonality = ""
+	}
+
+	r.logger().Info("player rejoined", logKeyPlayer, playerID, logKeyName, p.Name)
+	r.sendJoined(c, p)
+	r.broadcastState()
+	return true
+}
+
+// dropDisconnected removes the seats of players who never came back.
+// Must be called with lock held.
+func (r *Room) dropDisconnected() {
+	for _, id := range append([]string(nil), r.order...) {
+		if p := r.players[id]; p != nil && p.Disconnected {
+			r.dropPlayer(id)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Words and guesses can be in any language, so text is compared and
+// measured in runes after NFC normalization: "é" typed as one code point or
+// as "e" plus a combining accent is the same letter.
+
+const maxGuessLength = 80 // runes
+
+// normalizeText puts text in NFC form and trims and collapses its spacing.
+func normalizeText(s string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(s)), " ")
+}
+
+// foldWord is the key two spellings of a word share when they differ only in
+// case, spacing or Unicode normalization ("STRASSE" and "Straße" included).
+func foldWord(s string) string {
+	return cases.Fold().String(normalizeText(s))
+}
+
+// sameText reports whether a and b are the same word by foldWord.
+func sameText(a, b string) bool {
+	return foldWord(a) == foldWord(b)
+}
+
+// phraseWords splits a word or phrase into its words at spaces and hyphens.
+func phraseWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == '-' })
+}
+
+// limitRunes cuts s to at most n runes without splitting a character.
+func limitRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
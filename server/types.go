@@ -48,25 +48,57 @@ const (
 // --- Difficulty Constants ---
 
 const (
-	DifficultyEasy   = "EASY"
-	DifficultyMedium = "MEDIUM"
-	DifficultyHard   = "HARD"
+	DifficultyEasy     = "EASY"
+	DifficultyMedium   = "MEDIUM"
+	DifficultyHard     = "HARD"
+	DifficultyPhrase   = "PHRASE"   // multi-word phrases, e.g. "Hot Air Balloon"
+	DifficultyAdaptive = "ADAPTIVE" // words picked from play stats to balance wins; see wordstats.go
+)
+
+// --- Word Source Constants ---
+
+const (
+	WordSourceBuiltin = "BUILTIN" // word packs only
+	WordSourceCustom  = "CUSTOM"  // the room's custom list only
+	WordSourceMix     = "MIX"
+)
+
+// --- Bot Skill Constants ---
+
+const (
+	BotSkillEasy   = "EASY"
+	BotSkillNormal = "NORMAL"
+	BotSkillHard   = "HARD"
+)
+
+// --- Room Event Constants ---
+
+const (
+	RoomEventCreated = "ROOM_CREATED"
+	RoomEventUpdated = "ROOM_UPDATED"
+	RoomEventRemoved = "ROOM_REMOVED"
 )
 
 // --- Data Structures ---
 
 type Player struct {
-	ID            string   `json:"id"`
-	Name          string   `json:"name"`
-	Role          string   `json:"role"`
-	IsMayor       bool     `json:"isMayor"`
-	IsReady       bool     `json:"isReady"`
-	WantsMayor    bool     `json:"wantsMayor"`
-	AvatarURL     string   `json:"avatarUrl,omitempty"`
-	VotesReceived int      `json:"votesReceived"`
-	IsBot         bool     `json:"isBot"`
-	Score         int      `json:"score"`
-	Achievements  []string `json:"achievements,omitempty"`
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Role           string   `json:"role"`
+	IsMayor        bool     `json:"isMayor"`
+	IsReady        bool     `json:"isReady"`
+	WantsMayor     bool     `json:"wantsMayor"`
+	AvatarURL      string   `json:"avatarUrl,omitempty"`
+	VotesReceived  int      `json:"votesReceived"`
+	IsBot          bool     `json:"isBot"`
+	BotSkill       string   `json:"botSkill,omitempty"`
+	BotPersonality string   `json:"botPersonality,omitempty"`
+	Disconnected   bool     `json:"disconnected,omitempty"` // a bot is playing the seat until they rejoin
+	Score          int      `json:"score"`
+	Achievements   []string `json:"achievements,omitempty"`
+
+	autoFilled  bool   // added by AUTO_FILL_BOTS, and removed again as humans join
+	rejoinToken string // lets a disconnected human reclaim the seat
 }
 
 type TokenAction struct {
@@ -77,27 +109,42 @@ type TokenAction struct {
 }
 
 type GuessEntry struct {
-	PlayerID  string `json:"playerId"`
-	Text      string `json:"text"`
-	Timestamp int64  `json:"timestamp"`
+	PlayerID       string `json:"playerId"`
+	Text           string `json:"text"`
+	Timestamp      int64  `json:"timestamp"`
+	SuggestedToken string `json:"suggestedToken,omitempty"` // SO_CLOSE for a near miss; Mayor only
 }
 
 type GameState struct {
-	Phase            string        `json:"phase"`
-	RoomCode         string        `json:"roomCode"`
-	Players          []Player      `json:"players"`
-	SecretWord       string        `json:"secretWord"`
-	SecretWordHints  string        `json:"secretWordHints,omitempty"`
-	WordOptions      []string      `json:"wordOptions,omitempty"`
-	TimeRemaining    int           `json:"timeRemaining"`
-	TokensUsed       int           `json:"tokensUsed"`
-	TokenHistory     []TokenAction `json:"tokenHistory"`
-	Guesses          []GuessEntry  `json:"guesses"`
-	Winner           string        `json:"winner,omitempty"`
-	MyPlayerID       string        `json:"myPlayerId"`
-	Difficulty       string        `json:"difficulty"`
-	HintsRevealed    int           `json:"hintsRevealed"`
-	NumWerewolves    int           `json:"numWerewolves"`
+	Phase           string          `json:"phase"`
+	RoomCode        string          `json:"roomCode"`
+	Players         []Player        `json:"players"`
+	SecretWord      string          `json:"secretWord"`
+	SecretWordHints string          `json:"secretWordHints,omitempty"`
+	WordOptions     []string        `json:"wordOptions,omitempty"`
+	WordChoices     []WordOption    `json:"wordChoices,omitempty"` // wordOptions with difficulty tags; Mayor only
+	RerollsLeft     int             `json:"rerollsLeft,omitempty"` // Mayor only, while choosing
+	TimeRemaining   int             `json:"timeRemaining"`
+	TokensUsed      int             `json:"tokensUsed"`
+	TokenHistory    []TokenAction   `json:"tokenHistory"`
+	Guesses         []GuessEntry    `json:"guesses"`
+	Winner          string          `json:"winner,omitempty"`
+	MyPlayerID      string          `json:"myPlayerId"`
+	Difficulty      string          `json:"difficulty"`
+	HintsRevealed   int             `json:"hintsRevealed"`
+	HintOptions     []HintOption    `json:"hintOptions,omitempty"` // Mayor only, during the day
+	Timeline        []TimelineEvent `json:"timeline,omitempty"`
+	NumWerewolves   int             `json:"numWerewolves"`
+	AutoFillBots    bool            `json:"autoFillBots"`
+	Language        string          `json:"language"`
+	Languages       []LanguageInfo  `json:"languages,omitempty"` // choices, sent in the lobby
+	WordSource      string          `json:"wordSource"`
+	CustomWordCount int             `json:"customWordCount"`
+	FreshWords      int             `json:"freshWords"` // words left before options start repeating
+	WordOptionCount int             `json:"wordOptionCount"`
+	Rerolls         int             `json:"rerolls"`
+	MixedDifficulty bool            `json:"mixedDifficulty"`
+	FreeChoice      bool            `json:"freeChoice"` // the Mayor may choose a word of their own
 }
 
 // RoomInfo is a summary of a room for the room browser.
@@ -105,8 +152,19 @@ type RoomInfo struct {
 	Code        string   `json:"code"`
 	PlayerCount int      `json:"playerCount"`
 	MaxPlayers  int      `json:"maxPlayers"`
+	FreeSeats   int      `json:"freeSeats"`
 	Phase       string   `json:"phase"`
+	Joinable    bool     `json:"joinable"`
+	Difficulty  string   `json:"difficulty,omitempty"`
+	Language    string   `json:"language,omitempty"`
 	PlayerNames []string `json:"playerNames"`
+	CreatedAt   int64    `json:"createdAt,omitempty"`
+}
+
+// RoomEvent is pushed to room browser subscribers when the room list changes.
+type RoomEvent struct {
+	Type string   `json:"type"`
+	Room RoomInfo `json:"room"`
 }
 
 // --- Client → Server Messages ---
@@ -120,6 +178,32 @@ type JoinGamePayload struct {
 	Name      string `json:"name"`
 	RoomCode  string `json:"roomCode,omitempty"`
 	AvatarURL string `json:"avatarUrl,omitempty"`
+
+	// Reclaim a seat after a disconnect, using the JOINED message's values
+	PlayerID    string `json:"playerId,omitempty"`
+	RejoinToken string `json:"rejoinToken,omitempty"`
+}
+
+type AddBotPayload struct {
+	Skill       string `json:"skill,omitempty"`
+	Personality string `json:"personality,omitempty"`
+	Agent       string `json:"agent,omitempty"` // seat a connected external agent
+}
+
+type RemoveBotPayload struct {
+	BotID string `json:"botId"`
+}
+
+// ConfigureBotPayload changes a bot's settings; empty fields are left as is.
+type ConfigureBotPayload struct {
+	BotID       string `json:"botId"`
+	Name        string `json:"name,omitempty"`
+	Skill       string `json:"skill,omitempty"`
+	Personality string `json:"personality,omitempty"`
+}
+
+type AutoFillBotsPayload struct {
+	Enabled bool `json:"enabled"`
 }
 
 type SubmitTokenPayload struct {
@@ -143,10 +227,39 @@ type SendReactionPayload struct {
 	Emoji string `json:"emoji"`
 }
 
+// RevealHintPayload picks the kind of hint; an empty type reveals a letter.
+type RevealHintPayload struct {
+	Type string `json:"type,omitempty"`
+}
+
 type SetDifficultyPayload struct {
 	Difficulty string `json:"difficulty"`
 }
 
+// SetCustomWordsPayload replaces the room's custom word list. Text is a
+// pasted or uploaded list, one word per line or comma-separated; Words is
+// added to it. Sending neither clears the list.
+type SetCustomWordsPayload struct {
+	Text  string   `json:"text,omitempty"`
+	Words []string `json:"words,omitempty"`
+}
+
+type SetLanguagePayload struct {
+	Language string `json:"language"`
+}
+
+type SetWordSourcePayload struct {
+	Source string `json:"source"`
+}
+
+// SetWordOptionsPayload configures word selection for the room's games.
+type SetWordOptionsPayload struct {
+	Count           int  `json:"count"`
+	Rerolls         int  `json:"rerolls"`
+	MixedDifficulty bool `json:"mixedDifficulty"`
+	FreeChoice      bool `json:"freeChoice"`
+}
+
 // ReactionBroadcast is an ephemeral message broadcast to all clients.
 type ReactionBroadcast struct {
 	PlayerID string `json:"playerId"`
@@ -168,6 +281,43 @@ type RoomListPayload struct {
 	Rooms []RoomInfo `json:"rooms"`
 }
 
+// CountdownPayload warns clients that something will happen after a delay,
+// used for SERVER_SHUTDOWN and ROOM_EXPIRING.
+type CountdownPayload struct {
+	SecondsRemaining int    `json:"secondsRemaining"`
+	Message          string `json:"message"`
+}
+
+// NoticePayload carries a human-readable notice, used for ANNOUNCEMENT,
+// KICKED, ROOM_CLOSED and REJOIN_FAILED messages.
+type NoticePayload struct {
+	Message string `json:"message"`
+}
+
+// RoomSnapshot is a point-in-time copy of a room's full internal state.
+type RoomSnapshot struct {
+	Code          string            `json:"code"`
+	Phase         string            `json:"phase"`
+	GameEpoch     int               `json:"gameEpoch"`
+	SecretWord    string            `json:"secretWord"`
+	WordOptions   []string          `json:"wordOptions,omitempty"`
+	Difficulty    string            `json:"difficulty"`
+	Language      string            `json:"language"`
+	WordSource    string            `json:"wordSource"`
+	CustomWords   []string          `json:"customWords,omitempty"`
+	SeenWords     []string          `json:"seenWords,omitempty"`
+	TimeRemaining int               `json:"timeRemaining"`
+	Players       []Player          `json:"players"`
+	Connected     []string          `json:"connected"`
+	TokenHistory  []TokenAction     `json:"tokenHistory"`
+	Timeline      []TimelineEvent   `json:"timeline,omitempty"`
+	Guesses       []GuessEntry      `json:"guesses"`
+	Votes         map[string]string `json:"votes"`
+	Winner        string            `json:"winner,omitempty"`
+	DebugLogging  bool              `json:"debugLogging"`
+	TakenAt       int64             `json:"takenAt"`
+}
+
 // --- Utilities ---
 
 func newUUID() string {
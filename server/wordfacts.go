@@ -0,0 +1,109 @@
+package main
+
+import "fmt"
+
+// WordFacts describes a secret word well enough for a bot Mayor to answer
+// yes/no questions about it.
+type WordFacts struct {
+	Category string // animal, food, place, person, concept, ...
+	Size     int    // sizeNone for things without a physical size
+	Traits   map[string]bool
+}
+
+// Sizes, smallest to largest. sizeNone marks abstract words.
+const (
+	sizeNone = iota
+	sizeTiny
+	sizeSmall
+	sizeMedium
+	sizeLarge
+	sizeHuge
+)
+
+var sizeNames = map[string]int{
+	"": sizeNone, "none": sizeNone, "tiny": sizeTiny, "small": sizeSmall,
+	"medium": sizeMedium, "large": sizeLarge, "huge": sizeHuge,
+}
+
+var factCategories = map[string]bool{
+	"animal": true, "plant": true, "food": true, "drink": true, "object": true,
+	"toy": true, "clothing": true, "jewelry": true, "instrument": true, "vehicle": true,
+	"nature": true, "weather": true, "space": true, "science": true, "place": true,
+	"person": true, "creature": true, "body": true, "activity": true, "event": true,
+	"concept": true, "pattern": true,
+}
+
+// categoryNames are the categories as players see them in the category
+// hint, by language. Languages without a table use the English names.
+var categoryNames = map[string]map[string]string{
+	"es": {
+		"animal": "animal", "plant": "planta", "food": "comida", "drink": "bebida", "object": "objeto",
+		"toy": "juguete", "clothing": "ropa", "jewelry": "joya", "instrument": "instrumento", "vehicle": "vehículo",
+		"nature": "naturaleza", "weather": "tiempo", "space": "espacio", "science": "ciencia", "place": "lugar",
+		"person": "persona", "creature": "criatura", "body": "cuerpo", "activity": "actividad", "event": "evento",
+		"concept": "concepto", "pattern": "patrón",
+	},
+	"de": {
+		"animal": "Tier", "plant": "Pflanze", "food": "Essen", "drink": "Getränk", "object": "Gegenstand",
+		"toy": "Spielzeug", "clothing": "Kleidung", "jewelry": "Schmuck", "instrument": "Instrument", "vehicle": "Fahrzeug",
+		"nature": "Natur", "weather": "Wetter", "space": "Weltraum", "science": "Wissenschaft", "place": "Ort",
+		"person": "Person", "creature": "Fabelwesen", "body": "Körper", "activity": "Aktivität", "event": "Ereignis",
+		"concept": "Begriff", "pattern": "Muster",
+	},
+	"fr": {
+		"animal": "animal", "plant": "plante", "food": "nourriture", "drink": "boisson", "object": "objet",
+		"toy": "jouet", "clothing": "vêtement", "jewelry": "bijou", "instrument": "instrument", "vehicle": "véhicule",
+		"nature": "nature", "weather": "météo", "space": "espace", "science": "science", "place": "lieu",
+		"person": "personne", "creature": "créature", "body": "corps", "activity": "activité", "event": "événement",
+		"concept": "concept", "pattern": "motif",
+	},
+}
+
+// categoryHintFormats introduce the category in the category hint, by language.
+var categoryHintFormats = map[string]string{
+	"en": "It's in the category: %s",
+	"es": "Está en la categoría: %s",
+	"de": "Es gehört zur Kategorie: %s",
+	"fr": "C'est dans la catégorie : %s",
+}
+
+// categoryHint is the category hint for a word pack category, in language.
+func categoryHint(category, language string) string {
+	name := category
+	if n, ok := categoryNames[language][category]; ok {
+		name = n
+	}
+	format, ok := categoryHintFormats[language]
+	if !ok {
+		format = categoryHintFormats[defaultLanguage]
+	}
+	return fmt.Sprintf(format, name)
+}
+
+var factTraits = map[string]bool{
+	"living": true, "edible": true, "sweet": true, "fruit": true, "hot": true, "cold": true,
+	"indoors": true, "outdoors": true, "water": true, "sky": true, "flies": true, "fast": true,
+	"manmade": true, "holdable": true, "wearable": true, "electric": true, "noisy": true,
+	"dangerous": true, "sharp": true, "round": true, "imaginary": true, "abstract": true,
+	"pet": true, "wild": true, "farm": true, "sport": true, "game": true,
+}
+
+// newWordFacts checks a word pack entry's category, size and traits.
+// An empty size means the word has no physical size.
+func newWordFacts(category, size string, traits []string) (WordFacts, error) {
+	if !factCategories[category] {
+		return WordFacts{}, fmt.Errorf("unknown category %q", category)
+	}
+	sz, ok := sizeNames[size]
+	if !ok {
+		return WordFacts{}, fmt.Errorf("unknown size %q", size)
+	}
+	facts := WordFacts{Category: category, Size: sz, Traits: make(map[string]bool)}
+	for _, t := range traits {
+		if !factTraits[t] {
+			return WordFacts{}, fmt.Errorf("unknown trait %q", t)
+		}
+		facts.Traits[t] = true
+	}
+	return facts, nil
+}
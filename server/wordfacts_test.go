@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+func TestCategoryNamesCoverEveryCategory(t *testing.T) {
+	for language, names := range categoryNames {
+		for category := range factCategories {
+			if names[category] == "" {
+				t.Errorf("%s has no name for category %q", language, category)
+			}
+		}
+	}
+}
+
+func TestCategoryHint(t *testing.T) {
+	tests := []struct{ category, language, want string }{
+		{"animal", "en", "It's in the category: animal"},
+		{"animal", "de", "Es gehört zur Kategorie: Tier"},
+		{"food", "es", "Está en la categoría: comida"},
+		{"drink", "fr", "C'est dans la catégorie : boisson"},
+		{"food", "it", "It's in the category: food"},
+	}
+	for _, tt := range tests {
+		if got := categoryHint(tt.category, tt.language); got != tt.want {
+			t.Errorf("categoryHint(%q, %q) = %q, want %q", tt.category, tt.language, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import "sort"
+
+// A room remembers every word it has offered a Mayor, so a regular group
+// doesn't see the same options every few rounds. Seen words are skipped
+// until a pool runs out of fresh ones, then that pool starts over.
+
+// pickFresh returns n random words from pool, unseen ones first, and marks
+// them seen. When fewer than n are fresh, the pool's history is cleared.
+// Must be called with lock held.
+func (r *Room) pickFresh(pool []string, n int) []string {
+	fresh := make([]string, 0, len(pool))
+	for _, w := range pool {
+		if !r.seenWords[foldWord(w)] {
+			fresh = append(fresh, w)
+		}
+	}
+
+	picked := pickRandom(fresh, n)
+	if len(picked) < n {
+		// Everything's been offered: reshuffle, topping up from the rest
+		for _, w := range pool {
+			delete(r.seenWords, foldWord(w))
+		}
+		rest := make([]string, 0, len(pool))
+		for _, w := range pool {
+			if !containsFold(picked, w) {
+				rest = append(rest, w)
+			}
+		}
+		picked = append(picked, pickRandom(rest, n-len(picked))...)
+		r.logger().Debug("word pool exhausted, reshuffling", "pool", len(pool))
+	}
+
+	for _, w := range picked {
+		r.seenWords[foldWord(w)] = true
+	}
+	r.staleFreshWords()
+	return picked
+}
+
+// freshWordCount is how many words the room's next games can still offer
+// before words start repeating. It's sent with every state update, so it's
+// counted once and kept until the history or the word settings change.
+// Must be called with lock held.
+func (r *Room) freshWordCount() int {
+	if r.freshWords < 0 {
+		r.freshWords = 0
+		for _, w := range r.wordPool() {
+			if !r.seenWords[foldWord(w)] {
+				r.freshWords++
+			}
+		}
+	}
+	return r.freshWords
+}
+
+// staleFreshWords drops the cached freshWordCount, after the seen words or
+// anything that decides the word pool changes.
+// Must be called with lock held.
+func (r *Room) staleFreshWords() {
+	r.freshWords = -1
+}
+
+// seenWordList lists the words the room has been offered, for snapshots.
+// Must be called with lock held.
+func (r *Room) seenWordList() []string {
+	words := make([]string, 0, len(r.seenWords))
+	for w := range r.seenWords {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	return words
+}
@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestFreshWordCountFollowsHistory(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	pool := len(r.wordPool())
+	if pool == 0 {
+		t.Fatal("the built-in packs have no words")
+	}
+	if got := r.freshWordCount(); got != pool {
+		t.Fatalf("freshWordCount of a new room = %d, want %d", got, pool)
+	}
+
+	r.pickFresh(r.wordPool(), 3)
+	if got := r.freshWordCount(); got != pool-3 {
+		t.Fatalf("freshWordCount after offering 3 words = %d, want %d", got, pool-3)
+	}
+
+	r.difficulty = DifficultyHard
+	r.staleFreshWords()
+	if got, want := r.freshWordCount(), len(r.wordPool()); got != want {
+		t.Fatalf("freshWordCount after changing difficulty = %d, want %d", got, want)
+	}
+}
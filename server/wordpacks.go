@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+)
+
+// Word packs are JSON files of secret words. The built-in packs are
+// compiled in from data/wordpacks; WORD_PACKS_DIR adds more, or replaces a
+// built-in pack by reusing its id. Packs are validated as a set and can be
+// reloaded at runtime: a bad reload keeps the packs already in use. Each
+// pack is in one language; rooms play in one language at a time.
+
+//go:embed data/wordpacks/*.json
+var builtinWordPacks embed.FS
+
+// WordPack is one word pack file.
+type WordPack struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Language    string      `json:"language,omitempty"` // ISO 639 code; defaults to "en"
+	Words       []WordEntry `json:"words"`
+
+	source string // file the pack was loaded from
+}
+
+// WordPackInfo summarises a loaded pack for the admin API.
+type WordPackInfo struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description,omitempty"`
+	Language     string         `json:"language"`
+	Source       string         `json:"source"`
+	Words        int            `json:"words"`
+	ByDifficulty map[string]int `json:"byDifficulty"`
+}
+
+var (
+	wordBank     atomic.Pointer[WordBank]
+	wordPacksDir string     // set once at startup
+	reloadMu     sync.Mutex // serialises reloads
+)
+
+func init() {
+	bank, err := loadWordBank("")
+	if err != nil {
+		panic(err) // Built-in packs are static data; a bad one is a build mistake
+	}
+	wordBank.Store(bank)
+}
+
+// currentWordBank returns the word packs in use right now.
+func currentWordBank() *WordBank {
+	return wordBank.Load()
+}
+
+// reloadWordPacks rebuilds the bank from the built-in packs and dir and
+// swaps it in. On error the current bank stays in place.
+func reloadWordPacks(dir string) (*WordBank, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	bank, err := loadWordBank(dir)
+	if err != nil {
+		return nil, err
+	}
+	wordBank.Store(bank)
+	return bank, nil
+}
+
+// watchWordPacksSIGHUP reloads the word packs every time the process
+// receives SIGHUP.
+func watchWordPacksSIGHUP() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	go func() {
+		for range sigCh {
+			bank, err := reloadWordPacks(wordPacksDir)
+			if err != nil {
+				slog.Error("word pack reload failed, keeping previous", logKeyComponent, "words", "error", err)
+				continue
+			}
+			slog.Info("word packs reloaded", logKeyComponent, "words", "packs", len(bank.packs), "entries", bank.entryCount())
+		}
+	}()
+}
+
+// loadWordBank reads the built-in packs, then any *.json packs in dir.
+func loadWordBank(dir string) (*WordBank, error) {
+	packs, err := readWordPacks(builtinWordPacks, "data/wordpacks", "builtin:")
+	if err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		extra, err := readWordPacks(os.DirFS(dir), ".", dir+string(filepath.Separator))
+		if err != nil {
+			return nil, err
+		}
+		packs = mergeWordPacks(packs, extra)
+	}
+	return buildWordBank(packs)
+}
+
+func readWordPacks(fsys fs.FS, dir, label string) ([]*WordPack, error) {
+	names, err := fs.Glob(fsys, filepath.ToSlash(filepath.Join(dir, "*.json")))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	packs := make([]*WordPack, 0, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		pack, err := parseWordPack(data, label+filepath.Base(name))
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, pack)
+	}
+	return packs, nil
+}
+
+// mergeWordPacks adds extra packs to base; a pack reusing a base pack's id
+// replaces it. Extra packs sharing an id are left for buildWordBank to reject.
+func mergeWordPacks(base, extra []*WordPack) []*WordPack {
+	merged := append([]*WordPack(nil), base...)
+	for _, p := range extra {
+		replaced := false
+		for i, b := range base {
+			if b.ID == p.ID && merged[i] == b {
+				merged[i], replaced = p, true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, p)
+		}
+	}
+	return merged
+}
+
+// parseWordPack decodes one pack and checks each entry on its own.
+func parseWordPack(data []byte, source string) (*WordPack, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var pack WordPack
+	if err := dec.Decode(&pack); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	pack.source = source
+	pack.ID = strings.TrimSpace(pack.ID)
+	if pack.ID == "" {
+		return nil, fmt.Errorf("%s: pack id is required", source)
+	}
+	if pack.Name == "" {
+		pack.Name = pack.ID
+	}
+	if pack.Language == "" {
+		pack.Language = defaultLanguage
+	}
+	if !validLanguageCode(pack.Language) {
+		return nil, fmt.Errorf("%s: language %q is not a lowercase ISO 639 code", source, pack.Language)
+	}
+
+	var errs []error
+	for i := range pack.Words {
+		e := &pack.Words[i]
+		e.Word = normalizeText(e.Word)
+		e.pack = pack.ID
+		if err := checkWordEntry(e); err != nil {
+			errs = append(errs, fmt.Errorf("%s: word %d (%q): %w", source, i+1, e.Word, err))
+		}
+	}
+	return &pack, errors.Join(errs...)
+}
+
+func checkWordEntry(e *WordEntry) error {
+	if e.Word == "" {
+		return errors.New("word is empty")
+	}
+	switch e.Difficulty {
+	case DifficultyEasy, DifficultyMedium, DifficultyHard:
+	case DifficultyPhrase:
+		if len(phraseWords(e.Word)) < 2 {
+			return errors.New("PHRASE words need at least two words")
+		}
+	default:
+		return fmt.Errorf("difficulty %q is not EASY, MEDIUM, HARD or PHRASE", e.Difficulty)
+	}
+	facts, err := newWordFacts(e.Category, e.Size, e.Traits)
+	if err != nil {
+		return err
+	}
+	e.facts = facts
+	for i, a := range e.Aliases {
+		if e.Aliases[i] = normalizeText(a); e.Aliases[i] == "" {
+			return errors.New("empty alias")
+		}
+	}
+	return nil
+}
+
+func validLanguageCode(code string) bool {
+	if len(code) < 2 || len(code) > 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// buildWordBank indexes packs, rejecting words or aliases that appear more
+// than once in a language, and languages with too few words at a difficulty.
+// Phrases are optional, but a language that has any needs enough of them.
+func buildWordBank(packs []*WordPack) (*WordBank, error) {
+	bank := &WordBank{
+		packs:   packs,
+		pools:   make(map[string]map[string][]string),
+		entries: make(map[string]map[string]*WordEntry),
+	}
+	var errs []error
+	ids := make(map[string]string)
+	for _, pack := range packs {
+		if prev, ok := ids[pack.ID]; ok {
+			errs = append(errs, fmt.Errorf("%s: pack id %q is already used by %s", pack.source, pack.ID, prev))
+			continue
+		}
+		ids[pack.ID] = pack.source
+		lang := pack.Language
+		if bank.pools[lang] == nil {
+			bank.pools[lang] = make(map[string][]string)
+			bank.entries[lang] = make(map[string]*WordEntry)
+			bank.languages = append(bank.languages, lang)
+		}
+		for i := range pack.Words {
+			e := &pack.Words[i]
+			for _, name := range append([]string{e.Word}, e.Aliases...) {
+				key := foldWord(name)
+				if prev, ok := bank.entries[lang][key]; ok {
+					errs = append(errs, fmt.Errorf("%s: %q duplicates %q in pack %s", pack.source, name, prev.Word, prev.pack))
+					continue
+				}
+				bank.entries[lang][key] = e
+			}
+			bank.pools[lang][e.Difficulty] = append(bank.pools[lang][e.Difficulty], e.Word)
+		}
+	}
+	sort.Slice(bank.languages, func(i, j int) bool {
+		a, b := bank.languages[i], bank.languages[j]
+		if a == defaultLanguage || b == defaultLanguage {
+			return a == defaultLanguage
+		}
+		return a < b
+	})
+	if !bank.hasLanguage(defaultLanguage) {
+		errs = append(errs, fmt.Errorf("no %q word packs", defaultLanguage))
+	}
+	for _, lang := range bank.languages {
+		for _, d := range []string{DifficultyEasy, DifficultyMedium, DifficultyHard} {
+			if n := len(bank.pools[lang][d]); n < wordOptionCount {
+				errs = append(errs, fmt.Errorf("only %d %s words in %q packs, need at least %d", n, d, lang, wordOptionCount))
+			}
+		}
+		if n := len(bank.pools[lang][DifficultyPhrase]); n > 0 && n < wordOptionCount {
+			errs = append(errs, fmt.Errorf("only %d %s words in %q packs, need none or at least %d", n, DifficultyPhrase, lang, wordOptionCount))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return bank, nil
+}
+
+// packInfo summarises the bank's packs.
+func (b *WordBank) packInfo() []WordPackInfo {
+	infos := make([]WordPackInfo, 0, len(b.packs))
+	for _, p := range b.packs {
+		info := WordPackInfo{ID: p.ID, Name: p.Name, Description: p.Description, Language: p.Language, Source: p.source, Words: len(p.Words), ByDifficulty: make(map[string]int)}
+		for _, e := range p.Words {
+			info.ByDifficulty[e.Difficulty]++
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// validateWordPacksCommand implements "werewords-server validate-wordpacks
+// [dir]": it checks the built-in packs plus dir and reports the result.
+func validateWordPacksCommand(args []string) int {
+	dir := ""
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	bank, err := loadWordBank(dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	for _, info := range bank.packInfo() {
+		fmt.Printf("%-12s %-3s %4d words (easy %d, medium %d, hard %d, phrase %d)  %s\n", info.ID, info.Language, info.Words,
+			info.ByDifficulty[DifficultyEasy], info.ByDifficulty[DifficultyMedium], info.ByDifficulty[DifficultyHard],
+			info.ByDifficulty[DifficultyPhrase], info.Source)
+	}
+	return 0
+}
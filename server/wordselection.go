@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// The Mayor picks the secret word from a handful of options. A room sets
+// how many, whether they're drawn across all difficulties, how many times
+// per game the Mayor may throw them back for a fresh set, and whether the
+// Mayor may choose a word of their own instead (see dictionary.go).
+
+const (
+	minWordOptions    = 2
+	maxWordOptions    = 8
+	maxRerolls        = 3
+	defaultRerolls    = 1
+	wordSelectionTime = 30 // seconds to choose
+	rerollMinTime     = 15 // seconds left on the clock after a reroll
+)
+
+// WordOption is one word offered to the Mayor. Difficulty is empty for
+// the room's custom words.
+type WordOption struct {
+	Word       string `json:"word"`
+	Difficulty string `json:"difficulty,omitempty"`
+}
+
+func (r *Room) handleSetWordOptions(c *Client, payload SetWordOptionsPayload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseLobby {
+		c.sendError("Word options can only be changed in the lobby")
+		return
+	}
+	if payload.Count < minWordOptions || payload.Count > maxWordOptions {
+		c.sendError(fmt.Sprintf("The Pack Leader can choose from %d to %d words", minWordOptions, maxWordOptions))
+		return
+	}
+	if payload.Rerolls < 0 || payload.Rerolls > maxRerolls {
+		c.sendError(fmt.Sprintf("Rerolls must be between 0 and %d", maxRerolls))
+		return
+	}
+	r.optionCount = payload.Count
+	r.rerolls = payload.Rerolls
+	r.mixedDifficulty = payload.MixedDifficulty
+	r.freeChoice = payload.FreeChoice
+	r.staleFreshWords()
+	r.broadcastState()
+}
+
+func (r *Room) handleRerollWords(c *Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase != PhaseWordSelection || r.secretWord != "" {
+		c.sendError("Can only reroll while choosing the word")
+		return
+	}
+	player := r.players[c.playerID]
+	if player == nil || !player.IsMayor {
+		c.sendError("Only the Pack Leader can reroll the words")
+		return
+	}
+	if r.rerollsUsed >= r.rerolls {
+		c.sendError("No rerolls left")
+		return
+	}
+
+	r.rerollsUsed++
+	r.offerWords()
+	if r.timeRemaining < rerollMinTime {
+		r.timeRemaining = rerollMinTime
+	}
+	r.logger().Info("word options rerolled", logKeyPlayer, c.playerID, "rerollsLeft", r.rerolls-r.rerollsUsed)
+	r.broadcastState()
+}
+
+// offerWords draws a new set of options for the Mayor.
+// Must be called with lock held.
+func (r *Room) offerWords() {
+	r.wordChoices = r.drawWordOptions()
+	r.wordOptions = make([]string, len(r.wordChoices))
+	for i, o := range r.wordChoices {
+		r.wordOptions[i] = o.Word
+	}
+}
+
+// drawWordOptions picks the words offered to the Mayor, preferring words
+// the room hasn't seen yet. A mix offers up to half custom words, so a short
+// custom list isn't drowned out by the packs.
+// Must be called with lock held.
+func (r *Room) drawWordOptions() []WordOption {
+	n := r.optionCount
+	options := make([]WordOption, 0, n)
+	switch r.wordSource {
+	case WordSourceCustom:
+		for _, w := range r.pickFresh(r.customWords, n) {
+			options = append(options, WordOption{Word: w})
+		}
+	case WordSourceMix:
+		custom := r.pickFresh(r.customWords, (n+1)/2)
+		for _, w := range custom {
+			options = append(options, WordOption{Word: w})
+		}
+		options = append(options, r.drawPackOptions(n-len(options), custom)...)
+	default:
+		options = r.drawPackOptions(n, nil)
+	}
+	rand.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+	return options
+}
+
+// drawPackOptions picks n words from the word packs, leaving out exclude.
+// With mixed difficulty the words are spread evenly across difficulties.
+// Must be called with lock held.
+func (r *Room) drawPackOptions(n int, exclude []string) []WordOption {
+	if r.difficulty == DifficultyAdaptive && !r.mixedDifficulty {
+		return r.drawAdaptiveOptions(n, exclude)
+	}
+	difficulties := []string{r.difficulty}
+	if r.mixedDifficulty {
+		difficulties = []string{DifficultyEasy, DifficultyMedium, DifficultyHard}
+		rand.Shuffle(len(difficulties), func(i, j int) { difficulties[i], difficulties[j] = difficulties[j], difficulties[i] })
+	}
+
+	options := make([]WordOption, 0, n)
+	for i, d := range difficulties {
+		share := n / len(difficulties)
+		if i < n%len(difficulties) {
+			share++
+		}
+		pool := make([]string, 0)
+		for _, w := range r.words.pool(r.language, d) {
+			if !containsFold(exclude, w) {
+				pool = append(pool, w)
+			}
+		}
+		for _, w := range r.pickFresh(pool, share) {
+			options = append(options, WordOption{Word: w, Difficulty: d})
+		}
+	}
+	return options
+}
+
+// packPool returns the word pack words this room's games draw from.
+// Must be called with lock held.
+func (r *Room) packPool() []string {
+	if !r.mixedDifficulty && r.difficulty != DifficultyAdaptive {
+		return r.words.pool(r.language, r.difficulty)
+	}
+	pool := make([]string, 0)
+	for _, d := range []string{DifficultyEasy, DifficultyMedium, DifficultyHard} {
+		pool = append(pool, r.words.pool(r.language, d)...)
+	}
+	return pool
+}
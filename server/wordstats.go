@@ -0,0 +1,396 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Every game played with a word pack word adds to that word's stats:
+// whether the village guessed it, and if so how many tokens the Mayor spent
+// and how much time was left, and which side won the game. The stats give
+// each word an empirical difficulty score for the admin API and a village
+// win rate, which rooms on ADAPTIVE difficulty use to pick words that keep
+// the village winning about as often as ADAPTIVE_TARGET_WIN_RATE.
+// Stats live in memory and, with WORD_STATS_FILE set, are loaded at startup
+// and saved every wordStatsSaveInterval and at shutdown.
+
+const (
+	wordStatsSaveInterval = 1 * time.Minute
+	statsPriorPlays       = 3 // plays the curated tier's rates count as
+	adaptiveWindow        = 5 // recent games an ADAPTIVE room steers by
+	adaptivePriorGames    = 2 // games at the target rate mixed into the recent ones
+	adaptiveCandidates    = 15
+)
+
+// tierGuessRate is how often we expect a word of each curated tier to be
+// guessed before it has a record of its own.
+var tierGuessRate = map[string]float64{
+	DifficultyEasy:   0.8,
+	DifficultyMedium: 0.6,
+	DifficultyHard:   0.4,
+	DifficultyPhrase: 0.5,
+}
+
+// tierWinRate is how often we expect the village to win with a word of each
+// curated tier before it has a record of its own. The village can guess the
+// word and still lose to the werewolves finding the Seer, so these sit below
+// the guess rates.
+var tierWinRate = map[string]float64{
+	DifficultyEasy:   0.65,
+	DifficultyMedium: 0.5,
+	DifficultyHard:   0.35,
+	DifficultyPhrase: 0.45,
+}
+
+// adaptiveTargetWinRate is set once at startup from ADAPTIVE_TARGET_WIN_RATE.
+var adaptiveTargetWinRate = 0.5
+
+// WordStats is the record of one word in one language. Tokens and TimeLeft
+// are totals over the plays in which the word was guessed. Games counts the
+// plays whose winner was recorded, which stats saved before winners were
+// kept don't have.
+type WordStats struct {
+	Word        string `json:"word"`
+	Language    string `json:"language"`
+	Difficulty  string `json:"difficulty"` // curated tier, from the word pack
+	Plays       int    `json:"plays"`
+	Guessed     int    `json:"guessed"`
+	Tokens      int    `json:"tokens"`
+	TimeLeft    int    `json:"timeLeft"` // seconds
+	Games       int    `json:"games"`
+	VillageWins int    `json:"villageWins"`
+	LastPlayed  int64  `json:"lastPlayed,omitempty"`
+
+	// Filled in for the admin API; the stats file keeps them at zero
+	GuessRate float64 `json:"guessRate"`
+	WinRate   float64 `json:"winRate"`
+	Score     float64 `json:"score"`
+}
+
+// guessRate is the share of plays in which the word was guessed, smoothed
+// toward its tier's rate so a word's first few games don't swing it.
+func (s WordStats) guessRate() float64 {
+	prior, ok := tierGuessRate[s.Difficulty]
+	if !ok {
+		prior = tierGuessRate[DifficultyMedium]
+	}
+	return (float64(s.Guessed) + statsPriorPlays*prior) / (float64(s.Plays) + statsPriorPlays)
+}
+
+// winRate is the share of games with the word that the village won,
+// smoothed toward its tier's rate like guessRate.
+func (s WordStats) winRate() float64 {
+	prior, ok := tierWinRate[s.Difficulty]
+	if !ok {
+		prior = tierWinRate[DifficultyMedium]
+	}
+	return (float64(s.VillageWins) + statsPriorPlays*prior) / (float64(s.Games) + statsPriorPlays)
+}
+
+// score is the word's empirical difficulty, from 0 (always guessed at once)
+// to 1 (never guessed): its miss rate, with guesses that took the whole day
+// and more tokens than usual (meanTokens) counting as half a miss.
+func (s WordStats) score(meanTokens float64) float64 {
+	effort := 0.5
+	if s.Guessed > 0 {
+		timeUsed := 1 - float64(s.TimeLeft)/float64(s.Guessed*initialTime)
+		tokens := float64(s.Tokens) / float64(s.Guessed)
+		tokenShare := 0.5
+		if tokens+meanTokens > 0 {
+			tokenShare = tokens / (tokens + meanTokens)
+		}
+		effort = (timeUsed + tokenShare) / 2
+	}
+	g := s.guessRate()
+	return (1 - g) + g*effort/2
+}
+
+type wordStatsStore struct {
+	mu     sync.Mutex
+	words  map[string]*WordStats // language + ":" + folded word
+	file   string
+	dirty  bool
+	saveMu sync.Mutex // serialises writes to file
+}
+
+var wordStats = &wordStatsStore{words: make(map[string]*WordStats)}
+
+func wordStatsKey(language, word string) string {
+	return language + ":" + foldWord(word)
+}
+
+// entry returns the record of a word, adding it if it's new.
+// Must be called with s.mu held.
+func (s *wordStatsStore) entry(e *WordEntry, language string) *WordStats {
+	key := wordStatsKey(language, e.Word)
+	ws := s.words[key]
+	if ws == nil {
+		ws = &WordStats{Word: e.Word, Language: language}
+		s.words[key] = ws
+	}
+	ws.Difficulty = e.Difficulty
+	return ws
+}
+
+// record adds one play of a word.
+func (s *wordStatsStore) record(e *WordEntry, language string, guessed bool, tokens, timeLeft int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws := s.entry(e, language)
+	ws.Plays++
+	if guessed {
+		ws.Guessed++
+		ws.Tokens += tokens
+		ws.TimeLeft += timeLeft
+	}
+	ws.LastPlayed = time.Now().UnixMilli()
+	s.dirty = true
+}
+
+// recordGame adds the winner of a game played with a word.
+func (s *wordStatsStore) recordGame(e *WordEntry, language string, villageWon bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws := s.entry(e, language)
+	ws.Games++
+	if villageWon {
+		ws.VillageWins++
+	}
+	s.dirty = true
+}
+
+// meanTokens is the average tokens a guessed word took in a language.
+// Must be called with s.mu held.
+func (s *wordStatsStore) meanTokens(language string) float64 {
+	tokens, guessed := 0, 0
+	for _, ws := range s.words {
+		if ws.Language == language {
+			tokens += ws.Tokens
+			guessed += ws.Guessed
+		}
+	}
+	if guessed == 0 {
+		return 0
+	}
+	return float64(tokens) / float64(guessed)
+}
+
+// winRates returns the village win rate of every word in entries, keyed by
+// word. Words without a record are rated from their tier alone.
+func (s *wordStatsStore) winRates(language string, entries []*WordEntry) map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rates := make(map[string]float64, len(entries))
+	for _, e := range entries {
+		ws := WordStats{Difficulty: e.Difficulty}
+		if rec := s.words[wordStatsKey(language, e.Word)]; rec != nil {
+			ws = *rec
+			ws.Difficulty = e.Difficulty
+		}
+		rates[e.Word] = ws.winRate()
+	}
+	return rates
+}
+
+// list returns the stats for a language ("" for all), hardest first.
+func (s *wordStatsStore) list(language string) []WordStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	means := make(map[string]float64)
+	list := make([]WordStats, 0, len(s.words))
+	for _, ws := range s.words {
+		if language != "" && ws.Language != language {
+			continue
+		}
+		mean, ok := means[ws.Language]
+		if !ok {
+			mean = s.meanTokens(ws.Language)
+			means[ws.Language] = mean
+		}
+		out := *ws
+		out.GuessRate = round2(ws.guessRate())
+		out.WinRate = round2(ws.winRate())
+		out.Score = round2(ws.score(mean))
+		list = append(list, out)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score > list[j].Score
+		}
+		return list[i].Word < list[j].Word
+	})
+	return list
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// load reads saved stats from path, which need not exist yet, and remembers
+// it for save.
+func (s *wordStatsStore) load(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.file = path
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var saved []*WordStats
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return err
+	}
+	for _, ws := range saved {
+		ws.GuessRate, ws.WinRate, ws.Score = 0, 0, 0
+		s.words[wordStatsKey(ws.Language, ws.Word)] = ws
+	}
+	return nil
+}
+
+// save writes the stats to the file they were loaded from, if they've
+// changed. It's a no-op without WORD_STATS_FILE.
+func (s *wordStatsStore) save() {
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+	s.mu.Lock()
+	if s.file == "" || !s.dirty {
+		s.mu.Unlock()
+		return
+	}
+	saved := make([]WordStats, 0, len(s.words))
+	for _, ws := range s.words {
+		saved = append(saved, *ws)
+	}
+	s.dirty = false
+	path := s.file
+	s.mu.Unlock()
+
+	sort.Slice(saved, func(i, j int) bool {
+		if saved[i].Language != saved[j].Language {
+			return saved[i].Language < saved[j].Language
+		}
+		return saved[i].Word < saved[j].Word
+	})
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err == nil {
+		// Write then rename, so a crash mid-write keeps the old file
+		tmp := path + ".tmp"
+		if err = os.WriteFile(tmp, data, 0o600); err == nil {
+			err = os.Rename(tmp, path)
+		}
+	}
+	if err != nil {
+		slog.Error("saving word stats", logKeyComponent, "words", "file", path, "error", err)
+		s.mu.Lock()
+		s.dirty = true
+		s.mu.Unlock()
+	}
+}
+
+// watchWordStats saves the stats periodically.
+func watchWordStats() {
+	go func() {
+		for range time.Tick(wordStatsSaveInterval) {
+			wordStats.save()
+		}
+	}()
+}
+
+// recordWordPlay adds the game's secret word to the stats as the day ends.
+// Words from outside the word packs aren't tracked.
+// Must be called with lock held.
+func (r *Room) recordWordPlay(guessed bool) {
+	e, ok := r.words.lookupIn(r.language, r.secretWord)
+	if !ok {
+		return
+	}
+	timeLeft := 0
+	if guessed {
+		timeLeft = r.timeRemaining
+	}
+	wordStats.record(e, r.language, guessed, r.tokensUsed, timeLeft)
+}
+
+// recordResult remembers whether the village won, for ADAPTIVE difficulty,
+// and adds the result to the secret word's stats.
+// Must be called with lock held.
+func (r *Room) recordResult(winner string) {
+	villageWon := winner == WinnerVillage
+	r.recentWins = append(r.recentWins, villageWon)
+	if len(r.recentWins) > adaptiveWindow {
+		r.recentWins = r.recentWins[len(r.recentWins)-adaptiveWindow:]
+	}
+	if e, ok := r.words.lookupIn(r.language, r.secretWord); ok {
+		wordStats.recordGame(e, r.language, villageWon)
+	}
+}
+
+// adaptiveWinRate is the word win rate an ADAPTIVE room aims for: the
+// target win rate, pushed past it by however far the room's recent games
+// have strayed from it. A couple of games at the target rate are counted in
+// so one result doesn't swing it to an extreme.
+// Must be called with lock held.
+func (r *Room) adaptiveWinRate() float64 {
+	wins := adaptivePriorGames * adaptiveTargetWinRate
+	for _, won := range r.recentWins {
+		if won {
+			wins++
+		}
+	}
+	rate := wins / float64(len(r.recentWins)+adaptivePriorGames)
+	return min(max(2*adaptiveTargetWinRate-rate, 0.1), 0.9)
+}
+
+// drawAdaptiveOptions picks n pack words, leaving out exclude, from those
+// whose win rates are nearest the room's adaptiveWinRate.
+// Must be called with lock held.
+func (r *Room) drawAdaptiveOptions(n int, exclude []string) []WordOption {
+	entries := make([]*WordEntry, 0)
+	for _, d := range []string{DifficultyEasy, DifficultyMedium, DifficultyHard} {
+		for _, w := range r.words.pool(r.language, d) {
+			if containsFold(exclude, w) {
+				continue
+			}
+			if e, ok := r.words.lookupIn(r.language, w); ok {
+				entries = append(entries, e)
+			}
+		}
+	}
+	rates := wordStats.winRates(r.language, entries)
+	target := r.adaptiveWinRate()
+	// Shuffle first so words with equal rates, such as unplayed words of
+	// one tier, take turns
+	rand.Shuffle(len(entries), func(i, j int) { entries[i], entries[j] = entries[j], entries[i] })
+	sort.SliceStable(entries, func(i, j int) bool {
+		return math.Abs(rates[entries[i].Word]-target) < math.Abs(rates[entries[j].Word]-target)
+	})
+
+	candidates := make([]string, 0, adaptiveCandidates)
+	tiers := make(map[string]string)
+	for _, e := range entries[:min(len(entries), max(adaptiveCandidates, 3*n))] {
+		candidates = append(candidates, e.Word)
+		tiers[e.Word] = e.Difficulty
+	}
+	options := make([]WordOption, 0, n)
+	for _, w := range r.pickFresh(candidates, n) {
+		options = append(options, WordOption{Word: w, Difficulty: tiers[w]})
+	}
+	r.logger().Debug("adaptive word options", "target", round2(target), "candidates", len(candidates))
+	return options
+}
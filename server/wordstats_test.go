@@ -0,0 +1,43 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWordStatsWinRate(t *testing.T) {
+	s := &wordStatsStore{words: make(map[string]*WordStats)}
+	e := &WordEntry{Word: "Penguin", Difficulty: DifficultyEasy}
+
+	rates := s.winRates("en", []*WordEntry{e})
+	if got := rates["Penguin"]; got != tierWinRate[DifficultyEasy] {
+		t.Fatalf("win rate of an unplayed word = %v, want its tier's %v", got, tierWinRate[DifficultyEasy])
+	}
+
+	// Guessed every time but the village lost every game: the win rate
+	// follows the results, not the guesses
+	for range 6 {
+		s.record(e, "en", true, 3, 100)
+		s.recordGame(e, "en", false)
+	}
+	want := statsPriorPlays * tierWinRate[DifficultyEasy] / (6 + statsPriorPlays)
+	if got := s.winRates("en", []*WordEntry{e})["Penguin"]; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("win rate after six losses = %v, want %v", got, want)
+	}
+}
+
+func TestAdaptiveWinRateLeansAgainstRecentResults(t *testing.T) {
+	r := newRoom("ROOM", newTestHub(t))
+	if got := r.adaptiveWinRate(); math.Abs(got-adaptiveTargetWinRate) > 1e-9 {
+		t.Fatalf("adaptiveWinRate with no games = %v, want the target %v", got, adaptiveTargetWinRate)
+	}
+
+	r.recentWins = []bool{true, true, true}
+	if got := r.adaptiveWinRate(); got >= adaptiveTargetWinRate {
+		t.Fatalf("adaptiveWinRate after village wins = %v, want below the target", got)
+	}
+	r.recentWins = []bool{false, false, false}
+	if got := r.adaptiveWinRate(); got <= adaptiveTargetWinRate {
+		t.Fatalf("adaptiveWinRate after village losses = %v, want above the target", got)
+	}
+}
@@ -1,4 +1,4 @@
-import { GameService, GameState, ClientMessage, ServerMessage, GamePhase, TokenType, RoomInfo, Difficulty, ReactionEvent } from '../types';
+import { GameService, GameState, ClientMessage, ServerMessage, GamePhase, TokenType, RoomInfo, Difficulty, ReactionEvent, BotSkill, BotSettings, SeatInfo, WordSource, CustomWordsResult, HintType, ServerNotice } from '../types';
 
 const getWsUrl = (): string => {
   if (typeof window !== 'undefined') {
@@ -8,15 +8,24 @@ const getWsUrl = (): string => {
   return 'ws://localhost:8080/ws';
 };
 
-const WS_URL = getWsUrl();
+const DEFAULT_WS_URL = getWsUrl();
+const SEAT_KEY = 'werewords.seat';
+const RECONNECT_DELAY_MS = 2000;
 
 class LiveGameService implements GameService {
   private socket: WebSocket | null = null;
   private listeners: Set<(state: GameState) => void> = new Set();
   private roomListListeners: Set<(rooms: RoomInfo[]) => void> = new Set();
   private reactionListeners: Set<(reaction: ReactionEvent) => void> = new Set();
+  private customWordsListeners: Set<(result: CustomWordsResult) => void> = new Set();
+  private noticeListeners: Set<(notice: ServerNotice | null) => void> = new Set();
+  private notice: ServerNotice | null = null;
   private state: GameState;
   private onConnectCallbacks: (() => void)[] = [];
+  private playerName = '';
+  private avatarUrl: string | undefined;
+  // In a multi-node deployment the server may REDIRECT us to the node hosting our room
+  private wsUrl = DEFAULT_WS_URL;
 
   constructor() {
     this.state = {
@@ -40,11 +49,13 @@ class LiveGameService implements GameService {
       return;
     }
 
-    console.log(`Connecting to WebSocket at ${WS_URL}...`);
-    this.socket = new WebSocket(WS_URL);
+    console.log(`Connecting to WebSocket at ${this.wsUrl}...`);
+    const socket = new WebSocket(this.wsUrl);
+    this.socket = socket;
 
     this.socket.onopen = () => {
       console.log('Connected to Game Server');
+      this.reclaimSeat();
       this.onConnectCallbacks.forEach(cb => cb());
       this.onConnectCallbacks = [];
     };
@@ -59,7 +70,12 @@ class LiveGameService implements GameService {
     };
 
     this.socket.onclose = () => {
+      if (this.socket !== socket) return; // Replaced after a REDIRECT
       console.log('Disconnected from Game Server');
+      // A bot keeps our seat warm; reconnect and take it back
+      if (this.loadSeat()) {
+        setTimeout(() => this.connect(), RECONNECT_DELAY_MS);
+      }
     };
 
     this.socket.onerror = (error) => {
@@ -68,6 +84,8 @@ class LiveGameService implements GameService {
   }
 
   private sendMessage(message: ClientMessage) {
+    // Anything we send counts as activity, so the room is no longer expiring
+    if (this.notice?.kind === 'ROOM_EXPIRING') this.setNotice(null);
     if (this.socket && this.socket.readyState === WebSocket.OPEN) {
       this.socket.send(JSON.stringify(message));
     } else {
@@ -91,9 +109,26 @@ class LiveGameService implements GameService {
     } else if (message.type === 'ROOM_LIST') {
       const rooms = (message.payload as { rooms: RoomInfo[] }).rooms;
       this.roomListListeners.forEach(l => l(rooms));
+    } else if (message.type === 'JOINED') {
+      sessionStorage.setItem(SEAT_KEY, JSON.stringify(message.payload));
+    } else if (message.type === 'KICKED' || message.type === 'ROOM_CLOSED' || message.type === 'REJOIN_FAILED') {
+      sessionStorage.removeItem(SEAT_KEY);
+      if (message.type !== 'REJOIN_FAILED') {
+        this.setNotice({ kind: message.type, message: message.payload.message, receivedAt: Date.now() });
+      }
+    } else if (message.type === 'SERVER_SHUTDOWN' || message.type === 'ROOM_EXPIRING') {
+      const { secondsRemaining, message: text } = message.payload;
+      this.setNotice({ kind: message.type, message: text, secondsRemaining, receivedAt: Date.now() });
+    } else if (message.type === 'ANNOUNCEMENT') {
+      this.setNotice({ kind: 'ANNOUNCEMENT', message: message.payload.message, receivedAt: Date.now() });
+    } else if (message.type === 'REDIRECT') {
+      this.redirect(message.payload.url, message.payload.roomCode);
     } else if (message.type === 'REACTION') {
       const reaction = message.payload as ReactionEvent;
       this.reactionListeners.forEach(l => l(reaction));
+    } else if (message.type === 'CUSTOM_WORDS') {
+      const result = message.payload as CustomWordsResult;
+      this.customWordsListeners.forEach(l => l(result));
     }
   }
 
@@ -108,7 +143,53 @@ class LiveGameService implements GameService {
     this.listeners.forEach(l => l(this.state));
   }
 
+  private setNotice(notice: ServerNotice | null) {
+    this.notice = notice;
+    this.noticeListeners.forEach(l => l(notice));
+  }
+
+  // Moves to the node hosting the room and joins it there. A saved seat is
+  // reclaimed on connect; otherwise the join we were making is sent again
+  private redirect(url: string, roomCode: string) {
+    console.log(`Room ${roomCode} is hosted at ${url}, reconnecting...`);
+    const old = this.socket;
+    this.wsUrl = url;
+    this.socket = null;
+    old?.close();
+    if (!this.loadSeat()) {
+      this.onConnectCallbacks.push(() => {
+        this.socket?.send(JSON.stringify({
+          type: 'JOIN_GAME',
+          payload: { name: this.playerName || 'Player', roomCode, avatarUrl: this.avatarUrl },
+        } satisfies ClientMessage));
+      });
+    }
+    this.connect();
+  }
+
+  private loadSeat(): SeatInfo | null {
+    try {
+      const raw = sessionStorage.getItem(SEAT_KEY);
+      return raw ? JSON.parse(raw) as SeatInfo : null;
+    } catch {
+      return null;
+    }
+  }
+
+  // Reclaims the seat saved from an earlier connection, if there is one
+  private reclaimSeat() {
+    const seat = this.loadSeat();
+    if (!seat || this.socket?.readyState !== WebSocket.OPEN) return;
+    this.socket.send(JSON.stringify({
+      type: 'JOIN_GAME',
+      payload: { name: this.playerName || 'Player', roomCode: seat.roomCode, playerId: seat.playerId, rejoinToken: seat.rejoinToken },
+    } satisfies ClientMessage));
+  }
+
   joinGame(name: string, roomCode?: string, avatarUrl?: string) {
+    this.playerName = name;
+    this.avatarUrl = avatarUrl;
+    sessionStorage.removeItem(SEAT_KEY);
     this.sendMessage({ type: 'JOIN_GAME', payload: { name, roomCode, avatarUrl } });
   }
 
@@ -148,22 +229,64 @@ class LiveGameService implements GameService {
     this.sendMessage({ type: 'LIST_ROOMS' });
   }
 
-  addBot() {
-    this.sendMessage({ type: 'ADD_BOT' });
+  addBot(skill?: BotSkill, personality?: string) {
+    this.sendMessage(skill || personality ? { type: 'ADD_BOT', payload: { skill, personality } } : { type: 'ADD_BOT' });
+  }
+
+  removeBot(botId: string) {
+    this.sendMessage({ type: 'REMOVE_BOT', payload: { botId } });
+  }
+
+  configureBot(botId: string, settings: BotSettings) {
+    this.sendMessage({ type: 'CONFIGURE_BOT', payload: { botId, ...settings } });
+  }
+
+  setAutoFillBots(enabled: boolean) {
+    this.sendMessage({ type: 'AUTO_FILL_BOTS', payload: { enabled } });
   }
 
   sendReaction(emoji: string) {
     this.sendMessage({ type: 'SEND_REACTION', payload: { emoji } });
   }
 
-  revealHint() {
-    this.sendMessage({ type: 'REVEAL_HINT' });
+  revealHint(type?: HintType) {
+    this.sendMessage(type ? { type: 'REVEAL_HINT', payload: { type } } : { type: 'REVEAL_HINT' });
   }
 
   setDifficulty(difficulty: Difficulty) {
     this.sendMessage({ type: 'SET_DIFFICULTY', payload: { difficulty } });
   }
 
+  setLanguage(language: string) {
+    this.sendMessage({ type: 'SET_LANGUAGE', payload: { language } });
+  }
+
+  setCustomWords(text: string) {
+    this.sendMessage({ type: 'SET_CUSTOM_WORDS', payload: { text } });
+  }
+
+  // Reads a plain-text word list (one word per line, or comma-separated)
+  async uploadCustomWords(file: File) {
+    this.setCustomWords(await file.text());
+  }
+
+  setWordSource(source: WordSource) {
+    this.sendMessage({ type: 'SET_WORD_SOURCE', payload: { source } });
+  }
+
+  setWordOptions(count: number, rerolls: number, mixedDifficulty: boolean, freeChoice = false) {
+    this.sendMessage({ type: 'SET_WORD_OPTIONS', payload: { count, rerolls, mixedDifficulty, freeChoice } });
+  }
+
+  rerollWords() {
+    this.sendMessage({ type: 'REROLL_WORDS' });
+  }
+
+  onCustomWords(listener: (result: CustomWordsResult) => void) {
+    this.customWordsListeners.add(listener);
+    return () => this.customWordsListeners.delete(listener);
+  }
+
   onRoomList(listener: (rooms: RoomInfo[]) => void) {
     this.roomListListeners.add(listener);
     return () => this.roomListListeners.delete(listener);
@@ -173,6 +296,11 @@ class LiveGameService implements GameService {
     this.reactionListeners.add(listener);
     return () => this.reactionListeners.delete(listener);
   }
+
+  onNotice(listener: (notice: ServerNotice | null) => void) {
+    this.noticeListeners.add(listener);
+    return () => this.noticeListeners.delete(listener);
+  }
 }
 
 export const liveGameService = new LiveGameService();
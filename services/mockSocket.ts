@@ -49,14 +49,25 @@ class MockGameService implements GameService {
 
   listRooms() { /* no-op in mock */ }
   addBot() { /* no-op in mock */ }
+  removeBot(_botId: string) { /* no-op in mock */ }
+  configureBot(_botId: string, _settings: import('../types').BotSettings) { /* no-op in mock */ }
+  setAutoFillBots(_enabled: boolean) { /* no-op in mock */ }
   submitGuess(_text: string) { /* no-op in mock */ }
   chooseWord(_word: string) { /* no-op in mock */ }
   toggleWantsMayor() { /* no-op in mock */ }
   onRoomList(_listener: (rooms: RoomInfo[]) => void) { return () => {}; }
   sendReaction(_emoji: string) { /* no-op in mock */ }
-  revealHint() { /* no-op in mock */ }
+  revealHint(_type?: import('../types').HintType) { /* no-op in mock */ }
   setDifficulty(_difficulty: import('../types').Difficulty) { /* no-op in mock */ }
+  setLanguage(_language: string) { /* no-op in mock */ }
+  setCustomWords(_text: string) { /* no-op in mock */ }
+  async uploadCustomWords(_file: File) { /* no-op in mock */ }
+  setWordSource(_source: import('../types').WordSource) { /* no-op in mock */ }
+  setWordOptions(_count: number, _rerolls: number, _mixedDifficulty: boolean, _freeChoice?: boolean) { /* no-op in mock */ }
+  rerollWords() { /* no-op in mock */ }
+  onCustomWords(_listener: (result: import('../types').CustomWordsResult) => void) { return () => {}; }
   onReaction(_listener: (reaction: import('../types').ReactionEvent) => void) { return () => {}; }
+  onNotice(_listener: (notice: import('../types').ServerNotice | null) => void) { return () => {}; }
 
   joinGame(name: string, _roomCode?: string, _avatarUrl?: string) {
     const newPlayerId = uuidv4();
@@ -26,6 +26,9 @@ export interface Player {
   avatarUrl?: string;
   votesReceived?: number;
   isBot?: boolean;
+  botSkill?: BotSkill;
+  botPersonality?: string; // id from server/data/personalities.json
+  disconnected?: boolean; // a bot is playing this seat until the player rejoins
   score: number;
   achievements?: string[];
 }
@@ -50,9 +53,69 @@ export interface GuessEntry {
   playerId: string;
   text: string;
   timestamp: number;
+  suggestedToken?: TokenType; // SO_CLOSE for a near miss; sent to the Mayor only
 }
 
-export type Difficulty = 'EASY' | 'MEDIUM' | 'HARD';
+export type Difficulty = 'EASY' | 'MEDIUM' | 'HARD' | 'PHRASE' | 'ADAPTIVE'; // PHRASE: multi-word phrases; ADAPTIVE: picked from play stats
+
+export type HintType = 'LETTER' | 'FIRST_LETTER' | 'LENGTH' | 'SYLLABLES' | 'CATEGORY' | 'CLUE';
+
+// A hint the Mayor can still buy, and what it costs from their score
+export interface HintOption {
+  type: HintType;
+  cost: number;
+  remaining: number;
+}
+
+// A non-token event in the game, such as a revealed hint
+export interface TimelineEvent {
+  id: string;
+  type: 'HINT';
+  timestamp: number;
+  hintType?: HintType;
+  text?: string;
+  cost?: number;
+}
+
+// A language rooms can play in, e.g. { code: 'es', name: 'Español' }
+export interface LanguageInfo {
+  code: string;
+  name: string;
+}
+
+// Where secret words come from: the built-in packs, the room's own list, or both
+export type WordSource = 'BUILTIN' | 'CUSTOM' | 'MIX';
+
+// The result of setting a custom word list, sent to whoever set it
+export interface CustomWordsResult {
+  words: string[];
+  duplicates: number;
+  rejected?: string[];
+  truncated?: boolean;
+}
+
+// A message from the server to show the player. Countdowns (shutdown, idle
+// room expiry) carry the seconds left when the notice was received
+export interface ServerNotice {
+  kind: 'SERVER_SHUTDOWN' | 'ROOM_EXPIRING' | 'ANNOUNCEMENT' | 'KICKED' | 'ROOM_CLOSED';
+  message: string;
+  secondsRemaining?: number;
+  receivedAt: number;
+}
+
+// A word offered to the Mayor; difficulty is missing for the room's custom words
+export interface WordOption {
+  word: string;
+  difficulty?: Difficulty;
+}
+
+export type BotSkill = 'EASY' | 'NORMAL' | 'HARD';
+
+export interface BotSettings {
+  name?: string;
+  skill?: BotSkill;
+  personality?: string;
+}
 
 export interface GameState {
   phase: GamePhase;
@@ -61,6 +124,8 @@ export interface GameState {
   secretWord: string;
   secretWordHints?: string;
   wordOptions?: string[];
+  wordChoices?: WordOption[]; // wordOptions with difficulty tags (Mayor only)
+  rerollsLeft?: number; // Mayor only, while choosing
   timeRemaining: number;
   tokensUsed: number;
   tokenHistory: TokenAction[];
@@ -69,15 +134,39 @@ export interface GameState {
   myPlayerId: string | null;
   difficulty: Difficulty;
   hintsRevealed: number;
+  hintOptions?: HintOption[];
+  timeline?: TimelineEvent[];
   numWerewolves: number;
+  autoFillBots?: boolean;
+  language?: string;
+  languages?: LanguageInfo[];
+  wordSource?: WordSource;
+  customWordCount?: number;
+  freshWords?: number; // words left before the Mayor's options start repeating
+  wordOptionCount?: number;
+  rerolls?: number;
+  mixedDifficulty?: boolean;
+  freeChoice?: boolean; // the Mayor may choose a word of their own
+}
+
+// A player's seat in a room, kept so it can be reclaimed after a disconnect.
+export interface SeatInfo {
+  roomCode: string;
+  playerId: string;
+  rejoinToken: string;
 }
 
 export interface RoomInfo {
   code: string;
   playerCount: number;
   maxPlayers: number;
+  freeSeats: number;
   phase: string;
+  joinable: boolean;
+  difficulty?: Difficulty;
+  language?: string;
   playerNames: string[];
+  createdAt?: number;
 }
 
 export interface ReactionEvent {
@@ -99,17 +188,28 @@ export interface GameService {
   vote(targetId: string): void;
   resetGame(): void;
   listRooms(): void;
-  addBot(): void;
+  addBot(skill?: BotSkill, personality?: string): void;
+  removeBot(botId: string): void;
+  configureBot(botId: string, settings: BotSettings): void;
+  setAutoFillBots(enabled: boolean): void;
   sendReaction(emoji: string): void;
-  revealHint(): void;
+  revealHint(type?: HintType): void;
   setDifficulty(difficulty: Difficulty): void;
+  setLanguage(language: string): void;
+  setCustomWords(text: string): void;
+  uploadCustomWords(file: File): Promise<void>;
+  setWordSource(source: WordSource): void;
+  setWordOptions(count: number, rerolls: number, mixedDifficulty: boolean, freeChoice?: boolean): void;
+  rerollWords(): void;
+  onCustomWords(listener: (result: CustomWordsResult) => void): () => void;
   onRoomList(listener: (rooms: RoomInfo[]) => void): () => void;
   onReaction(listener: (reaction: ReactionEvent) => void): () => void;
+  onNotice(listener: (notice: ServerNotice | null) => void): () => void;
 }
 
 // Protocol: Messages sent FROM Frontend TO Backend
 export type ClientMessage = 
-  | { type: 'JOIN_GAME'; payload: { name: string; roomCode?: string; avatarUrl?: string } }
+  | { type: 'JOIN_GAME'; payload: { name: string; roomCode?: string; avatarUrl?: string; playerId?: string; rejoinToken?: string } }
   | { type: 'TOGGLE_READY' }
   | { type: 'TOGGLE_WANTS_MAYOR' }
   | { type: 'START_GAME' }
@@ -119,14 +219,32 @@ export type ClientMessage =
   | { type: 'VOTE'; payload: { targetId: string } }
   | { type: 'RESET_GAME' }
   | { type: 'LIST_ROOMS' }
-  | { type: 'ADD_BOT' }
+  | { type: 'ADD_BOT'; payload?: { skill?: BotSkill; personality?: string; agent?: string } }
+  | { type: 'REMOVE_BOT'; payload: { botId: string } }
+  | { type: 'CONFIGURE_BOT'; payload: { botId: string } & BotSettings }
+  | { type: 'AUTO_FILL_BOTS'; payload: { enabled: boolean } }
   | { type: 'SEND_REACTION'; payload: { emoji: string } }
-  | { type: 'REVEAL_HINT' }
-  | { type: 'SET_DIFFICULTY'; payload: { difficulty: Difficulty } };
+  | { type: 'REVEAL_HINT'; payload?: { type: HintType } }
+  | { type: 'SET_DIFFICULTY'; payload: { difficulty: Difficulty } }
+  | { type: 'SET_LANGUAGE'; payload: { language: string } }
+  | { type: 'SET_CUSTOM_WORDS'; payload?: { text?: string; words?: string[] } }
+  | { type: 'SET_WORD_SOURCE'; payload: { source: WordSource } }
+  | { type: 'SET_WORD_OPTIONS'; payload: { count: number; rerolls: number; mixedDifficulty: boolean; freeChoice?: boolean } }
+  | { type: 'REROLL_WORDS' };
 
 // Protocol: Messages sent FROM Backend TO Frontend
 export type ServerMessage = 
   | { type: 'STATE_UPDATE'; payload: GameState }
   | { type: 'ERROR'; payload: { message: string } }
   | { type: 'ROOM_LIST'; payload: { rooms: RoomInfo[] } }
-  | { type: 'REACTION'; payload: ReactionEvent };
+  | { type: 'REACTION'; payload: ReactionEvent }
+  | { type: 'SERVER_SHUTDOWN'; payload: { secondsRemaining: number; message: string } }
+  | { type: 'ANNOUNCEMENT'; payload: { message: string } }
+  | { type: 'KICKED'; payload: { message: string } }
+  | { type: 'ROOM_CLOSED'; payload: { message: string } }
+  | { type: 'ROOM_EXPIRING'; payload: { secondsRemaining: number; message: string } }
+  | { type: 'REDIRECT'; payload: { roomCode: string; url: string } }
+  | { type: 'AGENT_READY'; payload: { name: string; playerId: string } }
+  | { type: 'JOINED'; payload: SeatInfo }
+  | { type: 'REJOIN_FAILED'; payload: { message: string } }
+  | { type: 'CUSTOM_WORDS'; payload: CustomWordsResult };